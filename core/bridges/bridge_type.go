@@ -15,12 +15,20 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
-// BridgeTypeRequest is the incoming record used to create a BridgeType
+// BridgeTypeRequest is the incoming record used to create or update a
+// BridgeType. On update, an omitted HealthCheckURL keeps the stored one, and
+// an empty one removes it. The ClientKey and RequestSigningSecret, which are
+// never returned, keep their stored values unless they are given, or cleared
+// by ClearClientKey and ClearRequestSigningSecret.
 type BridgeTypeRequest struct {
-	Name                   BridgeName    `json:"name"`
-	URL                    models.WebURL `json:"url"`
-	Confirmations          uint32        `json:"confirmations"`
-	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	Name                      BridgeName     `json:"name"`
+	URL                       models.WebURL  `json:"url"`
+	Confirmations             uint32         `json:"confirmations"`
+	MinimumContractPayment    *assets.Link   `json:"minimumContractPayment"`
+	HealthCheckURL            *models.WebURL `json:"healthCheckURL,omitempty"`
+	ClearClientKey            bool           `json:"clearClientKey,omitempty"`
+	ClearRequestSigningSecret bool           `json:"clearRequestSigningSecret,omitempty"`
+	BridgeSecurity
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -40,6 +48,30 @@ func (bt *BridgeTypeRequest) SetID(value string) error {
 	return err
 }
 
+// HealthCheck returns the health check URL of the request, or nil if it has
+// none.
+func (bt BridgeTypeRequest) HealthCheck() *models.WebURL {
+	if bt.HealthCheckURL == nil || bt.HealthCheckURL.String() == "" {
+		return nil
+	}
+	return bt.HealthCheckURL
+}
+
+// WithStored returns the request as an update of the stored bridge, with the
+// settings it omits taken from stored.
+func (bt BridgeTypeRequest) WithStored(stored BridgeType) BridgeTypeRequest {
+	if bt.HealthCheckURL == nil {
+		bt.HealthCheckURL = stored.HealthCheckURL
+	}
+	if bt.ClientKey == "" && !bt.ClearClientKey {
+		bt.ClientKey = stored.ClientKey
+	}
+	if bt.RequestSigningSecret == "" && !bt.ClearRequestSigningSecret {
+		bt.RequestSigningSecret = stored.RequestSigningSecret
+	}
+	return bt
+}
+
 // BridgeTypeAuthentication is the record returned in response to a request to create a BridgeType
 type BridgeTypeAuthentication struct {
 	Name                   BridgeName
@@ -48,10 +80,13 @@ type BridgeTypeAuthentication struct {
 	IncomingToken          string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	HealthCheckURL         *models.WebURL
 }
 
 // BridgeType is used for external adapters and has fields for
-// the name of the adapter and its URL.
+// the name of the adapter and its URL. The optional HealthCheckURL is probed
+// in the background, see HealthMonitor, and the optional BridgeSecurity
+// settings apply to every call, see HTTPClient.
 type BridgeType struct {
 	Name                   BridgeName
 	URL                    models.WebURL
@@ -60,8 +95,10 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
+	HealthCheckURL         *models.WebURL
+	BridgeSecurity
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 // NewBridgeType returns a bridge type authentication (with plaintext
@@ -78,21 +115,23 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 	}
 
 	return &BridgeTypeAuthentication{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingToken:          incomingToken,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, &BridgeType{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingTokenHash:      hash,
-			Salt:                   salt,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, nil
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingToken:          incomingToken,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+		HealthCheckURL:         btr.HealthCheck(),
+	}, &BridgeType{
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingTokenHash:      hash,
+		Salt:                   salt,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+		HealthCheckURL:         btr.HealthCheck(),
+	}, nil
 }
 
 // AuthenticateBridgeType returns true if the passed token matches its
@@ -36,6 +36,33 @@ func TestBridgeTypeRequest(t *testing.T) {
 	assert.Error(t, r.SetID("abc123.,<>/.foobar"))
 }
 
+func TestBridgeTypeRequest_WithStored(t *testing.T) {
+	t.Parallel()
+
+	stored := bridges.BridgeType{HealthCheckURL: cltest.MustWebURL(t, "https://example.com/health")}
+
+	r := bridges.BridgeTypeRequest{}.WithStored(stored)
+	assert.Equal(t, stored.HealthCheckURL, r.HealthCheck(), "an omitted health check URL is kept")
+
+	r = bridges.BridgeTypeRequest{HealthCheckURL: &models.WebURL{}}.WithStored(stored)
+	assert.Nil(t, r.HealthCheck(), "an empty health check URL removes it")
+
+	other := cltest.MustWebURL(t, "https://example.com/other")
+	r = bridges.BridgeTypeRequest{HealthCheckURL: other}.WithStored(stored)
+	assert.Equal(t, other, r.HealthCheck())
+
+	stored.BridgeSecurity = bridges.BridgeSecurity{ClientCertificate: "cert", ClientKey: "key", RequestSigningSecret: "secret"}
+	r = bridges.BridgeTypeRequest{BridgeSecurity: bridges.BridgeSecurity{ClientCertificate: "new cert"}}.WithStored(stored)
+	assert.Equal(t, bridges.BridgeSecurity{ClientCertificate: "new cert", ClientKey: "key", RequestSigningSecret: "secret"}, r.BridgeSecurity,
+		"omitted secrets are kept")
+
+	r = bridges.BridgeTypeRequest{BridgeSecurity: bridges.BridgeSecurity{ClientKey: "new key"}, ClearRequestSigningSecret: true}.WithStored(stored)
+	assert.Equal(t, bridges.BridgeSecurity{ClientKey: "new key"}, r.BridgeSecurity)
+
+	r = bridges.BridgeTypeRequest{ClearClientKey: true}.WithStored(stored)
+	assert.Equal(t, bridges.BridgeSecurity{RequestSigningSecret: "secret"}, r.BridgeSecurity)
+}
+
 func TestBridgeType_Authenticate(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,252 @@
+package bridges
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promBridgeHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_healthy",
+		Help: "Result of the last health check of a bridge, 1 if healthy and 0 if not",
+	},
+		[]string{"name"},
+	)
+	promBridgeCircuitOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_circuit_breaker_open",
+		Help: "Whether the circuit breaker of a bridge is open, 1 if open and 0 if not",
+	},
+		[]string{"name"},
+	)
+	promBridgeCircuitRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_circuit_breaker_rejections_total",
+		Help: "Bridge requests failed fast by an open circuit breaker, scoped by name",
+	},
+		[]string{"name"},
+	)
+)
+
+// ErrCircuitOpen is returned for requests to a bridge whose circuit breaker is
+// open.
+var ErrCircuitOpen = errors.New("bridge circuit breaker is open")
+
+// HealthStatus is the result of the last health check of a bridge.
+type HealthStatus string
+
+const (
+	HealthStatusUnknown   HealthStatus = "unknown"
+	HealthStatusHealthy   HealthStatus = "healthy"
+	HealthStatusUnhealthy HealthStatus = "unhealthy"
+)
+
+// CircuitState is the state of the circuit breaker of a bridge.
+type CircuitState string
+
+const (
+	// CircuitClosed lets all requests through.
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen fails all requests fast.
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a single trial request through, which closes the
+	// breaker if it succeeds and opens it again if it fails.
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// Health is the health of a bridge, as seen by its health checks and by the
+// requests made to it.
+type Health struct {
+	Status              HealthStatus
+	Circuit             CircuitState
+	LastChecked         time.Time
+	LastError           string
+	ConsecutiveFailures uint32
+}
+
+// Err returns an error if the bridge is unhealthy or its circuit breaker is
+// not closed.
+func (h Health) Err() error {
+	if h.Status == HealthStatusUnhealthy {
+		return fmt.Errorf("health check failed: %s", h.LastError)
+	}
+	if h.Circuit != CircuitClosed {
+		return fmt.Errorf("circuit breaker is %s: %s", h.Circuit, h.LastError)
+	}
+	return nil
+}
+
+type bridgeHealth struct {
+	Health
+	openedAt time.Time
+}
+
+// HealthMonitor keeps track of the health of bridges, and of the circuit
+// breakers that make requests to unhealthy bridges fail fast.
+//
+// A failed health check opens the circuit breaker of a bridge, and so do
+// threshold consecutive failed requests, unless threshold is zero. After
+// cooldown, an open breaker lets a single trial request through. A successful
+// trial request, or a successful health check, closes the breaker.
+type HealthMonitor struct {
+	threshold uint32
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu      sync.Mutex
+	bridges map[BridgeName]*bridgeHealth
+}
+
+// NewHealthMonitor returns a HealthMonitor which opens circuit breakers after
+// threshold consecutive failed requests, and keeps them open for cooldown.
+func NewHealthMonitor(threshold uint32, cooldown time.Duration) *HealthMonitor {
+	return &HealthMonitor{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		bridges:   make(map[BridgeName]*bridgeHealth),
+	}
+}
+
+func (m *HealthMonitor) get(name BridgeName) *bridgeHealth {
+	h, ok := m.bridges[name]
+	if !ok {
+		h = &bridgeHealth{Health: Health{Status: HealthStatusUnknown, Circuit: CircuitClosed}}
+		m.bridges[name] = h
+	}
+	return h
+}
+
+// Allow returns ErrCircuitOpen if a request to the bridge must fail fast.
+// Otherwise the outcome of the request must be reported with RecordSuccess or
+// RecordFailure.
+func (m *HealthMonitor) Allow(name BridgeName) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h, ok := m.bridges[name]
+	if !ok {
+		return nil
+	}
+	if h.Circuit == CircuitClosed {
+		return nil
+	}
+	// An open breaker lets a trial request through after the cooldown, and so
+	// does a half-open one whose trial request never reported back.
+	if m.now().Sub(h.openedAt) >= m.cooldown {
+		h.Circuit = CircuitHalfOpen
+		h.openedAt = m.now()
+		return nil
+	}
+	promBridgeCircuitRejections.WithLabelValues(name.String()).Inc()
+	return fmt.Errorf("%w: %s", ErrCircuitOpen, name)
+}
+
+// RecordSuccess reports a successful request to the bridge.
+func (m *HealthMonitor) RecordSuccess(name BridgeName) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h, ok := m.bridges[name]
+	if !ok {
+		return
+	}
+	h.ConsecutiveFailures = 0
+	m.close(name, h)
+}
+
+// RecordFailure reports a failed request to the bridge.
+func (m *HealthMonitor) RecordFailure(name BridgeName, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h := m.get(name)
+	h.ConsecutiveFailures++
+	h.LastError = err.Error()
+	if h.Circuit == CircuitHalfOpen || (m.threshold > 0 && h.ConsecutiveFailures >= m.threshold) {
+		m.open(name, h)
+	}
+}
+
+// RecordCheck reports the result of a health check of the bridge, which is
+// healthy if err is nil.
+func (m *HealthMonitor) RecordCheck(name BridgeName, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h := m.get(name)
+	h.LastChecked = m.now()
+	if err != nil {
+		h.Status = HealthStatusUnhealthy
+		h.ConsecutiveFailures++
+		h.LastError = err.Error()
+		promBridgeHealthy.WithLabelValues(name.String()).Set(0)
+		m.open(name, h)
+		return
+	}
+	h.Status = HealthStatusHealthy
+	h.ConsecutiveFailures = 0
+	h.LastError = ""
+	promBridgeHealthy.WithLabelValues(name.String()).Set(1)
+	m.close(name, h)
+}
+
+func (m *HealthMonitor) open(name BridgeName, h *bridgeHealth) {
+	h.Circuit = CircuitOpen
+	h.openedAt = m.now()
+	promBridgeCircuitOpen.WithLabelValues(name.String()).Set(1)
+}
+
+func (m *HealthMonitor) close(name BridgeName, h *bridgeHealth) {
+	if h.Circuit == CircuitClosed {
+		return
+	}
+	h.Circuit = CircuitClosed
+	promBridgeCircuitOpen.WithLabelValues(name.String()).Set(0)
+}
+
+// Health returns the health of the bridge.
+func (m *HealthMonitor) Health(name BridgeName) Health {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if h, ok := m.bridges[name]; ok {
+		return h.Health
+	}
+	return Health{Status: HealthStatusUnknown, Circuit: CircuitClosed}
+}
+
+// Bridges returns the health of every bridge which has been health checked, or
+// has had a failed request.
+func (m *HealthMonitor) Bridges() map[BridgeName]Health {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	bridges := make(map[BridgeName]Health, len(m.bridges))
+	for name, h := range m.bridges {
+		bridges[name] = h.Health
+	}
+	return bridges
+}
+
+// Retain forgets the health of bridges not in names, e.g. after they are
+// deleted.
+func (m *HealthMonitor) Retain(names []BridgeName) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keep := make(map[BridgeName]struct{}, len(names))
+	for _, name := range names {
+		keep[name] = struct{}{}
+	}
+	for name := range m.bridges {
+		if _, ok := keep[name]; !ok {
+			delete(m.bridges, name)
+			promBridgeHealthy.DeleteLabelValues(name.String())
+			promBridgeCircuitOpen.DeleteLabelValues(name.String())
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package bridges_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+)
+
+func TestHealthMonitor_FailedRequests(t *testing.T) {
+	t.Parallel()
+
+	const name = bridges.BridgeName("ea")
+	cooldown := 50 * time.Millisecond
+	m := bridges.NewHealthMonitor(2, cooldown)
+	errTimeout := errors.New("timeout")
+
+	require.NoError(t, m.Allow(name))
+	m.RecordFailure(name, errTimeout)
+	require.NoError(t, m.Allow(name), "below the threshold")
+	m.RecordFailure(name, errTimeout)
+
+	h := m.Health(name)
+	assert.Equal(t, bridges.CircuitOpen, h.Circuit)
+	assert.Equal(t, bridges.HealthStatusUnknown, h.Status)
+	assert.Equal(t, uint32(2), h.ConsecutiveFailures)
+	assert.Equal(t, "timeout", h.LastError)
+	require.ErrorIs(t, m.Allow(name), bridges.ErrCircuitOpen)
+
+	time.Sleep(cooldown)
+	require.NoError(t, m.Allow(name), "trial request after the cooldown")
+	assert.Equal(t, bridges.CircuitHalfOpen, m.Health(name).Circuit)
+	require.ErrorIs(t, m.Allow(name), bridges.ErrCircuitOpen, "only one trial request")
+	m.RecordFailure(name, errTimeout)
+	assert.Equal(t, bridges.CircuitOpen, m.Health(name).Circuit, "failed trial opens the breaker again")
+
+	time.Sleep(cooldown)
+	require.NoError(t, m.Allow(name))
+	m.RecordSuccess(name)
+	h = m.Health(name)
+	assert.Equal(t, bridges.CircuitClosed, h.Circuit)
+	assert.Zero(t, h.ConsecutiveFailures)
+	require.NoError(t, h.Err())
+}
+
+func TestHealthMonitor_NoThreshold(t *testing.T) {
+	t.Parallel()
+
+	const name = bridges.BridgeName("ea")
+	m := bridges.NewHealthMonitor(0, time.Minute)
+	for range 10 {
+		m.RecordFailure(name, errors.New("timeout"))
+	}
+	require.NoError(t, m.Allow(name))
+	assert.Equal(t, bridges.CircuitClosed, m.Health(name).Circuit)
+}
+
+func TestHealthMonitor_HealthChecks(t *testing.T) {
+	t.Parallel()
+
+	const name = bridges.BridgeName("ea")
+	m := bridges.NewHealthMonitor(0, time.Minute)
+	assert.Equal(t, bridges.HealthStatusUnknown, m.Health(name).Status)
+
+	m.RecordCheck(name, errors.New("503 Service Unavailable"))
+	h := m.Health(name)
+	assert.Equal(t, bridges.HealthStatusUnhealthy, h.Status)
+	assert.Equal(t, bridges.CircuitOpen, h.Circuit)
+	assert.False(t, h.LastChecked.IsZero())
+	require.ErrorContains(t, h.Err(), "health check failed: 503 Service Unavailable")
+	require.ErrorIs(t, m.Allow(name), bridges.ErrCircuitOpen)
+
+	m.RecordCheck(name, nil)
+	h = m.Health(name)
+	assert.Equal(t, bridges.HealthStatusHealthy, h.Status)
+	assert.Equal(t, bridges.CircuitClosed, h.Circuit)
+	require.NoError(t, m.Allow(name))
+
+	assert.Len(t, m.Bridges(), 1)
+	m.Retain([]bridges.BridgeName{"other"})
+	assert.Empty(t, m.Bridges())
+	assert.Equal(t, bridges.HealthStatusUnknown, m.Health(name).Status)
+}
@@ -102,6 +102,7 @@ func (o *orm) DeleteBridgeType(ctx context.Context, bt *BridgeType) error {
 		return sql.ErrNoRows
 	}
 
+	forgetClient(bt.Name)
 	return nil
 }
 
@@ -124,8 +125,10 @@ func (o *orm) BridgeTypes(ctx context.Context, offset int, limit int) (bridges [
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, health_check_url,
+		client_certificate, client_key, ca_bundle, request_signing_secret, response_verification_key, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :health_check_url,
+		:client_certificate, :client_key, :ca_bundle, :request_signing_secret, :response_verification_key, now(), now())
 	RETURNING *;`
 	err := o.transact(ctx, false, func(tx *orm) error {
 		stmt, err := tx.ds.PrepareNamedContext(ctx, stmt)
@@ -139,12 +142,21 @@ func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
 	return pkgerrors.Wrap(err, "CreateBridgeType failed")
 }
 
-// UpdateBridgeType updates the bridge type.
+// UpdateBridgeType updates the bridge type. Settings omitted by btr keep
+// their values in bt, see BridgeTypeRequest.WithStored.
 func (o *orm) UpdateBridgeType(ctx context.Context, bt *BridgeType, btr *BridgeTypeRequest) error {
-	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	err := o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	req := btr.WithStored(*bt)
+	stmt := `UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, health_check_url = $4,
+		client_certificate = $5, client_key = $6, ca_bundle = $7, request_signing_secret = $8, response_verification_key = $9
+	WHERE name = $10 RETURNING *`
+	err := o.ds.GetContext(ctx, bt, stmt, req.URL, req.Confirmations, req.MinimumContractPayment, req.HealthCheck(),
+		req.ClientCertificate, req.ClientKey, req.CABundle, req.RequestSigningSecret, req.ResponseVerificationKey, bt.Name)
+	if err != nil {
+		return err
+	}
 
-	return err
+	forgetClient(bt.Name)
+	return nil
 }
 
 func (o *orm) GetCachedResponse(ctx context.Context, dotId string, specId int32, maxElapsed time.Duration) ([]byte, error) {
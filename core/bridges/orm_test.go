@@ -137,6 +137,44 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	require.Empty(t, bs)
 }
 
+func TestORM_UpdateBridgeType_KeepsSecrets(t *testing.T) {
+	ctx := testutils.Context(t)
+	_, orm := setupORM(t)
+
+	certPEM, keyPEM := newClientCertificate(t)
+	bt := &bridges.BridgeType{
+		Name: "secured",
+		URL:  cltest.WebURL(t, "https://bridge.example.com"),
+		BridgeSecurity: bridges.BridgeSecurity{
+			ClientCertificate:    certPEM,
+			ClientKey:            keyPEM,
+			RequestSigningSecret: signingSecret,
+		},
+	}
+	require.NoError(t, orm.CreateBridgeType(ctx, bt))
+
+	// The secrets are never returned, so an edit of the bridge omits them
+	require.NoError(t, orm.UpdateBridgeType(ctx, bt, &bridges.BridgeTypeRequest{
+		URL:            cltest.WebURL(t, "https://new.bridge.example.com"),
+		BridgeSecurity: bridges.BridgeSecurity{ClientCertificate: certPEM},
+	}))
+	found, err := orm.FindBridge(ctx, bt.Name)
+	require.NoError(t, err)
+	assert.Equal(t, cltest.WebURL(t, "https://new.bridge.example.com"), found.URL)
+	assert.Equal(t, keyPEM, found.ClientKey)
+	assert.Equal(t, signingSecret, found.RequestSigningSecret)
+
+	require.NoError(t, orm.UpdateBridgeType(ctx, bt, &bridges.BridgeTypeRequest{
+		URL:                       found.URL,
+		ClearClientKey:            true,
+		ClearRequestSigningSecret: true,
+	}))
+	found, err = orm.FindBridge(ctx, bt.Name)
+	require.NoError(t, err)
+	assert.Empty(t, found.ClientKey)
+	assert.Empty(t, found.RequestSigningSecret)
+}
+
 func TestORM_TestCachedResponse(t *testing.T) {
 	ctx := testutils.Context(t)
 	cfg := configtest.NewGeneralConfig(t, nil)
@@ -0,0 +1,262 @@
+package bridges
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// TimestampHeader carries the unix time at which a bridge request was
+	// signed. Signed responses must cover it, which ties them to the request.
+	TimestampHeader = "X-Chainlink-Bridge-Timestamp"
+	// RequestSignatureHeader carries the HMAC-SHA256 signature of a bridge
+	// request, see SignRequest.
+	RequestSignatureHeader = "X-Chainlink-Bridge-Signature"
+	// ResponseSignatureHeader carries the ed25519 signature of a bridge
+	// response, see VerifyResponse.
+	ResponseSignatureHeader = "X-Bridge-Signature"
+
+	// MinRequestSigningSecretLength is the minimum length of a request signing
+	// secret.
+	MinRequestSigningSecretLength = 32
+)
+
+// ErrResponseSignature is returned for bridge responses which are not signed
+// by the response verification key of the bridge.
+var ErrResponseSignature = errors.New("bridge response signature verification failed")
+
+// BridgeSecurity holds the optional settings which authenticate the node and a
+// bridge to each other, beyond the outgoing token:
+//   - ClientCertificate and ClientKey, PEM encoded, for mutual TLS.
+//   - CABundle, PEM encoded, to verify the bridge instead of the system roots.
+//   - RequestSigningSecret, to sign requests with HMAC-SHA256.
+//   - ResponseVerificationKey, a hex encoded ed25519 public key, which must
+//     have signed every response.
+type BridgeSecurity struct {
+	ClientCertificate       string `json:"clientCertificate,omitempty"`
+	ClientKey               string `json:"clientKey,omitempty"`
+	CABundle                string `json:"caBundle,omitempty"`
+	RequestSigningSecret    string `json:"requestSigningSecret,omitempty"`
+	ResponseVerificationKey string `json:"responseVerificationKey,omitempty"`
+}
+
+// Validate returns an error if any of the settings are malformed.
+func (s BridgeSecurity) Validate() error {
+	var errs []error
+	if _, err := s.tlsConfig(); err != nil {
+		errs = append(errs, err)
+	}
+	if s.RequestSigningSecret != "" && len(s.RequestSigningSecret) < MinRequestSigningSecretLength {
+		errs = append(errs, fmt.Errorf("request signing secret must be at least %d characters", MinRequestSigningSecretLength))
+	}
+	if _, err := s.verificationKey(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (s BridgeSecurity) tlsConfig() (*tls.Config, error) {
+	if s.ClientCertificate == "" && s.ClientKey == "" && s.CABundle == "" {
+		return nil, nil
+	}
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if s.ClientCertificate != "" || s.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(s.ClientCertificate), []byte(s.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate and key: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if s.CABundle != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(s.CABundle)) {
+			return nil, errors.New("CA bundle holds no PEM encoded certificates")
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
+func (s BridgeSecurity) verificationKey() (ed25519.PublicKey, error) {
+	if s.ResponseVerificationKey == "" {
+		return nil, nil
+	}
+	key, err := hex.DecodeString(s.ResponseVerificationKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("response verification key must be a hex encoded %d byte ed25519 public key", ed25519.PublicKeySize)
+	}
+	return key, nil
+}
+
+// SignRequest returns the signature of a request body sent at timestamp, the
+// hex encoded HMAC-SHA256 of "<timestamp>.<body>".
+func SignRequest(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyResponse returns ErrResponseSignature unless signature, hex encoded,
+// is the ed25519 signature by key of "<timestamp>.<body>", where timestamp is
+// that of the request.
+func VerifyResponse(key ed25519.PublicKey, timestamp string, body []byte, signature string) error {
+	sig, err := hex.DecodeString(signature)
+	if err != nil || !ed25519.Verify(key, append([]byte(timestamp+"."), body...), sig) {
+		return ErrResponseSignature
+	}
+	return nil
+}
+
+// clientKey identifies a cached client. Callers pass their own base clients,
+// such as the pipeline's and the bridge status reporter's, so each gets its
+// own entry rather than replacing the others'.
+type clientKey struct {
+	name             BridgeName
+	base             *http.Client
+	maxResponseBytes int64
+}
+
+type cachedClient struct {
+	security BridgeSecurity
+	client   *http.Client
+}
+
+// Clients are cached per bridge and base client so that connections are
+// reused, and replaced when the settings of the bridge change. Entries are
+// removed when the bridge is updated or deleted, see forgetClient.
+var (
+	clientsMu sync.Mutex
+	clients   = map[clientKey]cachedClient{}
+)
+
+// HTTPClient returns the client to call the bridge with, which is base unless
+// the bridge has security settings. Responses whose signature is verified are
+// read in full, so they are limited to maxResponseBytes.
+func (bt BridgeType) HTTPClient(base *http.Client, maxResponseBytes int64) (*http.Client, error) {
+	if bt.BridgeSecurity == (BridgeSecurity{}) {
+		return base, nil
+	}
+
+	key := clientKey{name: bt.Name, base: base, maxResponseBytes: maxResponseBytes}
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+	if c, ok := clients[key]; ok && c.security == bt.BridgeSecurity {
+		return c.client, nil
+	}
+
+	client, err := newClient(base, bt.BridgeSecurity, maxResponseBytes)
+	if err != nil {
+		return nil, fmt.Errorf("bridge %s: %w", bt.Name, err)
+	}
+	clients[key] = cachedClient{security: bt.BridgeSecurity, client: client}
+	return client, nil
+}
+
+// forgetClient removes the cached clients of a bridge.
+func forgetClient(name BridgeName) {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+	for key := range clients {
+		if key.name == name {
+			delete(clients, key)
+		}
+	}
+}
+
+func newClient(base *http.Client, s BridgeSecurity, maxResponseBytes int64) (*http.Client, error) {
+	transport := base.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	tlsConfig, err := s.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		t, ok := transport.(*http.Transport)
+		if !ok {
+			// TLS settings only apply to an *http.Transport, so one wrapped
+			// by another round tripper is replaced by the default transport
+			t = http.DefaultTransport.(*http.Transport)
+		}
+		t = t.Clone()
+		t.TLSClientConfig = tlsConfig
+		transport = t
+	}
+	verificationKey, err := s.verificationKey()
+	if err != nil {
+		return nil, err
+	}
+	if s.RequestSigningSecret != "" || verificationKey != nil {
+		transport = &signingTransport{
+			base:             transport,
+			secret:           s.RequestSigningSecret,
+			verificationKey:  verificationKey,
+			maxResponseBytes: maxResponseBytes,
+		}
+	}
+
+	client := *base
+	client.Transport = transport
+	return &client, nil
+}
+
+// signingTransport signs requests, and verifies the signature of responses.
+type signingTransport struct {
+	base             http.RoundTripper
+	secret           string
+	verificationKey  ed25519.PublicKey
+	maxResponseBytes int64
+}
+
+func (t *signingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(TimestampHeader, timestamp)
+	if t.secret != "" {
+		req.Header.Set(RequestSignatureHeader, SignRequest(t.secret, timestamp, body))
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || t.verificationKey == nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, t.maxResponseBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(respBody)) > t.maxResponseBytes {
+		return nil, fmt.Errorf("bridge response exceeds %d bytes", t.maxResponseBytes)
+	}
+	if err := VerifyResponse(t.verificationKey, timestamp, respBody, resp.Header.Get(ResponseSignatureHeader)); err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	return resp, nil
+}
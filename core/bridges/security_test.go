@@ -0,0 +1,207 @@
+package bridges_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+)
+
+const signingSecret = "0123456789abcdef0123456789abcdef"
+
+func TestBridgeSecurity_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, bridges.BridgeSecurity{}.Validate())
+
+	certPEM, keyPEM := newClientCertificate(t)
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	require.NoError(t, bridges.BridgeSecurity{
+		ClientCertificate:       certPEM,
+		ClientKey:               keyPEM,
+		CABundle:                certPEM,
+		RequestSigningSecret:    signingSecret,
+		ResponseVerificationKey: hex.EncodeToString(pub),
+	}.Validate())
+
+	err = bridges.BridgeSecurity{ClientCertificate: certPEM}.Validate()
+	require.ErrorContains(t, err, "invalid client certificate and key")
+	err = bridges.BridgeSecurity{CABundle: "not a certificate"}.Validate()
+	require.ErrorContains(t, err, "CA bundle holds no PEM encoded certificates")
+	err = bridges.BridgeSecurity{RequestSigningSecret: "short"}.Validate()
+	require.ErrorContains(t, err, "request signing secret must be at least 32 characters")
+	err = bridges.BridgeSecurity{ResponseVerificationKey: hex.EncodeToString(pub[:16])}.Validate()
+	require.ErrorContains(t, err, "response verification key must be a hex encoded 32 byte ed25519 public key")
+}
+
+func TestBridgeType_HTTPClient_Signing(t *testing.T) {
+	t.Parallel()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var sign atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		timestamp := r.Header.Get(bridges.TimestampHeader)
+		assert.Equal(t, bridges.SignRequest(signingSecret, timestamp, body), r.Header.Get(bridges.RequestSignatureHeader))
+
+		response := []byte(`{"data":{"result":1}}`)
+		if sign.Load() {
+			sig := ed25519.Sign(priv, append([]byte(timestamp+"."), response...))
+			w.Header().Set(bridges.ResponseSignatureHeader, hex.EncodeToString(sig))
+		}
+		_, _ = w.Write(response)
+	}))
+	t.Cleanup(srv.Close)
+
+	bt := bridges.BridgeType{
+		Name: "signed",
+		BridgeSecurity: bridges.BridgeSecurity{
+			RequestSigningSecret:    signingSecret,
+			ResponseVerificationKey: hex.EncodeToString(pub),
+		},
+	}
+	base := &http.Client{}
+	client, err := bt.HTTPClient(base, 1024)
+	require.NoError(t, err)
+	again, err := bt.HTTPClient(base, 1024)
+	require.NoError(t, err)
+	assert.Same(t, client, again, "clients are reused")
+	other := &http.Client{}
+	otherClient, err := bt.HTTPClient(other, 1024)
+	require.NoError(t, err)
+	assert.NotSame(t, client, otherClient)
+	again, err = bt.HTTPClient(base, 1024)
+	require.NoError(t, err)
+	assert.Same(t, client, again, "clients of other bases don't replace each other")
+	otherAgain, err := bt.HTTPClient(other, 1024)
+	require.NoError(t, err)
+	assert.Same(t, otherClient, otherAgain)
+
+	_, err = client.Post(srv.URL, "application/json", strings.NewReader(`{"id":"1"}`))
+	require.ErrorIs(t, err, bridges.ErrResponseSignature)
+
+	sign.Store(true)
+	resp, err := client.Post(srv.URL, "application/json", strings.NewReader(`{"id":"1"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":{"result":1}}`, string(body))
+
+	small, err := bt.HTTPClient(base, 8)
+	require.NoError(t, err)
+	_, err = small.Post(srv.URL, "application/json", strings.NewReader(`{"id":"1"}`))
+	require.ErrorContains(t, err, "bridge response exceeds 8 bytes")
+
+	bt.RequestSigningSecret = strings.Repeat("x", bridges.MinRequestSigningSecretLength)
+	updated, err := bt.HTTPClient(base, 8)
+	require.NoError(t, err)
+	assert.NotSame(t, small, updated, "the client is replaced when the settings change")
+}
+
+func TestBridgeType_HTTPClient_MutualTLS(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM([]byte(certPEM)))
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	base := &http.Client{}
+	plain, err := bridges.BridgeType{Name: "plain"}.HTTPClient(base, 1024)
+	require.NoError(t, err)
+	assert.Same(t, base, plain, "bridges without security settings use the base client")
+
+	noCert, err := bridges.BridgeType{
+		Name:           "nocert",
+		BridgeSecurity: bridges.BridgeSecurity{CABundle: caBundle},
+	}.HTTPClient(base, 1024)
+	require.NoError(t, err)
+	_, err = noCert.Get(srv.URL) //nolint:noctx
+	require.Error(t, err, "the server requires a client certificate")
+
+	client, err := bridges.BridgeType{
+		Name: "mtls",
+		BridgeSecurity: bridges.BridgeSecurity{
+			ClientCertificate: certPEM,
+			ClientKey:         keyPEM,
+			CABundle:          caBundle,
+		},
+	}.HTTPClient(base, 1024)
+	require.NoError(t, err)
+	resp, err := client.Get(srv.URL) //nolint:noctx
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	wrapped := &http.Client{Transport: wrappingTransport{http.DefaultTransport}}
+	client, err = bridges.BridgeType{
+		Name: "mtls-wrapped",
+		BridgeSecurity: bridges.BridgeSecurity{
+			ClientCertificate: certPEM,
+			ClientKey:         keyPEM,
+			CABundle:          caBundle,
+		},
+	}.HTTPClient(wrapped, 1024)
+	require.NoError(t, err, "a base transport other than *http.Transport is supported")
+	resp, err = client.Get(srv.URL) //nolint:noctx
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+type wrappingTransport struct {
+	http.RoundTripper
+}
+
+// newClientCertificate returns a self signed client certificate and its key,
+// PEM encoded.
+func newClientCertificate(t *testing.T) (certPEM, keyPEM string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "chainlink-node"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -20,16 +22,80 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func initAdminSubCmds(s *Shell) []cli.Command {
 	return []cli.Command{
+		{
+			Name:  "audit",
+			Usage: "Query and verify the local audit log",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists the records of the local audit log, newest first",
+					Action: s.ListAuditRecords,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "since",
+							Usage: "only list records logged since this time, as RFC3339",
+						},
+						cli.StringFlag{
+							Name:  "event",
+							Usage: "only list records of this event, e.g. JOB_CREATED",
+						},
+						cli.StringFlag{
+							Name:  "user",
+							Usage: "only list records of this user's email",
+						},
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+					},
+				},
+				{
+					Name:   "verify",
+					Usage:  "Checks the hash chain of the local audit log for altered or removed records",
+					Action: s.VerifyAuditLog,
+				},
+			},
+		},
 		{
 			Name:   "chpass",
 			Usage:  "Change your API password remotely",
 			Action: s.ChangePassword,
 		},
+		{
+			Name:  "keystore",
+			Usage: "Manage the keystore",
+			Subcommands: cli.Commands{
+				{
+					Name:   "rotate-password",
+					Usage:  "Re-encrypt the keystore under a new password, and optionally stronger scrypt parameters",
+					Action: s.RotateKeystorePassword,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "old-password",
+							Usage: "text file holding the current keystore password, prompted for if not set",
+						},
+						cli.StringFlag{
+							Name:  "new-password",
+							Usage: "text file holding the new keystore password, prompted for if not set",
+						},
+						cli.IntFlag{
+							Name:  "scrypt-n",
+							Usage: "scrypt N parameter for the new encryption, defaults to the node's configured value",
+						},
+						cli.IntFlag{
+							Name:  "scrypt-p",
+							Usage: "scrypt P parameter for the new encryption, defaults to the node's configured value",
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:   "login",
 			Usage:  "Login to remote client by creating a session cookie",
@@ -67,6 +133,47 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "sessions",
+			Usage: "List and revoke the active sessions of API users",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists active sessions, most recently used first",
+					Action: s.ListSessions,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "email",
+							Usage: "only list sessions of this user's email",
+						},
+					},
+				},
+				{
+					Name:   "revoke",
+					Usage:  "Revoke an active session, logging out its user",
+					Action: s.RevokeSession,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "id",
+							Usage:    "ID of the session to revoke, as listed",
+							Required: true,
+						},
+					},
+				},
+				{
+					Name:   "revoke-all",
+					Usage:  "Revoke all active sessions of an API user",
+					Action: s.RevokeUserSessions,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "email",
+							Usage:    "Email of API user whose sessions to revoke",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:   "status",
 			Usage:  "Displays the health of various services running inside the node.",
@@ -94,7 +201,7 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 						},
 						cli.StringFlag{
 							Name:     "role",
-							Usage:    "Permission level of new user. Options: 'admin', 'edit', 'run', 'view'.",
+							Usage:    "Permission level of new user. Options: 'admin', 'edit', 'run', 'view', or a custom role from WebServer.Roles.",
 							Required: true,
 						},
 					},
@@ -111,7 +218,7 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 						},
 						cli.StringFlag{
 							Name:     "new-role, newrole",
-							Usage:    "new permission level role to set for user. Options: 'admin', 'edit', 'run', 'view'.",
+							Usage:    "new permission level role to set for user. Options: 'admin', 'edit', 'run', 'view', or a custom role from WebServer.Roles.",
 							Required: true,
 						},
 					},
@@ -301,6 +408,194 @@ func (s *Shell) DeleteUser(c *cli.Context) (err error) {
 	return s.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
 
+type AdminSessionPresenter struct {
+	JAID
+	presenters.SessionResource
+}
+
+var adminSessionsTableHeaders = []string{"ID", "Email", "IP address", "User agent", "Created at", "Last used", "Current"}
+
+func (p *AdminSessionPresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		p.Email,
+		p.IPAddress,
+		p.UserAgent,
+		p.CreatedAt.String(),
+		p.LastUsed.String(),
+		strconv.FormatBool(p.Current),
+	}
+}
+
+type AdminSessionPresenters []AdminSessionPresenter
+
+// RenderTable implements TableRenderer
+func (ps AdminSessionPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Sessions\n")); err != nil {
+		return err
+	}
+	renderList(adminSessionsTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListSessions renders the active sessions of all API users, or of one
+func (s *Shell) ListSessions(c *cli.Context) (err error) {
+	uri := "/v2/sessions"
+	if email := c.String("email"); email != "" {
+		uri += "?" + url.Values{"email": {email}}.Encode()
+	}
+	resp, err := s.HTTP.Get(s.ctx(), uri, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AdminSessionPresenters{})
+}
+
+// RevokeSession revokes an active session by its ID
+func (s *Shell) RevokeSession(c *cli.Context) (err error) {
+	id := c.String("id")
+	if id == "" {
+		return s.errorOut(errors.New("id flag is empty, must specify a session ID"))
+	}
+
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/sessions/"+url.PathEscape(id))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+	if _, err = s.parseResponse(resp); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("Session %v revoked\n", id)
+	return nil
+}
+
+// RevokeUserSessions revokes all active sessions of an API user by email
+func (s *Shell) RevokeUserSessions(c *cli.Context) (err error) {
+	email := c.String("email")
+	if email == "" {
+		return s.errorOut(errors.New("email flag is empty, must specify an email"))
+	}
+
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/users/"+url.PathEscape(email)+"/sessions")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+	if _, err = s.parseResponse(resp); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("Sessions of %v revoked\n", email)
+	return nil
+}
+
+type AuditRecordPresenter struct {
+	JAID
+	presenters.AuditRecordResource
+}
+
+var auditRecordTableHeaders = []string{"ID", "Event", "User", "Data", "Created at"}
+
+func (p *AuditRecordPresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		string(p.EventID),
+		p.User,
+		string(p.Data),
+		p.CreatedAt.String(),
+	}
+}
+
+type AuditRecordPresenters []AuditRecordPresenter
+
+// RenderTable implements TableRenderer
+func (ps AuditRecordPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Audit log\n")); err != nil {
+		return err
+	}
+	renderList(auditRecordTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type AuditVerificationPresenter struct {
+	JAID
+	presenters.AuditVerificationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *AuditVerificationPresenter) RenderTable(rt RendererTable) error {
+	row := []string{strconv.Itoa(p.Checked), strconv.FormatBool(p.Valid), p.Error}
+	renderList([]string{"Records checked", "Valid", "Error"}, [][]string{row}, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAuditRecords renders the records of the local audit log
+func (s *Shell) ListAuditRecords(c *cli.Context) error {
+	q := url.Values{}
+	for _, filter := range []string{"since", "event", "user"} {
+		if v := c.String(filter); v != "" {
+			q.Set(filter, v)
+		}
+	}
+	uri := "/v2/audit"
+	if len(q) > 0 {
+		uri += "?" + q.Encode()
+	}
+	return s.getPage(uri, c.Int("page"), &AuditRecordPresenters{})
+}
+
+// VerifyAuditLog checks the hash chain of the local audit log, and fails if it
+// is broken
+func (s *Shell) VerifyAuditLog(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/audit/verify", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	var verification AuditVerificationPresenter
+	if err = s.renderAPIResponse(resp, &verification); err != nil {
+		return err
+	}
+	if !verification.Valid {
+		return s.errorOut(errors.New("audit log verification failed"))
+	}
+	return nil
+}
+
 // Status will display the health of various services
 func (s *Shell) Status(c *cli.Context) error {
 	resp, err := s.HTTP.Get(s.ctx(), "/health?full=1", nil)
@@ -316,6 +611,66 @@ func (s *Shell) Status(c *cli.Context) error {
 	return s.renderAPIResponse(resp, &HealthCheckPresenters{})
 }
 
+// RotateKeystorePassword re-encrypts the node's keystore under a new password.
+func (s *Shell) RotateKeystorePassword(c *cli.Context) (err error) {
+	request := web.RotateKeystorePasswordRequest{
+		ScryptN: c.Int("scrypt-n"),
+		ScryptP: c.Int("scrypt-p"),
+	}
+	if file := c.String("old-password"); file != "" {
+		if request.OldPassword, err = readPasswordFile(file); err != nil {
+			return s.errorOut(err)
+		}
+	} else {
+		fmt.Println("Current keystore password:")
+		request.OldPassword = s.PasswordPrompter.Prompt()
+	}
+	if file := c.String("new-password"); file != "" {
+		if request.NewPassword, err = readPasswordFile(file); err != nil {
+			return s.errorOut(err)
+		}
+	} else {
+		fmt.Println("New keystore password:")
+		request.NewPassword = s.PasswordPrompter.Prompt()
+		fmt.Println("Confirm new keystore password:")
+		if s.PasswordPrompter.Prompt() != request.NewPassword {
+			return s.errorOut(errors.New("new password and confirmation did not match"))
+		}
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/keystore/password", bytes.NewReader(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusNoContent:
+		fmt.Println("Keystore password rotated. Supply the new password to the node before it next restarts.")
+	case http.StatusConflict:
+		fmt.Println("Old keystore password did not match.")
+	default:
+		return s.printResponseBody(resp)
+	}
+	return nil
+}
+
+func readPasswordFile(file string) (string, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("could not read password file: %w", err)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
 // Profile will collect pprof metrics and store them in a folder.
 func (s *Shell) Profile(c *cli.Context) error {
 	ctx := s.ctx()
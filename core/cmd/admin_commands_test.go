@@ -5,6 +5,8 @@ import (
 	"flag"
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -165,6 +167,70 @@ func TestShell_ListUsers(t *testing.T) {
 	assert.Truef(t, userPresenterFound, "expected to find user %s in presenter list", user.Email)
 }
 
+func TestShell_Sessions(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, app.BasicAdminUsersORM().CreateUser(ctx, &user))
+	app.MustSeedNewSession(user.Email)
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListSessions, set, "")
+	require.NoError(t, set.Set("email", user.Email))
+	require.NoError(t, client.ListSessions(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+	ss := *r.Renders[0].(*cmd.AdminSessionPresenters)
+	require.Len(t, ss, 1)
+	assert.Equal(t, user.Email, ss[0].Email)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RevokeSession, set, "")
+	require.NoError(t, set.Set("id", ss[0].ID))
+	require.NoError(t, client.RevokeSession(cli.NewContext(nil, set, nil)))
+	require.ErrorContains(t, client.RevokeSession(cli.NewContext(nil, set, nil)), "not found")
+
+	app.MustSeedNewSession(user.Email)
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RevokeUserSessions, set, "")
+	require.NoError(t, set.Set("email", user.Email))
+	require.NoError(t, client.RevokeUserSessions(cli.NewContext(nil, set, nil)))
+	ss2, err := app.AuthenticationProvider().ListSessions(ctx, user.Email)
+	require.NoError(t, err)
+	assert.Empty(t, ss2)
+}
+
+func TestShell_RotateKeystorePassword(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	dir := t.TempDir()
+	oldPasswordFile := filepath.Join(dir, "old")
+	newPasswordFile := filepath.Join(dir, "new")
+	const newPassword = "rotatedKeystorePassword123!"
+	require.NoError(t, os.WriteFile(oldPasswordFile, []byte(cltest.Password+"\n"), 0o600))
+	require.NoError(t, os.WriteFile(newPasswordFile, []byte(newPassword), 0o600))
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RotateKeystorePassword, set, "")
+	require.NoError(t, set.Set("old-password", oldPasswordFile))
+	require.NoError(t, set.Set("new-password", newPasswordFile))
+	require.NoError(t, set.Set("scrypt-n", "4"))
+
+	require.NoError(t, client.RotateKeystorePassword(cli.NewContext(nil, set, nil)))
+	require.Error(t, app.KeyStore.Unlock(ctx, cltest.Password))
+	require.NoError(t, app.KeyStore.Unlock(ctx, newPassword))
+
+	t.Run("missing password file", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(client.RotateKeystorePassword, set, "")
+		require.NoError(t, set.Set("old-password", filepath.Join(dir, "missing")))
+
+		assert.ErrorContains(t, client.RotateKeystorePassword(cli.NewContext(nil, set, nil)), "could not read password file")
+	})
+}
+
 func TestAdminUsersPresenter_RenderTable(t *testing.T) {
 	user := sessions.User{
 		Email:     "foo@bar.com",
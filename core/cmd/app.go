@@ -165,6 +165,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for the node's configuration",
 			Subcommands: initRemoteConfigSubCmds(s),
 		},
+		{
+			Name:        "gateway",
+			Usage:       "Commands for inspecting the gateways of running gateway jobs",
+			Subcommands: initGatewaySubCmds(s),
+		},
 		{
 			Name:   "health",
 			Usage:  "Prints a health report",
@@ -188,7 +193,7 @@ func NewApp(s *Shell) *cli.App {
 		{
 			Name:  "keys",
 			Usage: "Commands for managing various types of keys used by the Chainlink node",
-			Subcommands: []cli.Command{
+			Subcommands: append([]cli.Command{
 				// TODO unify init vs keysCommand
 				// out of scope for initial refactor because it breaks usage messages.
 				initEthKeysSubCmd(s),
@@ -206,7 +211,7 @@ func NewApp(s *Shell) *cli.App {
 				keysCommand("Sui", NewSuiKeysClient(s)),
 
 				initVRFKeysSubCmd(s),
-			},
+			}, initKeysBackupSubCmds(s)...),
 		},
 		{
 			Name:        "node",
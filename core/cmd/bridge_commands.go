@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/urfave/cli"
@@ -49,14 +50,24 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 	return strconv.FormatUint(uint64(p.Confirmations), 10)
 }
 
+// FriendlyHealth converts the health and circuit breaker state to a string
+func (p *BridgePresenter) FriendlyHealth() string {
+	if p.Health == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s (circuit breaker %s)", p.Health.Status, p.Health.CircuitBreaker)
+}
+
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token", "Health Check URL", "Health"})
 	table.Append([]string{
 		p.Name,
 		p.URL,
 		p.FriendlyConfirmations(),
 		p.OutgoingToken,
+		p.HealthCheckURL,
+		p.FriendlyHealth(),
 	})
 	render("Bridge", table)
 	return nil
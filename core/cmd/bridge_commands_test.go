@@ -38,6 +38,7 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 			Confirmations: 10,
 			OutgoingToken: outgoingToken,
 			CreatedAt:     createdAt,
+			Health:        &presenters.BridgeHealth{Status: "unhealthy", CircuitBreaker: "open"},
 		},
 	}
 
@@ -49,6 +50,7 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, "unhealthy (circuit breaker open)")
 
 	// Render many resources
 	buffer.Reset()
@@ -27,6 +27,17 @@ func initCSAKeysSubCmd(s *Shell) cli.Command {
 				Usage:  format(`Create a CSA key, encrypted with password from the password file, and store it in the database.`),
 				Action: s.CreateCSAKey,
 			},
+			{
+				Name:  "add-external",
+				Usage: format(`Add a CSA key held by the external signer. Only its handle is stored in the database.`),
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "handle",
+						Usage: "handle of the key in the external signer, e.g. its PKCS#11 label (required)",
+					},
+				},
+				Action: s.AddExternalCSAKey,
+			},
 			{
 				Name:   "list",
 				Usage:  format(`List available CSA keys`),
@@ -136,6 +147,27 @@ func (s *Shell) CreateCSAKey(_ *cli.Context) (err error) {
 	return s.renderAPIResponse(resp, &CSAKeyPresenter{}, "Created CSA key")
 }
 
+// AddExternalCSAKey adds a CSA key held by the external signer
+func (s *Shell) AddExternalCSAKey(c *cli.Context) (err error) {
+	handle := c.String("handle")
+	if handle == "" {
+		return s.errorOut(errors.New("Must specify --handle flag"))
+	}
+	addURL := url.URL{Path: "/v2/keys/csa/external"}
+	addURL.RawQuery = url.Values{"handle": {handle}}.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), addURL.String(), nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CSAKeyPresenter{}, "Added external CSA key")
+}
+
 // ImportCSAKey imports and stores a CSA key. Path to key must be passed.
 func (s *Shell) ImportCSAKey(c *cli.Context) (err error) {
 	if !c.Args().Present() {
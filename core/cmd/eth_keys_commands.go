@@ -41,6 +41,21 @@ func initEthKeysSubCmd(s *Shell) cli.Command {
 					},
 				},
 			},
+			{
+				Name:   "add-external",
+				Usage:  "Add a key held by the external signer. Only its handle is stored in the database.",
+				Action: s.AddExternalETHKey,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "handle",
+						Usage: "handle of the key in the external signer, e.g. its PKCS#11 label (required)",
+					},
+					cli.StringFlag{
+						Name:  "evm-chain-id, evmChainID",
+						Usage: "Chain ID for the key. If left blank, default chain will be used.",
+					},
+				},
+			},
 			{
 				Name:   "list",
 				Usage:  "List available Ethereum accounts with their ETH & LINK balances and other metadata",
@@ -220,6 +235,35 @@ func (s *Shell) CreateETHKey(c *cli.Context) (err error) {
 	return s.renderAPIResponse(resp, &EthKeyPresenter{}, "ETH key created.\n\n🔑 New key")
 }
 
+// AddExternalETHKey adds an Ethereum key held by the external signer
+func (s *Shell) AddExternalETHKey(c *cli.Context) (err error) {
+	handle := c.String("handle")
+	if handle == "" {
+		return s.errorOut(errors.New("Must specify --handle flag"))
+	}
+	addURL := url.URL{
+		Path: "/v2/keys/evm/external",
+	}
+	query := addURL.Query()
+	query.Set("handle", handle)
+	if c.IsSet("evm-chain-id") {
+		query.Set("evmChainID", c.String("evm-chain-id"))
+	}
+
+	addURL.RawQuery = query.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), addURL.String(), nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &EthKeyPresenter{}, "ETH key added.\n\n🔑 New key")
+}
+
 // DeleteETHKey hard deletes an Ethereum key,
 // address of key must be passed
 func (s *Shell) DeleteETHKey(c *cli.Context) (err error) {
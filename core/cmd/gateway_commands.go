@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initGatewaySubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "status",
+			Usage:  "Show the node connections and pending requests of running gateways",
+			Action: s.GatewayStatus,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "job",
+					Usage: "only show the gateway of this job ID",
+				},
+			},
+		},
+		{
+			Name:   "disconnect-node",
+			Usage:  "Close the connection of a DON node to a gateway, the node may reconnect",
+			Action: s.DisconnectGatewayNode,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "job",
+					Usage:    "ID of the gateway job",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "don",
+					Usage:    "ID of the DON of the node",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "address",
+					Usage:    "Address of the node to disconnect",
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+type GatewayPresenter struct {
+	JAID
+	presenters.GatewayResource
+}
+
+var (
+	gatewayDONsTableHeaders     = []string{"Job ID", "DON ID", "Nodes", "Connected", "Pending handshakes"}
+	gatewayNodesTableHeaders    = []string{"Job ID", "DON ID", "Name", "Address", "Connected", "Connected at", "Last heartbeat"}
+	gatewayHandlersTableHeaders = []string{"Job ID", "DON ID", "Handler", "Pending requests", "Cache entries", "Cache hits", "Cache misses"}
+)
+
+// RenderTable implements TableRenderer
+func (p *GatewayPresenter) RenderTable(rt RendererTable) error {
+	return GatewayPresenters{*p}.RenderTable(rt)
+}
+
+type GatewayPresenters []GatewayPresenter
+
+// RenderTable implements TableRenderer
+func (ps GatewayPresenters) RenderTable(rt RendererTable) error {
+	var donRows, nodeRows, handlerRows [][]string
+	for _, p := range ps {
+		for _, don := range p.DONs {
+			connected := 0
+			for _, node := range don.Nodes {
+				if node.Connected {
+					connected++
+				}
+				nodeRows = append(nodeRows, []string{
+					p.ID,
+					don.DonID,
+					node.Name,
+					node.Address,
+					strconv.FormatBool(node.Connected),
+					formatOptionalTime(node.ConnectedAt),
+					formatOptionalTime(node.LastHeartbeat),
+				})
+			}
+			donRows = append(donRows, []string{
+				p.ID,
+				don.DonID,
+				strconv.Itoa(len(don.Nodes)),
+				strconv.Itoa(connected),
+				strconv.Itoa(don.PendingHandshakes),
+			})
+			for _, handler := range don.Handlers {
+				row := []string{p.ID, don.DonID, handler.Name, "n/a", "n/a", "n/a", "n/a"}
+				if handler.PendingRequests != nil {
+					row[3] = strconv.Itoa(*handler.PendingRequests)
+				}
+				if cache := handler.ResponseCache; cache != nil {
+					row[4] = strconv.Itoa(cache.Entries)
+					row[5] = strconv.FormatUint(cache.Hits, 10)
+					row[6] = strconv.FormatUint(cache.Misses, 10)
+				}
+				handlerRows = append(handlerRows, row)
+			}
+		}
+	}
+
+	if _, err := rt.Write([]byte("DONs\n")); err != nil {
+		return err
+	}
+	renderList(gatewayDONsTableHeaders, donRows, rt.Writer)
+	if _, err := rt.Write([]byte("\nNodes\n")); err != nil {
+		return err
+	}
+	renderList(gatewayNodesTableHeaders, nodeRows, rt.Writer)
+	if _, err := rt.Write([]byte("\nHandlers\n")); err != nil {
+		return err
+	}
+	renderList(gatewayHandlersTableHeaders, handlerRows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// GatewayStatus renders the status of all running gateways, or of the
+// gateway of one job
+func (s *Shell) GatewayStatus(c *cli.Context) (err error) {
+	jobID := c.String("job")
+	uri := "/v2/gateways"
+	var dst any = &GatewayPresenters{}
+	if jobID != "" {
+		uri += "/" + url.PathEscape(jobID)
+		dst = &GatewayPresenter{}
+	}
+	resp, err := s.HTTP.Get(s.ctx(), uri, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, dst)
+}
+
+// DisconnectGatewayNode closes the connection of a node of a DON to the
+// gateway of a job
+func (s *Shell) DisconnectGatewayNode(c *cli.Context) (err error) {
+	jobID, donID, address := c.String("job"), c.String("don"), c.String("address")
+	if jobID == "" || donID == "" || address == "" {
+		return s.errorOut(errors.New("job, don and address flags must all be set"))
+	}
+
+	uri := fmt.Sprintf("/v2/gateways/%s/dons/%s/nodes/%s", url.PathEscape(jobID), url.PathEscape(donID), url.PathEscape(address))
+	resp, err := s.HTTP.Delete(s.ctx(), uri)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+	if _, err = s.parseResponse(resp); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("Node %v of DON %v disconnected\n", address, donID)
+	return nil
+}
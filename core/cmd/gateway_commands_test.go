@@ -0,0 +1,79 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestGatewayPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		connectedAt = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		pending     = 3
+		buffer      = bytes.NewBufferString("")
+		r           = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.GatewayPresenter{
+		JAID: cmd.JAID{ID: "7"},
+		GatewayResource: presenters.GatewayResource{
+			JAID: presenters.NewJAID("7"),
+			DONs: []presenters.GatewayDONResource{{
+				DonID: "my_don",
+				Nodes: []presenters.GatewayNodeResource{
+					{Address: "0x01", Name: "node one", Connected: true, ConnectedAt: &connectedAt, LastHeartbeat: &connectedAt},
+					{Address: "0x02", Name: "node two"},
+				},
+				PendingHandshakes: 1,
+				Handlers: []presenters.GatewayHandlerResource{
+					{Name: "http-capabilities", PendingRequests: &pending, ResponseCache: &presenters.GatewayCacheStatsResource{Entries: 4, Hits: 5, Misses: 6}},
+				},
+			}},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "my_don")
+	assert.Contains(t, output, "node one")
+	assert.Contains(t, output, "0x02")
+	assert.Contains(t, output, connectedAt.Format(time.RFC3339))
+	assert.Contains(t, output, "http-capabilities")
+}
+
+func TestShell_GatewayStatus(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.GatewayStatus, set, "")
+	require.NoError(t, client.GatewayStatus(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+	assert.Empty(t, *r.Renders[0].(*cmd.GatewayPresenters))
+
+	require.NoError(t, set.Set("job", "1"))
+	require.ErrorContains(t, client.GatewayStatus(cli.NewContext(nil, set, nil)), "no gateway running")
+}
+
+func TestShell_DisconnectGatewayNode(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.DisconnectGatewayNode, set, "")
+	require.NoError(t, set.Set("job", "1"))
+	require.NoError(t, set.Set("don", "my_don"))
+	require.NoError(t, set.Set("address", "0x01"))
+	require.ErrorContains(t, client.DisconnectGatewayNode(cli.NewContext(nil, set, nil)), "no gateway running")
+}
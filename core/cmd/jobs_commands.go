@@ -6,6 +6,10 @@ import (
 	stderrors "errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +17,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -45,11 +50,97 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Delete a job",
 			Action: s.DeleteJob,
 		},
+		{
+			Name:   "pause",
+			Usage:  "Stop the services of a job without deleting it",
+			Action: s.PauseJob,
+		},
+		{
+			Name:   "resume",
+			Usage:  "Restart the services of a paused job",
+			Action: s.ResumeJob,
+		},
+		{
+			Name:   "graph",
+			Usage:  "Render the pipeline graph of a job, including implicit dependencies between tasks",
+			Action: s.ShowJobGraph,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "format",
+					Usage: "output format: dot, mermaid or json",
+					Value: string(pipeline.GraphFormatDOT),
+				},
+				cli.StringFlag{
+					Name:  "run",
+					Usage: "id of a pipeline run of the job whose task timings and errors are overlaid on the graph",
+				},
+			},
+		},
+		{
+			Name:   "revisions",
+			Usage:  "List the recorded revisions of a job's spec",
+			Action: s.ListJobRevisions,
+		},
+		{
+			Name:   "diff",
+			Usage:  "Show the unified diff between two revisions of a job's spec",
+			Action: s.DiffJobRevisions,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "from",
+					Usage: "revision to diff from, defaults to the revision before --to",
+				},
+				cli.StringFlag{
+					Name:  "to",
+					Usage: "revision to diff to, defaults to the latest revision",
+				},
+			},
+		},
+		{
+			Name:   "rollback",
+			Usage:  "Replace a job with the spec of one of its earlier revisions",
+			Action: s.RollbackJob,
+		},
+		{
+			Name:   "export",
+			Usage:  "Write a tar bundle of jobs, with the bridges and external initiators they reference, to stdout",
+			Action: s.ExportJobs,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "all",
+					Usage: "export all jobs instead of the given job ids",
+				},
+			},
+		},
+		{
+			Name:   "import",
+			Usage:  "Create all jobs of a bundle written by export, or none of them if any fails",
+			Action: s.ImportJobs,
+		},
 		{
 			Name:   "run",
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "replay-run",
+			Usage:  "Replay a pipeline run locally against the bridge, http and ethtx responses recorded in a fixture file, and report the tasks whose results differ",
+			Action: s.ReplayPipelineRun,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "fixture",
+					Usage: "path to the fixture file, which is recorded from the persisted run on the node if it does not exist yet. An existing fixture is replayed without contacting the node",
+				},
+				cli.BoolFlag{
+					Name:  "record",
+					Usage: "record the fixture from the persisted run on the node even if the file already exists",
+				},
+				cli.BoolFlag{
+					Name:  "rerecord-live",
+					Usage: "instead of recording the persisted run, re-execute it on the node against the live bridge and http endpoints, and record the responses and results of the re-execution",
+				},
+			},
+		},
 	}
 }
 
@@ -237,6 +328,166 @@ func (s *Shell) ShowJob(c *cli.Context) (err error) {
 	return s.renderAPIResponse(resp, &JobPresenter{})
 }
 
+// ShowJobGraph writes the pipeline graph of a job to stdout
+func (s *Shell) ShowJobGraph(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	query := url.Values{"format": {c.String("format")}}
+	if runID := c.String("run"); runID != "" {
+		query.Set("runID", runID)
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/"+c.Args().First()+"/graph?"+query.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(httpError(resp))
+	}
+
+	_, err = io.Copy(os.Stdout, resp.Body)
+	return err
+}
+
+// JobRevisionPresenter wraps the JSONAPI job revision resource and adds rendering functionality
+type JobRevisionPresenter struct {
+	JAID
+	presenters.JobRevisionResource
+}
+
+// JobRevisionPresenters implements TableRenderer for a slice of JobRevisionPresenter
+type JobRevisionPresenters []JobRevisionPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobRevisionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Revision", "Job ID", "Created At"})
+	for _, p := range ps {
+		table.Append([]string{p.ID, strconv.Itoa(int(p.JobID)), p.CreatedAt.Format(time.RFC3339)})
+	}
+
+	render("Job Revisions", table)
+	return nil
+}
+
+// ListJobRevisions lists the revisions of a job
+func (s *Shell) ListJobRevisions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/"+c.Args().First()+"/revisions")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobRevisionPresenters{})
+}
+
+// DiffJobRevisions writes the unified diff between two revisions of a job to stdout
+func (s *Shell) DiffJobRevisions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	query := url.Values{}
+	for _, name := range []string{"from", "to"} {
+		if rev := c.String(name); rev != "" {
+			query.Set(name, rev)
+		}
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/"+c.Args().First()+"/revisions/diff?"+query.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(httpError(resp))
+	}
+
+	_, err = io.Copy(os.Stdout, resp.Body)
+	return err
+}
+
+// ExportJobs writes a tar bundle of jobs and their bridges and external initiators to stdout
+func (s *Shell) ExportJobs(c *cli.Context) (err error) {
+	if c.Bool("all") == c.Args().Present() {
+		return s.errorOut(errors.New("must pass either --all or the ids of the jobs to export"))
+	}
+	query := url.Values{"id": []string(c.Args())}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/export?"+query.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(httpError(resp))
+	}
+
+	_, err = io.Copy(os.Stdout, resp.Body)
+	return err
+}
+
+// ImportJobs creates the jobs of a bundle written by ExportJobs
+func (s *Shell) ImportJobs(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the path of the bundle"))
+	}
+	f, err := os.Open(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer f.Close()
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/import", f)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenters{})
+}
+
+// RollbackJob replaces a job with the spec of one of its earlier revisions
+func (s *Shell) RollbackJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the id of the job and the revision to roll back to"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().Get(0)+"/rollback/"+c.Args().Get(1), nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, fmt.Sprintf("Job rolled back to revision %s", c.Args().Get(1)))
+}
+
 // CreateJob creates a job
 // Valid input is a TOML string or a path to TOML file
 func (s *Shell) CreateJob(c *cli.Context) (err error) {
@@ -298,6 +549,37 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// PauseJob stops the services of a job, keeping its spec and run history
+func (s *Shell) PauseJob(c *cli.Context) error {
+	return s.setJobPaused(c, true)
+}
+
+// ResumeJob restarts the services of a paused job
+func (s *Shell) ResumeJob(c *cli.Context) error {
+	return s.setJobPaused(c, false)
+}
+
+func (s *Shell) setJobPaused(c *cli.Context, paused bool) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id"))
+	}
+	buf, err := json.Marshal(web.PatchJobRequest{Paused: &paused})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Patch(s.ctx(), "/v2/jobs/"+c.Args().First(), bytes.NewReader(buf))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{})
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -317,3 +599,107 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineRunReplayPresenter wraps the JSONAPI replay resource and adds rendering functionality
+type PipelineRunReplayPresenter struct {
+	JAID
+	presenters.PipelineRunReplayResource
+}
+
+// RenderTable implements TableRenderer
+func (p PipelineRunReplayPresenter) RenderTable(rt RendererTable) error {
+	if len(p.Diffs) == 0 {
+		_, err := rt.Write([]byte(fmt.Sprintf("Replay of run %s matches the recorded run\n", p.ID)))
+		return err
+	}
+
+	table := rt.newTable([]string{"Task", "Expected Output", "Actual Output", "Expected Error", "Actual Error"})
+	for _, diff := range p.Diffs {
+		table.Append([]string{diff.DotID, diff.ExpectedOutput, diff.ActualOutput, diff.ExpectedError, diff.ActualError})
+	}
+
+	render(fmt.Sprintf("Replay of run %s differs from the recorded run", p.ID), table)
+	return nil
+}
+
+// ReplayPipelineRun replays a pipeline run locally against a fixture recorded from it. The node is only contacted to
+// record the fixture.
+func (s *Shell) ReplayPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the pipeline run to replay"))
+	}
+	runID := c.Args().First()
+	fixturePath := c.String("fixture")
+	if fixturePath == "" {
+		return s.errorOut(errors.New("must pass the path of the fixture file with --fixture"))
+	}
+
+	if c.Bool("record") && c.Bool("rerecord-live") {
+		return s.errorOut(errors.New("--record and --rerecord-live are mutually exclusive"))
+	}
+	if _, err = os.Stat(fixturePath); c.Bool("record") || c.Bool("rerecord-live") || os.IsNotExist(err) {
+		if err = s.recordRunFixture(runID, fixturePath, c.Bool("rerecord-live")); err != nil {
+			return s.errorOut(err)
+		}
+	}
+
+	fixtureJSON, err := os.ReadFile(fixturePath)
+	if err != nil {
+		return s.errorOut(errors.Wrapf(err, "Could not read %v", fixturePath))
+	}
+	var fixture pipeline.RunFixture
+	if err = json.Unmarshal(fixtureJSON, &fixture); err != nil {
+		return s.errorOut(errors.Wrapf(err, "Could not parse fixture %v", fixturePath))
+	}
+	if fmt.Sprint(fixture.RunID) != runID {
+		return s.errorOut(errors.Errorf("fixture %v was recorded from run %d, not run %s", fixturePath, fixture.RunID, runID))
+	}
+
+	runner := pipeline.NewReplayRunner(s.Config.JobPipeline(), s.Config.WebServer(), s.Logger)
+	run, trrs, err := pipeline.ReplayRun(s.ctx(), runner, fixture)
+	if err != nil {
+		return s.errorOut(errors.Wrapf(err, "Could not replay run %s", runID))
+	}
+	diffs, err := fixture.Diff(trrs)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	return s.errorOut(s.Render(&PipelineRunReplayPresenter{
+		JAID:                      NewJAID(runID),
+		PipelineRunReplayResource: presenters.NewPipelineRunReplayResource(fixture.RunID, *run, diffs, s.Logger),
+	}))
+}
+
+func (s *Shell) recordRunFixture(runID string, fixturePath string, live bool) (err error) {
+	var resp *http.Response
+	if live {
+		resp, err = s.HTTP.Post(s.ctx(), "/v2/pipeline/runs/"+runID+"/fixture/live", nil)
+	} else {
+		resp, err = s.HTTP.Get(s.ctx(), "/v2/pipeline/runs/"+runID+"/fixture")
+	}
+	if err != nil {
+		return errors.Wrap(err, "Could not make HTTP request")
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error recording fixture: %w", httpError(resp))
+	}
+
+	fixtureJSON, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "Could not read response body")
+	}
+
+	if err = utils.WriteFileWithMaxPerms(fixturePath, fixtureJSON, 0o600); err != nil {
+		return errors.Wrapf(err, "Could not write %v", fixturePath)
+	}
+
+	_, err = os.Stderr.WriteString(fmt.Sprintf("Recorded run %s to %s\n", runID, fixturePath))
+	return err
+}
@@ -3,8 +3,11 @@ package cmd_test
 import (
 	"bytes"
 	_ "embed"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -20,8 +23,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -459,3 +465,52 @@ func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	require.NoError(t, err)
 	require.Len(t, jobs, expected)
 }
+
+func TestShell_ReplayPipelineRun_Offline(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, nil)
+	lggr := logger.TestLogger(t)
+	runner := pipeline.NewReplayRunner(cfg.JobPipeline(), cfg.WebServer(), lggr)
+	spec := pipeline.Spec{JobID: 1, DotDagSource: `
+ds1       [type=bridge name=price_adapter];
+ds1_parse [type=jsonparse path="data,price"];
+
+ds1 -> ds1_parse;
+`}
+	run, _, err := pipeline.SimulateRun(testutils.Context(t), runner, spec, pipeline.NewVarsFrom(nil), pipeline.TaskStubs{
+		"ds1": {Value: `{"data":{"price":100}}`},
+	})
+	require.NoError(t, err)
+	run.ID = 42
+	fixture, err := pipeline.NewRunFixture(*run)
+	require.NoError(t, err)
+	fixtureJSON, err := json.Marshal(fixture)
+	require.NoError(t, err)
+	fixturePath := filepath.Join(t.TempDir(), "fixture.json")
+	require.NoError(t, os.WriteFile(fixturePath, fixtureJSON, 0o600))
+
+	// without an HTTP client, any request to the node would panic
+	r := &cltest.RendererMock{}
+	client := &cmd.Shell{Config: cfg, Logger: lggr, Renderer: r}
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ReplayPipelineRun, set, "")
+	require.NoError(t, set.Set("fixture", fixturePath))
+	require.NoError(t, set.Parse([]string{"42"}))
+	require.NoError(t, client.ReplayPipelineRun(cli.NewContext(nil, set, nil)))
+
+	require.Len(t, r.Renders, 1)
+	replay := r.Renders[0].(*cmd.PipelineRunReplayPresenter)
+	assert.Equal(t, "42", replay.ID)
+	assert.Empty(t, replay.Diffs)
+	assert.Len(t, replay.Run.TaskRuns, 2)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ReplayPipelineRun, set, "")
+	require.NoError(t, set.Set("fixture", fixturePath))
+	require.NoError(t, set.Set("record", "true"))
+	require.NoError(t, set.Set("rerecord-live", "true"))
+	require.NoError(t, set.Parse([]string{"42"}))
+	require.EqualError(t, client.ReplayPipelineRun(cli.NewContext(nil, set, nil)), "--record and --rerecord-live are mutually exclusive")
+}
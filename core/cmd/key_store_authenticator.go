@@ -3,11 +3,13 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/shamir"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -79,3 +81,41 @@ func (auth TerminalKeyStoreAuthenticator) promptNewPassword() (string, error) {
 		return password, nil
 	}
 }
+
+// CombinePasswordShares recovers the key store password from shares of it,
+// read from shareFiles and, if prompt is set, entered by the user until the
+// quorum is reached.
+func (auth TerminalKeyStoreAuthenticator) CombinePasswordShares(shareFiles []string, prompt bool) (string, error) {
+	var shares []shamir.Share
+	for _, file := range shareFiles {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return "", errors.Wrap(err, "error reading password share from file")
+		}
+		share, err := shamir.ParseShare(string(b))
+		if err != nil {
+			return "", errors.Wrapf(err, "error parsing password share from file %q", file)
+		}
+		shares = append(shares, share)
+	}
+	if prompt {
+		if !auth.Prompter.IsTerminal() {
+			return "", errors.New("password shares can only be prompted for from a terminal")
+		}
+		for len(shares) == 0 || len(shares) < shares[0].Threshold {
+			text := auth.Prompter.PasswordPrompt(fmt.Sprintf("Enter key store password share %d:", len(shares)+1))
+			clearLine()
+			share, err := shamir.ParseShare(text)
+			if err != nil {
+				fmt.Printf("%v. Please try again... ", err)
+				continue
+			}
+			shares = append(shares, share)
+		}
+	}
+	password, err := shamir.Combine(shares)
+	if err != nil {
+		return "", errors.Wrap(err, "error combining password shares")
+	}
+	return string(password), nil
+}
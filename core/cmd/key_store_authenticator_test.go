@@ -0,0 +1,86 @@
+package cmd_test
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/shamir"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestTerminalKeyStoreAuthenticator_CombinePasswordShares(t *testing.T) {
+	t.Parallel()
+
+	shares, err := shamir.Split([]byte(cltest.Password), 3, 2)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	var files []string
+	for _, share := range shares {
+		file := filepath.Join(dir, fmt.Sprintf("share%d", share.X))
+		require.NoError(t, os.WriteFile(file, []byte(share.String()+"\n"), 0o600))
+		files = append(files, file)
+	}
+
+	t.Run("from files", func(t *testing.T) {
+		auth := cmd.TerminalKeyStoreAuthenticator{Prompter: &cltest.MockCountingPrompter{T: t}}
+		password, err := auth.CombinePasswordShares(files[1:], false)
+		require.NoError(t, err)
+		assert.Equal(t, cltest.Password, password)
+	})
+
+	t.Run("below quorum", func(t *testing.T) {
+		auth := cmd.TerminalKeyStoreAuthenticator{Prompter: &cltest.MockCountingPrompter{T: t}}
+		_, err := auth.CombinePasswordShares(files[:1], false)
+		require.ErrorIs(t, err, shamir.ErrNotEnoughShares)
+	})
+
+	t.Run("from a file and the prompt", func(t *testing.T) {
+		prompter := &cltest.MockCountingPrompter{T: t, EnteredStrings: []string{"not a share", shares[2].String()}}
+		auth := cmd.TerminalKeyStoreAuthenticator{Prompter: prompter}
+		password, err := auth.CombinePasswordShares(files[:1], true)
+		require.NoError(t, err)
+		assert.Equal(t, cltest.Password, password)
+		assert.Equal(t, 2, prompter.Count)
+	})
+
+	t.Run("prompt needs a terminal", func(t *testing.T) {
+		auth := cmd.TerminalKeyStoreAuthenticator{Prompter: &cltest.MockCountingPrompter{T: t, NotTerminal: true}}
+		_, err := auth.CombinePasswordShares(nil, true)
+		require.Error(t, err)
+	})
+}
+
+func TestShell_SplitPassword(t *testing.T) {
+	t.Parallel()
+
+	client := &cmd.Shell{}
+	dir := filepath.Join(t.TempDir(), "shares")
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SplitPassword, set, "")
+	require.NoError(t, set.Set("password", "../internal/fixtures/correct_password.txt"))
+	require.NoError(t, set.Set("shares", "3"))
+	require.NoError(t, set.Set("threshold", "2"))
+	require.NoError(t, set.Set("output-dir", dir))
+	require.NoError(t, client.SplitPassword(cli.NewContext(nil, set, nil)))
+
+	auth := cmd.TerminalKeyStoreAuthenticator{Prompter: &cltest.MockCountingPrompter{T: t}}
+	password, err := auth.CombinePasswordShares([]string{
+		filepath.Join(dir, "password.share.1"),
+		filepath.Join(dir, "password.share.3"),
+	}, false)
+	require.NoError(t, err)
+	expected, err := utils.PasswordFromFile("../internal/fixtures/correct_password.txt")
+	require.NoError(t, err)
+	assert.Equal(t, expected, password)
+}
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initKeysBackupSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "backup",
+			Usage: format(`Back up every key in the keystore, and the eth key states, to a single file encrypted with a backup passphrase.`),
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "passphrase, p",
+					Usage: "`FILE` containing the passphrase to encrypt the backup with, at least 16 characters long (required)",
+				},
+				cli.StringFlag{
+					Name:  "out, o",
+					Usage: "`FILE` where the backup will be saved (required)",
+				},
+			},
+			Action: s.BackupKeys,
+		},
+		{
+			Name:  "restore",
+			Usage: format(`Restore the keys in a backup file which are missing from the keystore. Existing keys are left untouched.`),
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "passphrase, p",
+					Usage: "`FILE` containing the passphrase the backup was encrypted with (required)",
+				},
+			},
+			Action: s.RestoreKeys,
+		},
+	}
+}
+
+type KeystoreRestorePresenter struct {
+	JAID
+	presenters.KeystoreRestoreResource
+}
+
+// RenderTable implements TableRenderer
+func (p *KeystoreRestorePresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"Backup SHA256", "Eth key states"}
+	row := []string{p.ID, strconv.Itoa(p.EthKeyStates)}
+
+	fields := make([]string, 0, len(p.Keys))
+	for field := range p.Keys {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	for _, field := range fields {
+		headers = append(headers, field+" keys")
+		row = append(row, strings.Join(p.Keys[field], "\n"))
+	}
+
+	if _, err := rt.Write([]byte("🔑 Restored Keys\n")); err != nil {
+		return err
+	}
+	renderList(headers, [][]string{row}, rt.Writer)
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// BackupKeys saves an encrypted backup of the whole keystore
+func (s *Shell) BackupKeys(c *cli.Context) (err error) {
+	passphraseFile := c.String("passphrase")
+	if len(passphraseFile) == 0 {
+		return s.errorOut(errors.New("Must specify --passphrase/-p flag"))
+	}
+	passphrase, err := os.ReadFile(passphraseFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read passphrase file"))
+	}
+
+	filepath := c.String("out")
+	if len(filepath) == 0 {
+		return s.errorOut(errors.New("Must specify --out/-o flag"))
+	}
+
+	request, err := json.Marshal(web.KeystoreBackupRequest{Passphrase: strings.TrimSpace(string(passphrase))})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/keystore/backup", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error backing up keys: %w", httpError(resp)))
+	}
+
+	backup, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read response body"))
+	}
+
+	err = utils.WriteFileWithMaxPerms(filepath, backup, 0o600)
+	if err != nil {
+		return s.errorOut(errors.Wrapf(err, "Could not write %v", filepath))
+	}
+
+	_, err = os.Stderr.WriteString(fmt.Sprintf("🔑 Backed up keystore to %s\n", filepath))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	return nil
+}
+
+// RestoreKeys adds the keys in a backup file to the keystore
+func (s *Shell) RestoreKeys(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("Must pass the filepath of the backup to be restored"))
+	}
+
+	passphraseFile := c.String("passphrase")
+	if len(passphraseFile) == 0 {
+		return s.errorOut(errors.New("Must specify --passphrase/-p flag"))
+	}
+	passphrase, err := os.ReadFile(passphraseFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read passphrase file"))
+	}
+
+	backup, err := os.ReadFile(c.Args().Get(0))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.KeystoreRestoreRequest{
+		Passphrase: strings.TrimSpace(string(passphrase)),
+		Backup:     backup,
+	})
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "invalid backup"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/keystore/restore", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &KeystoreRestorePresenter{}, "🔑 Restored keystore backup")
+}
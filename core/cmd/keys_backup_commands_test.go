@@ -0,0 +1,83 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeystoreRestorePresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+		p      = cmd.KeystoreRestorePresenter{
+			KeystoreRestoreResource: presenters.KeystoreRestoreResource{
+				JAID:         presenters.NewJAID("digest"),
+				Keys:         map[string][]string{"P2P": {"p2p-a", "p2p-b"}, "CSA": {"csa-a"}},
+				EthKeyStates: 2,
+			},
+		}
+	)
+	p.ID = "digest"
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "digest")
+	assert.Contains(t, output, "CSA keys")
+	assert.Contains(t, output, "p2p-a")
+	assert.Contains(t, output, "p2p-b")
+}
+
+func TestShell_BackupRestoreKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	key, err := app.GetKeyStore().P2P().Create(ctx)
+	require.NoError(t, err)
+
+	backupFile := filepath.Join(t.TempDir(), "bundle.json")
+
+	set := flag.NewFlagSet("test keys backup", 0)
+	flagSetApplyFromAction(client.BackupKeys, set, "")
+	require.NoError(t, set.Set("passphrase", "../internal/fixtures/incorrect_password.txt"))
+	require.NoError(t, set.Set("out", backupFile))
+	require.NoError(t, client.BackupKeys(cli.NewContext(nil, set, nil)))
+
+	info, err := os.Stat(backupFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	_, err = app.GetKeyStore().P2P().Delete(ctx, key.PeerID())
+	require.NoError(t, err)
+
+	set = flag.NewFlagSet("test keys restore", 0)
+	flagSetApplyFromAction(client.RestoreKeys, set, "")
+	require.NoError(t, set.Parse([]string{backupFile}))
+	require.NoError(t, set.Set("passphrase", "../internal/fixtures/correct_password.txt"))
+	require.Error(t, client.RestoreKeys(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test keys restore", 0)
+	flagSetApplyFromAction(client.RestoreKeys, set, "")
+	require.NoError(t, set.Parse([]string{backupFile}))
+	require.NoError(t, set.Set("passphrase", "../internal/fixtures/incorrect_password.txt"))
+	require.NoError(t, client.RestoreKeys(cli.NewContext(nil, set, nil)))
+
+	_, err = app.GetKeyStore().P2P().Get(key.PeerID())
+	require.NoError(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 
 	"github.com/pkg/errors"
@@ -28,6 +29,21 @@ func initOCR2KeysSubCmd(s *Shell) cli.Command {
 				Usage:  format(`Create an OCR2 key bundle, encrypted with password from the password file, and store it in the database`),
 				Action: s.CreateOCR2KeyBundle,
 			},
+			{
+				Name:  "add-external",
+				Usage: format(`Add an EVM OCR2 key bundle whose signing keys are held by the external signer. Only their handles, and the config encryption key, are stored in the database.`),
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "onchain-handle",
+						Usage: "handle of the secp256k1 onchain signing key in the external signer (required)",
+					},
+					cli.StringFlag{
+						Name:  "offchain-handle",
+						Usage: "handle of the ed25519 offchain signing key in the external signer (required)",
+					},
+				},
+				Action: s.AddExternalOCR2KeyBundle,
+			},
 			{
 				Name:  "delete",
 				Usage: format(`Deletes the encrypted OCR2 key bundle matching the given ID`),
@@ -163,6 +179,33 @@ func (s *Shell) CreateOCR2KeyBundle(c *cli.Context) error {
 	return s.renderAPIResponse(resp, &presenter, "Created OCR key bundle")
 }
 
+// AddExternalOCR2KeyBundle adds an OCR2 key bundle whose signing keys are held by the external signer
+func (s *Shell) AddExternalOCR2KeyBundle(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(
+			errors.Errorf(`must pass the type to add, options are: %s`, chaintype.EVM),
+		)
+	}
+	onchainHandle, offchainHandle := c.String("onchain-handle"), c.String("offchain-handle")
+	if onchainHandle == "" || offchainHandle == "" {
+		return s.errorOut(errors.New("Must specify --onchain-handle and --offchain-handle flags"))
+	}
+	addURL := url.URL{Path: "/v2/keys/ocr2/" + c.Args().Get(0) + "/external"}
+	addURL.RawQuery = url.Values{"onchainHandle": {onchainHandle}, "offchainHandle": {offchainHandle}}.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), addURL.String(), nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	var presenter OCR2KeyBundlePresenter
+	return s.renderAPIResponse(resp, &presenter, "Added external OCR key bundle")
+}
+
 // DeleteOCR2KeyBundle deletes an OCR2 key bundle
 func (s *Shell) DeleteOCR2KeyBundle(c *cli.Context) error {
 	if !c.Args().Present() {
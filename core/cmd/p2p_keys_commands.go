@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 
 	"github.com/pkg/errors"
@@ -26,6 +27,17 @@ func initP2PKeysSubCmd(s *Shell) cli.Command {
 				Usage:  format(`Create a p2p key, encrypted with password from the password file, and store it in the database.`),
 				Action: s.CreateP2PKey,
 			},
+			{
+				Name:  "add-external",
+				Usage: format(`Add a P2P key held by the external signer. Only its handle is stored in the database.`),
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "handle",
+						Usage: "handle of the key in the external signer, e.g. its PKCS#11 label (required)",
+					},
+				},
+				Action: s.AddExternalP2PKey,
+			},
 			{
 				Name:  "delete",
 				Usage: format(`Delete the encrypted P2P key by id`),
@@ -153,6 +165,27 @@ func (s *Shell) CreateP2PKey(_ *cli.Context) (err error) {
 	return s.renderAPIResponse(resp, &P2PKeyPresenter{}, "Created P2P keypair")
 }
 
+// AddExternalP2PKey adds a P2P key held by the external signer
+func (s *Shell) AddExternalP2PKey(c *cli.Context) (err error) {
+	handle := c.String("handle")
+	if handle == "" {
+		return s.errorOut(errors.New("Must specify --handle flag"))
+	}
+	addURL := url.URL{Path: "/v2/keys/p2p/external"}
+	addURL.RawQuery = url.Values{"handle": {handle}}.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), addURL.String(), nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &P2PKeyPresenter{}, "Added external P2P keypair")
+}
+
 // DeleteP2PKey deletes a P2P key,
 // key ID must be passed
 func (s *Shell) DeleteP2PKey(c *cli.Context) (err error) {
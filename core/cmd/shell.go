@@ -45,6 +45,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
 	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
@@ -171,6 +172,7 @@ type Shell struct {
 	configFilesIsSet bool
 	secretsFiles     []string
 	secretsFileIsSet bool
+	externalSigner   signer.Signer // initialized in BeforeNode
 
 	LDB      pg.LockedDB        // initialized in BeforeNode
 	DS       sqlutil.DataSource // initialized in BeforeNode
@@ -222,7 +224,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 	unrestrictedClient := clhttp.NewUnrestrictedClient()
 
 	// Configure and optionally start the audit log forwarder service
-	auditLogger, err := audit.NewAuditLogger(appLggr, cfg.AuditLogger())
+	auditLogger, err := audit.NewAuditLogger(appLggr, cfg.AuditLogger(), ds)
 	if err != nil {
 		return nil, err
 	}
@@ -37,9 +37,14 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/v2/core/build"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/shamir"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer/pkcs11"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer/remote"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/shutdown"
@@ -71,6 +76,14 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 					Name:  "password, p",
 					Usage: "text file holding the password for the node's account",
 				},
+				cli.StringSliceFlag{
+					Name:  "password-share, ps",
+					Usage: "text file holding a share of the password for the node's account, as made by split-password; repeat for each share",
+				},
+				cli.BoolFlag{
+					Name:  "prompt-password-shares",
+					Usage: "prompt for shares of the password for the node's account until the quorum is reached",
+				},
 				cli.StringFlag{
 					Name:  "vrfpassword, vp",
 					Usage: "text file holding the password for the vrf keys; enables Chainlink VRF oracle",
@@ -152,6 +165,33 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 				return nil
 			},
 		},
+		{
+			Name:   "split-password",
+			Usage:  "Split the password for the node's account into shares, a quorum of which unlocks the node",
+			Action: s.SplitPassword,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "password, p",
+					Usage:    "text file holding the password for the node's account",
+					Required: true,
+				},
+				cli.IntFlag{
+					Name:     "shares, n",
+					Usage:    "number of shares to split the password into",
+					Required: true,
+				},
+				cli.IntFlag{
+					Name:     "threshold, k",
+					Usage:    "number of shares needed to unlock the node, at least 2",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "output-dir, o",
+					Usage:    "directory to write the share files to, one per share",
+					Required: true,
+				},
+			},
+		},
 		{
 			Name:   "validate",
 			Usage:  "Validate the TOML configuration and secrets that are passed as flags to the `node` command. Prints the full effective configuration, with defaults included",
@@ -810,6 +850,31 @@ func (s *Shell) ConfigFileValidate(_ *cli.Context) error {
 	return nil
 }
 
+// SplitPassword writes the shares of the node's password to files, for
+// start --password-share.
+func (s *Shell) SplitPassword(c *cli.Context) error {
+	password, err := utils.PasswordFromFile(c.String("password"))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error reading password from file"))
+	}
+	shares, err := shamir.Split([]byte(password), c.Int("shares"), c.Int("threshold"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	dir := c.String("output-dir")
+	if err = utils.EnsureDirAndMaxPerms(dir, 0o700); err != nil {
+		return s.errorOut(err)
+	}
+	for _, share := range shares {
+		file := filepath.Join(dir, fmt.Sprintf("password.share.%d", share.X))
+		if err = utils.WriteFileWithMaxPerms(file, []byte(share.String()+"\n"), 0o600); err != nil {
+			return s.errorOut(errors.Wrapf(err, "could not write %s", file))
+		}
+	}
+	fmt.Printf("Wrote %d password shares to %s. Any %d of them unlock the node.\n", len(shares), dir, c.Int("threshold"))
+	return nil
+}
+
 // ValidateDB is a BeforeFunc to run prior to database sub commands
 // the ctx must be that of the last subcommand to be validated
 func (s *Shell) validateDB(c *cli.Context) error {
@@ -1096,6 +1161,17 @@ func (s *Shell) beforeNode(c *cli.Context) error {
 		vrfpwd = &p
 	}
 
+	if shareFiles, prompt := c.StringSlice("password-share"), c.Bool("prompt-password-shares"); len(shareFiles) > 0 || prompt {
+		if pwd != nil {
+			return errors.New("password and password shares are mutually exclusive")
+		}
+		p, err := s.KeyStoreAuthenticator.CombinePasswordShares(shareFiles, prompt)
+		if err != nil {
+			return err
+		}
+		pwd = &p
+	}
+
 	s.Config.SetPasswords(pwd, vrfpwd)
 
 	lggr := s.Logger
@@ -1119,7 +1195,12 @@ func (s *Shell) beforeNode(c *cli.Context) error {
 	}
 
 	ds := sqlutil.WrapDataSource(db, lggr, sqlutil.TimeoutHook(cfg.Database().DefaultQueryTimeout), sqlutil.MonitorHook(cfg.Database().LogSQL))
-	keyStore := keystore.New(ds, utils.GetScryptParams(cfg), lggr.Infof)
+	extSigner, err := newExternalSigner(cfg.ExternalSigner())
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "opening external signer"))
+	}
+	s.externalSigner = extSigner
+	keyStore := keystore.NewWithSigner(ds, utils.GetScryptParams(cfg), extSigner, lggr.Infof)
 	s.DS = ds
 	s.KeyStore = keyStore
 
@@ -1169,6 +1250,34 @@ func (s *Shell) BeforeNode(c *cli.Context) error {
 	return nil
 }
 
+// newExternalSigner opens the external signer selected by cfg, if any.
+func newExternalSigner(cfg config.ExternalSigner) (signer.Signer, error) {
+	switch cfg.Provider() {
+	case "":
+		return nil, nil
+	case "pkcs11":
+		s, err := pkcs11.New(pkcs11.Config{
+			Module:     cfg.PKCS11().Module(),
+			TokenLabel: cfg.PKCS11().TokenLabel(),
+			PIN:        cfg.PKCS11().PIN(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	case "remote":
+		s, err := remote.New(remote.Config{
+			URL:         cfg.Remote().URL(),
+			TLSCertPath: cfg.Remote().TLSCertPath(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+	return nil, errors.Errorf("unknown external signer provider %q", cfg.Provider())
+}
+
 // afterNode is a thread-safe helper method to close the database and logger.
 // This is used in multiple places: shutdown handler, signal handler, and cleanup.
 // It uses sync.Once to ensure cleanup happens exactly once, even if called concurrently.
@@ -1179,6 +1288,12 @@ func (s *Shell) afterNode(lggr logger.SugaredLogger) {
 		}
 		lggr.Debug("Closed DB")
 
+		if s.externalSigner != nil {
+			if err := s.externalSigner.Close(); err != nil {
+				lggr.Errorf("Failed to close external signer: %v", err)
+			}
+		}
+
 		if err := s.CloseLogger(); err != nil {
 			log.Printf("Failed to close Logger: %v", err)
 		}
@@ -169,7 +169,7 @@ func TestTerminalAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 			ctx := testutils.Context(t)
 			db := pgtest.NewSqlxDB(t)
 			lggr := logger.TestLogger(t)
-			orm := localauth.NewORM(db, time.Minute, lggr, audit.NoopLogger)
+			orm := localauth.NewORM(db, time.Minute, sessions.Roles{}, lggr, audit.NoopLogger)
 
 			mock := &cltest.MockCountingPrompter{T: t, EnteredStrings: test.enteredStrings, NotTerminal: !test.isTerminal}
 			tai := cmd.NewPromptingAPIInitializer(mock)
@@ -200,7 +200,7 @@ func TestTerminalAPIInitializer_InitializeWithExistingAPIUser(t *testing.T) {
 	ctx := testutils.Context(t)
 	db := pgtest.NewSqlxDB(t)
 	lggr := logger.TestLogger(t)
-	orm := localauth.NewORM(db, time.Minute, lggr, audit.NoopLogger)
+	orm := localauth.NewORM(db, time.Minute, sessions.Roles{}, lggr, audit.NoopLogger)
 
 	// Clear out fixture users/users created from the other test cases
 	// This asserts that on initial run with an empty users table that the credentials file will instantiate and
@@ -238,7 +238,7 @@ func TestFileAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 			ctx := testutils.Context(t)
 			db := pgtest.NewSqlxDB(t)
 			lggr := logger.TestLogger(t)
-			orm := localauth.NewORM(db, time.Minute, lggr, audit.NoopLogger)
+			orm := localauth.NewORM(db, time.Minute, sessions.Roles{}, lggr, audit.NoopLogger)
 
 			// Clear out fixture users/users created from the other test cases
 			// This asserts that on initial run with an empty users table that the credentials file will instantiate and
@@ -262,7 +262,7 @@ func TestFileAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 
 func TestFileAPIInitializer_InitializeWithExistingAPIUser(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
-	orm := localauth.NewORM(db, time.Minute, logger.TestLogger(t), audit.NoopLogger)
+	orm := localauth.NewORM(db, time.Minute, sessions.Roles{}, logger.TestLogger(t), audit.NoopLogger)
 
 	tests := []struct {
 		name      string
@@ -65,6 +65,7 @@ type AppConfig interface {
 	CCV() CCV
 	Billing() Billing
 	BridgeStatusReporter() BridgeStatusReporter
+	ExternalSigner() ExternalSigner
 }
 
 type DatabaseBackupMode string
@@ -10,4 +10,8 @@ type BridgeStatusReporter interface {
 	PollingInterval() time.Duration
 	IgnoreInvalidBridges() bool
 	IgnoreJoblessBridges() bool
+
+	HealthCheckInterval() time.Duration
+	CircuitBreakerThreshold() uint32
+	CircuitBreakerCooldown() time.Duration
 }
@@ -0,0 +1,18 @@
+package config
+
+type ExternalSigner interface {
+	Provider() string
+	PKCS11() ExternalSignerPKCS11
+	Remote() ExternalSignerRemote
+}
+
+type ExternalSignerPKCS11 interface {
+	Module() string
+	TokenLabel() string
+	PIN() string
+}
+
+type ExternalSignerRemote interface {
+	URL() string
+	TLSCertPath() string
+}
@@ -64,6 +64,7 @@ type Core struct {
 	CRE                  CreConfig            `toml:",omitempty"`
 	Billing              Billing              `toml:",omitempty"`
 	BridgeStatusReporter BridgeStatusReporter `toml:",omitempty"`
+	ExternalSigner       ExternalSigner       `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -109,6 +110,7 @@ func (c *Core) SetFrom(f *Core) {
 	c.CRE.setFrom(&f.CRE)
 	c.Billing.setFrom(&f.Billing)
 	c.BridgeStatusReporter.setFrom(&f.BridgeStatusReporter)
+	c.ExternalSigner.setFrom(&f.ExternalSigner)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -146,6 +148,8 @@ type Secrets struct {
 
 	CRE CreSecrets `toml:",omitempty"`
 	CCV CCVSecrets `toml:",omitempty"`
+
+	ExternalSigner ExternalSignerSecrets `toml:",omitempty"`
 }
 
 type SolKeys struct {
@@ -558,6 +562,42 @@ func (p *PyroscopeSecrets) validateMerge(f *PyroscopeSecrets) (err error) {
 	return err
 }
 
+type ExternalSignerSecrets struct {
+	PKCS11 ExternalSignerPKCS11Secrets `toml:",omitempty"`
+}
+
+func (e *ExternalSignerSecrets) SetFrom(f *ExternalSignerSecrets) (err error) {
+	if err2 := e.PKCS11.SetFrom(&f.PKCS11); err2 != nil {
+		err = errors.Join(err, commonconfig.NamedMultiErrorList(err2, "PKCS11"))
+	}
+	return err
+}
+
+type ExternalSignerPKCS11Secrets struct {
+	PIN *models.Secret
+}
+
+func (e *ExternalSignerPKCS11Secrets) SetFrom(f *ExternalSignerPKCS11Secrets) (err error) {
+	err = e.validateMerge(f)
+	if err != nil {
+		return err
+	}
+
+	if v := f.PIN; v != nil {
+		e.PIN = v
+	}
+
+	return nil
+}
+
+func (e *ExternalSignerPKCS11Secrets) validateMerge(f *ExternalSignerPKCS11Secrets) (err error) {
+	if e.PIN != nil && f.PIN != nil {
+		err = errors.Join(err, configutils.ErrOverride{Name: "PIN"})
+	}
+
+	return err
+}
+
 type PrometheusSecrets struct {
 	AuthToken *models.Secret
 }
@@ -784,6 +824,7 @@ type AuditLogger struct {
 	ForwardToUrl   *commonconfig.URL
 	JsonWrapperKey *string
 	Headers        *[]models.ServiceHeader
+	LocalEnabled   *bool
 }
 
 func (p *AuditLogger) SetFrom(f *AuditLogger) {
@@ -799,6 +840,9 @@ func (p *AuditLogger) SetFrom(f *AuditLogger) {
 	if v := f.Headers; v != nil {
 		p.Headers = v
 	}
+	if v := f.LocalEnabled; v != nil {
+		p.LocalEnabled = v
+	}
 }
 
 // LogLevel replaces dpanic with crit/CRIT
@@ -895,6 +939,7 @@ type WebServer struct {
 	MFA       WebServerMFA       `toml:",omitempty"`
 	RateLimit WebServerRateLimit `toml:",omitempty"`
 	TLS       WebServerTLS       `toml:",omitempty"`
+	Roles     []WebServerRole    `toml:",omitempty"`
 }
 
 func (w *WebServer) setFrom(f *WebServer) {
@@ -940,9 +985,32 @@ func (w *WebServer) setFrom(f *WebServer) {
 	w.MFA.setFrom(&f.MFA)
 	w.RateLimit.setFrom(&f.RateLimit)
 	w.TLS.setFrom(&f.TLS)
+	if v := f.Roles; v != nil {
+		w.Roles = v
+	}
 }
 
 func (w *WebServer) ValidateConfig() (err error) {
+	names := make(map[string]bool, len(w.Roles))
+	for i, role := range w.Roles {
+		if role.Name == nil || *role.Name == "" {
+			err = errors.Join(err, configutils.ErrMissing{Name: fmt.Sprintf("Roles.%d.Name", i), Msg: "must have a name"})
+			continue
+		}
+		name := *role.Name
+		if _, rerr := sessions.GetUserRole(name); rerr == nil {
+			err = errors.Join(err, configutils.ErrInvalid{Name: fmt.Sprintf("Roles.%d.Name", i), Value: name, Msg: "can not redefine a built-in role"})
+		} else if names[name] {
+			err = errors.Join(err, configutils.ErrInvalid{Name: fmt.Sprintf("Roles.%d.Name", i), Value: name, Msg: "duplicate role name"})
+		}
+		names[name] = true
+		for _, p := range role.Permissions {
+			if _, perr := sessions.ParsePermission(p); perr != nil {
+				err = errors.Join(err, configutils.ErrInvalid{Name: fmt.Sprintf("Roles.%d.Permissions", i), Value: p, Msg: perr.Error()})
+			}
+		}
+	}
+
 	switch *w.AuthenticationMethod {
 	case string(sessions.LDAPAuth):
 		// Assert LDAP fields when AuthMethod set to LDAP
@@ -1011,6 +1079,14 @@ func (w *WebServer) ValidateConfig() (err error) {
 	return err
 }
 
+// WebServerRole defines a custom role with an explicit set of permissions.
+type WebServerRole struct {
+	Name        *string
+	Permissions []string
+	LDAPGroupCN *string
+	OIDCClaim   *string
+}
+
 type WebServerMFA struct {
 	RPID     *string
 	RPOrigin *string
@@ -2687,6 +2763,10 @@ type BridgeStatusReporter struct {
 	PollingInterval      *commonconfig.Duration
 	IgnoreInvalidBridges *bool
 	IgnoreJoblessBridges *bool
+
+	HealthCheckInterval     *commonconfig.Duration
+	CircuitBreakerThreshold *uint32
+	CircuitBreakerCooldown  *commonconfig.Duration
 }
 
 func (e *BridgeStatusReporter) setFrom(f *BridgeStatusReporter) {
@@ -2705,9 +2785,22 @@ func (e *BridgeStatusReporter) setFrom(f *BridgeStatusReporter) {
 	if f.IgnoreJoblessBridges != nil {
 		e.IgnoreJoblessBridges = f.IgnoreJoblessBridges
 	}
+	if f.HealthCheckInterval != nil {
+		e.HealthCheckInterval = f.HealthCheckInterval
+	}
+	if f.CircuitBreakerThreshold != nil {
+		e.CircuitBreakerThreshold = f.CircuitBreakerThreshold
+	}
+	if f.CircuitBreakerCooldown != nil {
+		e.CircuitBreakerCooldown = f.CircuitBreakerCooldown
+	}
 }
 
 func (e *BridgeStatusReporter) ValidateConfig() error {
+	if e.CircuitBreakerCooldown != nil && e.CircuitBreakerCooldown.Duration() <= 0 {
+		return configutils.ErrInvalid{Name: "CircuitBreakerCooldown", Value: e.CircuitBreakerCooldown.Duration(), Msg: "must be positive"}
+	}
+
 	if e.Enabled == nil || !*e.Enabled {
 		return nil
 	}
@@ -2748,3 +2841,71 @@ func (jd *JobDistributor) setFrom(f *JobDistributor) {
 		jd.DisplayName = f.DisplayName
 	}
 }
+
+type ExternalSigner struct {
+	Provider *string
+	PKCS11   ExternalSignerPKCS11 `toml:",omitempty"`
+	Remote   ExternalSignerRemote `toml:",omitempty"`
+}
+
+func (e *ExternalSigner) setFrom(f *ExternalSigner) {
+	if v := f.Provider; v != nil {
+		e.Provider = v
+	}
+	e.PKCS11.setFrom(&f.PKCS11)
+	e.Remote.setFrom(&f.Remote)
+}
+
+func (e *ExternalSigner) ValidateConfig() (err error) {
+	if e.Provider == nil {
+		return nil
+	}
+	switch *e.Provider {
+	case "":
+	case "pkcs11":
+		if e.PKCS11.Module == nil || *e.PKCS11.Module == "" {
+			err = errors.Join(err, configutils.ErrMissing{Name: "PKCS11.Module", Msg: "must be set when Provider is pkcs11"})
+		}
+		if e.PKCS11.TokenLabel == nil || *e.PKCS11.TokenLabel == "" {
+			err = errors.Join(err, configutils.ErrMissing{Name: "PKCS11.TokenLabel", Msg: "must be set when Provider is pkcs11"})
+		}
+	case "remote":
+		if e.Remote.URL == nil || *e.Remote.URL == "" {
+			err = errors.Join(err, configutils.ErrMissing{Name: "Remote.URL", Msg: "must be set when Provider is remote"})
+		}
+		if e.Remote.TLSCertPath != nil && *e.Remote.TLSCertPath != "" && !isValidFilePath(*e.Remote.TLSCertPath) {
+			err = errors.Join(err, configutils.ErrInvalid{Name: "Remote.TLSCertPath", Value: *e.Remote.TLSCertPath, Msg: "must be a valid file path"})
+		}
+	default:
+		err = errors.Join(err, configutils.ErrInvalid{Name: "Provider", Value: *e.Provider, Msg: "must be one of '', 'pkcs11' or 'remote'"})
+	}
+	return err
+}
+
+type ExternalSignerPKCS11 struct {
+	Module     *string
+	TokenLabel *string
+}
+
+func (e *ExternalSignerPKCS11) setFrom(f *ExternalSignerPKCS11) {
+	if v := f.Module; v != nil {
+		e.Module = v
+	}
+	if v := f.TokenLabel; v != nil {
+		e.TokenLabel = v
+	}
+}
+
+type ExternalSignerRemote struct {
+	URL         *string
+	TLSCertPath *string
+}
+
+func (e *ExternalSignerRemote) setFrom(f *ExternalSignerRemote) {
+	if v := f.URL; v != nil {
+		e.URL = v
+	}
+	if v := f.TLSCertPath; v != nil {
+		e.TLSCertPath = v
+	}
+}
@@ -70,6 +70,13 @@ type OIDC interface {
 	UserAPITokenDuration() commonconfig.Duration
 }
 
+type WebServerRole interface {
+	Name() string
+	Permissions() []string
+	LDAPGroupCN() string
+	OIDCClaim() string
+}
+
 type WebServer interface {
 	AuthenticationMethod() string
 	AllowOrigins() string
@@ -90,4 +97,5 @@ type WebServer interface {
 	MFA() MFA
 	LDAP() LDAP
 	OIDC() OIDC
+	Roles() []WebServerRole
 }
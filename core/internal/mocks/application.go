@@ -17,6 +17,8 @@ import (
 
 	feeds "github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 
+	gateway "github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
 
 	jsonserializable "github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
@@ -202,6 +204,53 @@ func (_c *Application_BasicAdminUsersORM_Call) RunAndReturn(run func() sessions.
 	return _c
 }
 
+// BridgeHealth provides a mock function with no fields
+func (_m *Application) BridgeHealth() *bridges.HealthMonitor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeHealth")
+	}
+
+	var r0 *bridges.HealthMonitor
+	if rf, ok := ret.Get(0).(func() *bridges.HealthMonitor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.HealthMonitor)
+		}
+	}
+
+	return r0
+}
+
+// Application_BridgeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeHealth'
+type Application_BridgeHealth_Call struct {
+	*mock.Call
+}
+
+// BridgeHealth is a helper method to define mock.On call
+func (_e *Application_Expecter) BridgeHealth() *Application_BridgeHealth_Call {
+	return &Application_BridgeHealth_Call{Call: _e.mock.On("BridgeHealth")}
+}
+
+func (_c *Application_BridgeHealth_Call) Run(run func()) *Application_BridgeHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_BridgeHealth_Call) Return(_a0 *bridges.HealthMonitor) *Application_BridgeHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_BridgeHealth_Call) RunAndReturn(run func() *bridges.HealthMonitor) *Application_BridgeHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BridgeORM provides a mock function with no fields
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -403,6 +452,53 @@ func (_c *Application_FindLCA_Call) RunAndReturn(run func(context.Context, *big.
 	return _c
 }
 
+// GatewayRegistry provides a mock function with no fields
+func (_m *Application) GatewayRegistry() *gateway.Registry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GatewayRegistry")
+	}
+
+	var r0 *gateway.Registry
+	if rf, ok := ret.Get(0).(func() *gateway.Registry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gateway.Registry)
+		}
+	}
+
+	return r0
+}
+
+// Application_GatewayRegistry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GatewayRegistry'
+type Application_GatewayRegistry_Call struct {
+	*mock.Call
+}
+
+// GatewayRegistry is a helper method to define mock.On call
+func (_e *Application_Expecter) GatewayRegistry() *Application_GatewayRegistry_Call {
+	return &Application_GatewayRegistry_Call{Call: _e.mock.On("GatewayRegistry")}
+}
+
+func (_c *Application_GatewayRegistry_Call) Run(run func()) *Application_GatewayRegistry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_GatewayRegistry_Call) Return(_a0 *gateway.Registry) *Application_GatewayRegistry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_GatewayRegistry_Call) RunAndReturn(run func() *gateway.Registry) *Application_GatewayRegistry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAuditLogger provides a mock function with no fields
 func (_m *Application) GetAuditLogger() audit.AuditLogger {
 	ret := _m.Called()
@@ -1059,6 +1155,66 @@ func (_c *Application_ID_Call) RunAndReturn(run func() uuid.UUID) *Application_I
 	return _c
 }
 
+// ImportJobs provides a mock function with given fields: ctx, bundle, parse
+func (_m *Application) ImportJobs(ctx context.Context, bundle job.Bundle, parse func(context.Context, sqlutil.DataSource, string) (job.Job, error)) ([]job.Job, error) {
+	ret := _m.Called(ctx, bundle, parse)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportJobs")
+	}
+
+	var r0 []job.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Bundle, func(context.Context, sqlutil.DataSource, string) (job.Job, error)) ([]job.Job, error)); ok {
+		return rf(ctx, bundle, parse)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, job.Bundle, func(context.Context, sqlutil.DataSource, string) (job.Job, error)) []job.Job); ok {
+		r0 = rf(ctx, bundle, parse)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, job.Bundle, func(context.Context, sqlutil.DataSource, string) (job.Job, error)) error); ok {
+		r1 = rf(ctx, bundle, parse)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ImportJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportJobs'
+type Application_ImportJobs_Call struct {
+	*mock.Call
+}
+
+// ImportJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bundle job.Bundle
+//   - parse func(context.Context, sqlutil.DataSource, string) (job.Job, error)
+func (_e *Application_Expecter) ImportJobs(ctx interface{}, bundle interface{}, parse interface{}) *Application_ImportJobs_Call {
+	return &Application_ImportJobs_Call{Call: _e.mock.On("ImportJobs", ctx, bundle, parse)}
+}
+
+func (_c *Application_ImportJobs_Call) Run(run func(ctx context.Context, bundle job.Bundle, parse func(context.Context, sqlutil.DataSource, string) (job.Job, error))) *Application_ImportJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.Bundle), args[2].(func(context.Context, sqlutil.DataSource, string) (job.Job, error)))
+	})
+	return _c
+}
+
+func (_c *Application_ImportJobs_Call) Return(_a0 []job.Job, _a1 error) *Application_ImportJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ImportJobs_Call) RunAndReturn(run func(context.Context, job.Bundle, func(context.Context, sqlutil.DataSource, string) (job.Job, error)) ([]job.Job, error)) *Application_ImportJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // JobORM provides a mock function with no fields
 func (_m *Application) JobORM() job.ORM {
 	ret := _m.Called()
@@ -1153,6 +1309,53 @@ func (_c *Application_JobSpawner_Call) RunAndReturn(run func() job.Spawner) *App
 	return _c
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type Application_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Application_Expecter) PauseJob(ctx interface{}, jobID interface{}) *Application_PauseJob_Call {
+	return &Application_PauseJob_Call{Call: _e.mock.On("PauseJob", ctx, jobID)}
+}
+
+func (_c *Application_PauseJob_Call) Run(run func(ctx context.Context, jobID int32)) *Application_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_PauseJob_Call) Return(_a0 error) *Application_PauseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_PauseJob_Call) RunAndReturn(run func(context.Context, int32) error) *Application_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineORM provides a mock function with no fields
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -1250,6 +1453,178 @@ func (_c *Application_ReplayFromBlock_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ReplayPipelineRunV2 provides a mock function with given fields: ctx, fixture
+func (_m *Application) ReplayPipelineRunV2(ctx context.Context, fixture pipeline.RunFixture) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, fixture)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayPipelineRunV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.RunFixture) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, fixture)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.RunFixture) *pipeline.Run); ok {
+		r0 = rf(ctx, fixture)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.RunFixture) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, fixture)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.RunFixture) error); ok {
+		r2 = rf(ctx, fixture)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Application_ReplayPipelineRunV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayPipelineRunV2'
+type Application_ReplayPipelineRunV2_Call struct {
+	*mock.Call
+}
+
+// ReplayPipelineRunV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fixture pipeline.RunFixture
+func (_e *Application_Expecter) ReplayPipelineRunV2(ctx interface{}, fixture interface{}) *Application_ReplayPipelineRunV2_Call {
+	return &Application_ReplayPipelineRunV2_Call{Call: _e.mock.On("ReplayPipelineRunV2", ctx, fixture)}
+}
+
+func (_c *Application_ReplayPipelineRunV2_Call) Run(run func(ctx context.Context, fixture pipeline.RunFixture)) *Application_ReplayPipelineRunV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.RunFixture))
+	})
+	return _c
+}
+
+func (_c *Application_ReplayPipelineRunV2_Call) Return(_a0 *pipeline.Run, _a1 pipeline.TaskRunResults, _a2 error) *Application_ReplayPipelineRunV2_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Application_ReplayPipelineRunV2_Call) RunAndReturn(run func(context.Context, pipeline.RunFixture) (*pipeline.Run, pipeline.TaskRunResults, error)) *Application_ReplayPipelineRunV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RerecordPipelineRunLiveV2 provides a mock function with given fields: ctx, run
+func (_m *Application) RerecordPipelineRunLiveV2(ctx context.Context, run pipeline.Run) (pipeline.RunFixture, error) {
+	ret := _m.Called(ctx, run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RerecordPipelineRunLiveV2")
+	}
+
+	var r0 pipeline.RunFixture
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Run) (pipeline.RunFixture, error)); ok {
+		return rf(ctx, run)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Run) pipeline.RunFixture); ok {
+		r0 = rf(ctx, run)
+	} else {
+		r0 = ret.Get(0).(pipeline.RunFixture)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Run) error); ok {
+		r1 = rf(ctx, run)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_RerecordPipelineRunLiveV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RerecordPipelineRunLiveV2'
+type Application_RerecordPipelineRunLiveV2_Call struct {
+	*mock.Call
+}
+
+// RerecordPipelineRunLiveV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - run pipeline.Run
+func (_e *Application_Expecter) RerecordPipelineRunLiveV2(ctx interface{}, run interface{}) *Application_RerecordPipelineRunLiveV2_Call {
+	return &Application_RerecordPipelineRunLiveV2_Call{Call: _e.mock.On("RerecordPipelineRunLiveV2", ctx, run)}
+}
+
+func (_c *Application_RerecordPipelineRunLiveV2_Call) Run(run func(ctx context.Context, run pipeline.Run)) *Application_RerecordPipelineRunLiveV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Run))
+	})
+	return _c
+}
+
+func (_c *Application_RerecordPipelineRunLiveV2_Call) Return(_a0 pipeline.RunFixture, _a1 error) *Application_RerecordPipelineRunLiveV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_RerecordPipelineRunLiveV2_Call) RunAndReturn(run func(context.Context, pipeline.Run) (pipeline.RunFixture, error)) *Application_RerecordPipelineRunLiveV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) ResumeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type Application_ResumeJob_Call struct {
+	*mock.Call
+}
+
+// ResumeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Application_Expecter) ResumeJob(ctx interface{}, jobID interface{}) *Application_ResumeJob_Call {
+	return &Application_ResumeJob_Call{Call: _e.mock.On("ResumeJob", ctx, jobID)}
+}
+
+func (_c *Application_ResumeJob_Call) Run(run func(ctx context.Context, jobID int32)) *Application_ResumeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_ResumeJob_Call) Return(_a0 error) *Application_ResumeJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_ResumeJob_Call) RunAndReturn(run func(context.Context, int32) error) *Application_ResumeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -1508,6 +1883,76 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, vars, stubs
+func (_m *Application) SimulateJobV2(ctx context.Context, jb *job.Job, vars map[string]interface{}, stubs pipeline.TaskStubs) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, jb, vars, stubs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job, map[string]interface{}, pipeline.TaskStubs) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, jb, vars, stubs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job, map[string]interface{}, pipeline.TaskStubs) *pipeline.Run); ok {
+		r0 = rf(ctx, jb, vars, stubs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *job.Job, map[string]interface{}, pipeline.TaskStubs) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, jb, vars, stubs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *job.Job, map[string]interface{}, pipeline.TaskStubs) error); ok {
+		r2 = rf(ctx, jb, vars, stubs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Application_SimulateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateJobV2'
+type Application_SimulateJobV2_Call struct {
+	*mock.Call
+}
+
+// SimulateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb *job.Job
+//   - vars map[string]interface{}
+//   - stubs pipeline.TaskStubs
+func (_e *Application_Expecter) SimulateJobV2(ctx interface{}, jb interface{}, vars interface{}, stubs interface{}) *Application_SimulateJobV2_Call {
+	return &Application_SimulateJobV2_Call{Call: _e.mock.On("SimulateJobV2", ctx, jb, vars, stubs)}
+}
+
+func (_c *Application_SimulateJobV2_Call) Run(run func(ctx context.Context, jb *job.Job, vars map[string]interface{}, stubs pipeline.TaskStubs)) *Application_SimulateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*job.Job), args[2].(map[string]interface{}), args[3].(pipeline.TaskStubs))
+	})
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) Return(_a0 *pipeline.Run, _a1 pipeline.TaskRunResults, _a2 error) *Application_SimulateJobV2_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) RunAndReturn(run func(context.Context, *job.Job, map[string]interface{}, pipeline.TaskStubs) (*pipeline.Run, pipeline.TaskRunResults, error)) *Application_SimulateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -1646,6 +2091,54 @@ func (_c *Application_TxmStorageService_Call) RunAndReturn(run func() txmgr.EvmT
 	return _c
 }
 
+// UpdateJob provides a mock function with given fields: ctx, jobID, jb
+func (_m *Application) UpdateJob(ctx context.Context, jobID int32, jb *job.Job) error {
+	ret := _m.Called(ctx, jobID, jb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, *job.Job) error); ok {
+		r0 = rf(ctx, jobID, jb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_UpdateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJob'
+type Application_UpdateJob_Call struct {
+	*mock.Call
+}
+
+// UpdateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - jb *job.Job
+func (_e *Application_Expecter) UpdateJob(ctx interface{}, jobID interface{}, jb interface{}) *Application_UpdateJob_Call {
+	return &Application_UpdateJob_Call{Call: _e.mock.On("UpdateJob", ctx, jobID, jb)}
+}
+
+func (_c *Application_UpdateJob_Call) Run(run func(ctx context.Context, jobID int32, jb *job.Job)) *Application_UpdateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(*job.Job))
+	})
+	return _c
+}
+
+func (_c *Application_UpdateJob_Call) Return(_a0 error) *Application_UpdateJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_UpdateJob_Call) RunAndReturn(run func(context.Context, int32, *job.Job) error) *Application_UpdateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WakeSessionReaper provides a mock function with no fields
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
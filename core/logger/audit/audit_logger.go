@@ -15,6 +15,7 @@ import (
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -22,6 +23,7 @@ import (
 
 const bufferCapacity = 2048
 const webRequestTimeout = 10
+const localWriteTimeout = 5 * time.Second
 
 type Data = map[string]any
 
@@ -45,10 +47,14 @@ type AuditLoggerService struct {
 	hostname        string                   // The self-reported hostname of the machine
 	localIP         string                   // A non-loopback IP address as reported by the machine
 	loggingClient   HTTPAuditLoggerInterface // Abstract type for sending logs onward
+	forwarding      bool                     // Whether logs are sent to forwardToUrl
+	localORM        ORM                      // The local audit log, if enabled
 
 	loggingChannel chan wrappedAuditLog
+	localChannel   chan wrappedAuditLog
 	chStop         services.StopChan
 	chDone         chan struct{}
+	chLocalDone    chan struct{}
 }
 
 type wrappedAuditLog struct {
@@ -59,11 +65,12 @@ type wrappedAuditLog struct {
 var NoopLogger AuditLogger = &AuditLoggerService{}
 
 // NewAuditLogger returns a buffer push system that ingests audit log events and
-// asynchronously pushes them up to an HTTP log service.
+// asynchronously pushes them up to an HTTP log service, and if configured,
+// appends them to the local audit log in ds.
 // Parses and validates the AUDIT_LOGS_* environment values and returns an enabled
 // AuditLogger instance. If the environment variables are not set, the logger
 // is disabled and short circuits execution via enabled flag.
-func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogger, error) {
+func NewAuditLogger(logger logger.Logger, config config.AuditLogger, ds sqlutil.DataSource) (AuditLogger, error) {
 	// If the unverified config is nil, then we assume this came from the
 	// configuration system and return a nil logger.
 	if config == nil || !config.Enabled() {
@@ -98,12 +105,19 @@ func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogge
 		hostname:        hostname,
 		localIP:         getLocalIP(),
 		loggingClient:   &http.Client{Timeout: time.Second * webRequestTimeout},
+		forwarding:      forwardToUrl.String() != "",
 
 		loggingChannel: loggingChannel,
 		chStop:         make(chan struct{}),
 		chDone:         make(chan struct{}),
 	}
 
+	if config.LocalEnabled() {
+		auditLogger.localORM = NewORM(ds)
+		auditLogger.localChannel = make(chan wrappedAuditLog, bufferCapacity)
+		auditLogger.chLocalDone = make(chan struct{})
+	}
+
 	return &auditLogger, nil
 }
 
@@ -115,7 +129,8 @@ func (l *AuditLoggerService) SetLoggingClient(newClient HTTPAuditLoggerInterface
 // sent out by the goroutine that was started when the AuditLoggerService was
 // created. If this service was not enabled, this immeidately returns.
 //
-// This function never blocks.
+// Local audit log events have a buffer of their own, so that they are kept
+// even when the HTTP log service is unreachable.
 func (l *AuditLoggerService) Audit(eventID EventID, data Data) {
 	if !l.enabled {
 		return
@@ -126,6 +141,17 @@ func (l *AuditLoggerService) Audit(eventID EventID, data Data) {
 		data:    data,
 	}
 
+	if l.localORM != nil {
+		select {
+		case l.localChannel <- wrappedLog:
+		default:
+			l.logger.Errorf("local audit log buffer is full. Dropping log with eventID: %s", eventID)
+		}
+	}
+	if !l.forwarding {
+		return
+	}
+
 	select {
 	case l.loggingChannel <- wrappedLog:
 	default:
@@ -140,6 +166,9 @@ func (l *AuditLoggerService) Start(context.Context) error {
 	}
 
 	go l.runLoop()
+	if l.localORM != nil {
+		go l.runLocalLoop()
+	}
 	return nil
 }
 
@@ -152,6 +181,9 @@ func (l *AuditLoggerService) Close() error {
 	l.logger.Warnf("Disabled the audit logger service")
 	close(l.chStop)
 	<-l.chDone
+	if l.localORM != nil {
+		<-l.chLocalDone
+	}
 
 	return nil
 }
@@ -166,6 +198,8 @@ func (l *AuditLoggerService) HealthReport() map[string]error {
 		err = errors.New("the audit logger is not enabled")
 	} else if len(l.loggingChannel) == bufferCapacity {
 		err = errors.New("buffer is full")
+	} else if len(l.localChannel) == bufferCapacity {
+		err = errors.New("local audit log buffer is full")
 	}
 	return map[string]error{l.Name(): err}
 }
@@ -196,6 +230,39 @@ func (l *AuditLoggerService) runLoop() {
 	}
 }
 
+// runLocalLoop appends events to the local audit log in the order they were
+// audited. On shutdown, it appends the events still buffered before returning.
+func (l *AuditLoggerService) runLocalLoop() {
+	defer close(l.chLocalDone)
+
+	for {
+		select {
+		case <-l.chStop:
+			ctx, cancel := context.WithTimeout(context.Background(), localWriteTimeout)
+			defer cancel()
+			for {
+				select {
+				case event := <-l.localChannel:
+					l.appendToLocalLog(ctx, event)
+				default:
+					return
+				}
+			}
+		case event := <-l.localChannel:
+			// Not cancelled on shutdown, so that an event being appended is not lost
+			ctx, cancel := context.WithTimeout(context.Background(), localWriteTimeout)
+			l.appendToLocalLog(ctx, event)
+			cancel()
+		}
+	}
+}
+
+func (l *AuditLoggerService) appendToLocalLog(ctx context.Context, event wrappedAuditLog) {
+	if err := l.localORM.Append(ctx, event.eventID, event.data); err != nil {
+		l.logger.Errorw("failed to append to local audit log", "err", err, "eventID", event.eventID)
+	}
+}
+
 // Takes an EventID and associated data and sends it to the configured logging
 // endpoint. This function blocks on the send by timesout after a period of
 // several seconds. This helps us prevent getting stuck on a single log
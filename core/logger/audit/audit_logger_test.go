@@ -82,6 +82,10 @@ func (c Config) JsonWrapperKey() string {
 	return ""
 }
 
+func (c Config) LocalEnabled() bool {
+	return false
+}
+
 func TestCheckLoginAuditLog(t *testing.T) {
 	t.Parallel()
 
@@ -100,7 +104,7 @@ func TestCheckLoginAuditLog(t *testing.T) {
 	auditLoggerTestConfig := Config{}
 
 	// Create new AuditLoggerService
-	auditLogger, err := audit.NewAuditLogger(logger.Named("AuditLogger"), &auditLoggerTestConfig)
+	auditLogger, err := audit.NewAuditLogger(logger.Named("AuditLogger"), &auditLoggerTestConfig, nil)
 	assert.NoError(t, err)
 
 	// Cast to concrete type so we can swap out the internals
@@ -11,6 +11,8 @@ const (
 	AuthLoginSuccessNo2FA   EventID = "AUTH_LOGIN_SUCCESS_NO_2FA"
 	Auth2FAEnrolled         EventID = "AUTH_2FA_ENROLLED"
 	AuthSessionDeleted      EventID = "SESSION_DELETED"
+	AuthSessionRevoked      EventID = "SESSION_REVOKED"
+	AuthUserSessionsRevoked EventID = "USER_SESSIONS_REVOKED"
 
 	PasswordResetAttemptFailedMismatch EventID = "PASSWORD_RESET_ATTEMPT_FAILED_MISMATCH"
 	PasswordResetSuccess               EventID = "PASSWORD_RESET_SUCCESS"
@@ -51,12 +53,21 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeystorePasswordRotationFailedMismatch EventID = "KEYSTORE_PASSWORD_ROTATION_FAILED_MISMATCH"
+	KeystorePasswordRotated                EventID = "KEYSTORE_PASSWORD_ROTATED"
+	KeystoreBackedUp                       EventID = "KEYSTORE_BACKED_UP"
+	KeystoreRestored                       EventID = "KEYSTORE_RESTORED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
 	JobCreated EventID = "JOB_CREATED"
 	JobDeleted EventID = "JOB_DELETED"
+	JobPaused  EventID = "JOB_PAUSED"
+	JobResumed EventID = "JOB_RESUMED"
+
+	JobsExported EventID = "JOBS_EXPORTED"
 
 	ChainAdded       EventID = "CHAIN_ADDED"
 	ChainSpecUpdated EventID = "CHAIN_SPEC_UPDATED"
@@ -75,6 +86,8 @@ const (
 	ExternalInitiatorCreated EventID = "EXTERNAL_INITIATOR_CREATED"
 	ExternalInitiatorDeleted EventID = "EXTERNAL_INITIATOR_DELETED"
 
+	GatewayNodeDisconnected EventID = "GATEWAY_NODE_DISCONNECTED"
+
 	JobProposalSpecApproved EventID = "JOB_PROPOSAL_SPEC_APPROVED"
 	JobProposalSpecUpdated  EventID = "JOB_PROPOSAL_SPEC_UPDATED"
 	JobProposalSpecCanceled EventID = "JOB_PROPOSAL_SPEC_CANCELED"
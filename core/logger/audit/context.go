@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"context"
+	"maps"
+)
+
+type dataKey struct{}
+
+// ContextWithData returns a copy of ctx carrying data, which loggers from
+// FromContext add to every event, e.g. to attribute the events of a request
+// to the API token it was made with.
+func ContextWithData(ctx context.Context, data Data) context.Context {
+	if existing, ok := ctx.Value(dataKey{}).(Data); ok {
+		merged := maps.Clone(existing)
+		maps.Copy(merged, data)
+		data = merged
+	}
+	return context.WithValue(ctx, dataKey{}, data)
+}
+
+// FromContext returns lggr, adding the data carried by ctx to every event.
+// The data of an event takes precedence over that of the context.
+func FromContext(ctx context.Context, lggr AuditLogger) AuditLogger {
+	data, ok := ctx.Value(dataKey{}).(Data)
+	if !ok {
+		return lggr
+	}
+	return &contextLogger{AuditLogger: lggr, data: data}
+}
+
+type contextLogger struct {
+	AuditLogger
+	data Data
+}
+
+func (l *contextLogger) Audit(eventID EventID, data Data) {
+	merged := maps.Clone(l.data)
+	maps.Copy(merged, data)
+	l.AuditLogger.Audit(eventID, merged)
+}
@@ -0,0 +1,34 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+type recordingLogger struct {
+	audit.AuditLogger
+	events []audit.Data
+}
+
+func (r *recordingLogger) Audit(_ audit.EventID, data audit.Data) {
+	r.events = append(r.events, data)
+}
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	rec := &recordingLogger{}
+	assert.Same(t, rec, audit.FromContext(context.Background(), rec))
+
+	ctx := audit.ContextWithData(context.Background(), audit.Data{"user": "a@b.c", "apiToken": "ci"})
+	ctx = audit.ContextWithData(ctx, audit.Data{"apiToken": "deploy"})
+	data := audit.Data{"jobID": 1, "user": "d@e.f"}
+	audit.FromContext(ctx, rec).Audit(audit.JobCreated, data)
+
+	assert.Equal(t, []audit.Data{{"jobID": 1, "user": "d@e.f", "apiToken": "deploy"}}, rec.events)
+	assert.Equal(t, audit.Data{"jobID": 1, "user": "d@e.f"}, data, "event data is not modified")
+}
@@ -0,0 +1,166 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// verifyBatchSize is how many records Verify loads at a time
+const verifyBatchSize = 1000
+
+// ErrChainBroken is returned by Verify when a record was altered, removed or
+// inserted out of band.
+var ErrChainBroken = errors.New("audit log hash chain is broken")
+
+// Record is an audit event kept in the local audit log. Each record's hash
+// covers its content and the hash of the record before it, so changing or
+// removing any record breaks the chain from there on.
+type Record struct {
+	ID        int64
+	EventID   EventID
+	UserEmail string
+	Data      string
+	PrevHash  []byte
+	Hash      []byte
+	CreatedAt time.Time
+}
+
+func (r Record) computeHash() []byte {
+	h := sha256.New()
+	h.Write(r.PrevHash)
+	fmt.Fprintf(h, "\x00%s\x00%s\x00%s\x00", r.CreatedAt.UTC().Format(time.RFC3339Nano), r.EventID, r.UserEmail)
+	h.Write([]byte(r.Data))
+	return h.Sum(nil)
+}
+
+// Filter selects records from the local audit log. Zero fields match any
+// record.
+type Filter struct {
+	Since   time.Time
+	EventID EventID
+	User    string
+}
+
+// ORM is the local, append-only audit log.
+type ORM interface {
+	Append(ctx context.Context, eventID EventID, data Data) error
+	List(ctx context.Context, filter Filter, offset, limit int) ([]Record, int, error)
+	// Verify checks the hash chain of every record, returning how many were
+	// checked, and ErrChainBroken if any does not match.
+	Verify(ctx context.Context) (int, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+// Append adds an event to the end of the chain. Writers are serialized by a
+// row lock on the head of the chain, which readers do not wait on.
+func (o *orm) Append(ctx context.Context, eventID EventID, data Data) error {
+	serialized, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to serialize audit log data: %w", err)
+	}
+	r := Record{
+		EventID:   eventID,
+		UserEmail: dataUser(data),
+		Data:      string(serialized),
+		// Postgres keeps microseconds, which the hash must agree with
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+	return sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		if err := tx.GetContext(ctx, &r.PrevHash, "SELECT hash FROM audit_log_head FOR UPDATE"); err != nil {
+			return err
+		}
+		r.Hash = r.computeHash()
+		err := tx.GetContext(ctx, &r.ID, `INSERT INTO audit_log (event_id, user_email, data, prev_hash, hash, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`, r.EventID, r.UserEmail, r.Data, r.PrevHash, r.Hash, r.CreatedAt)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "UPDATE audit_log_head SET record_id = $1, hash = $2", r.ID, r.Hash)
+		return err
+	})
+}
+
+// dataUser returns the user an event is about, as events name them by
+// different keys.
+func dataUser(data Data) string {
+	for _, key := range []string{"user", "email"} {
+		if user, ok := data[key].(string); ok {
+			return user
+		}
+	}
+	return ""
+}
+
+// List returns the records matching filter, newest first, and the total count
+// of matching records.
+func (o *orm) List(ctx context.Context, filter Filter, offset, limit int) (records []Record, count int, err error) {
+	where := `WHERE ($1::timestamptz IS NULL OR created_at >= $1)
+		AND ($2 = '' OR event_id = $2)
+		AND ($3 = '' OR lower(user_email) = lower($3))`
+	var since *time.Time
+	if !filter.Since.IsZero() {
+		since = &filter.Since
+	}
+	if err = o.ds.GetContext(ctx, &count, "SELECT count(*) FROM audit_log "+where, since, filter.EventID, filter.User); err != nil {
+		return nil, 0, err
+	}
+	err = o.ds.SelectContext(ctx, &records, "SELECT * FROM audit_log "+where+" ORDER BY id DESC LIMIT $4 OFFSET $5",
+		since, filter.EventID, filter.User, limit, offset)
+	return records, count, err
+}
+
+// Verify walks the log in order up to the head of the chain, recomputing each
+// record's hash. Records appended while it runs are left for the next call.
+func (o *orm) Verify(ctx context.Context) (int, error) {
+	var head struct {
+		RecordID int64 `db:"record_id"`
+		Hash     []byte
+	}
+	if err := o.ds.GetContext(ctx, &head, "SELECT record_id, hash FROM audit_log_head"); err != nil {
+		return 0, err
+	}
+
+	var checked int
+	var prevHash []byte
+	var lastID int64
+	for {
+		var records []Record
+		if err := o.ds.SelectContext(ctx, &records, "SELECT * FROM audit_log WHERE id > $1 AND id <= $2 ORDER BY id LIMIT $3", lastID, head.RecordID, verifyBatchSize); err != nil {
+			return checked, err
+		}
+		for _, r := range records {
+			if !bytes.Equal(r.PrevHash, prevHash) {
+				return checked, fmt.Errorf("%w: record %d does not follow the record before it", ErrChainBroken, r.ID)
+			}
+			if !bytes.Equal(r.Hash, r.computeHash()) {
+				return checked, fmt.Errorf("%w: record %d does not match its hash", ErrChainBroken, r.ID)
+			}
+			prevHash = r.Hash
+			lastID = r.ID
+			checked++
+		}
+		if len(records) < verifyBatchSize {
+			break
+		}
+	}
+	if lastID != head.RecordID || !bytes.Equal(prevHash, head.Hash) {
+		return checked, fmt.Errorf("%w: the log ends at record %d, but its head is record %d", ErrChainBroken, lastID, head.RecordID)
+	}
+	return checked, nil
+}
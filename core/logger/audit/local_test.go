@@ -0,0 +1,94 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+func TestORM_LocalAuditLog(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := audit.NewORM(db)
+
+	require.NoError(t, orm.Append(ctx, audit.AuthLoginSuccessNo2FA, audit.Data{"email": "a@b.c"}))
+	require.NoError(t, orm.Append(ctx, audit.JobCreated, audit.Data{"user": "a@b.c", "apiToken": "ci", "job": "{}"}))
+	require.NoError(t, orm.Append(ctx, audit.BridgeCreated, audit.Data{"user": "d@e.f"}))
+
+	checked, err := orm.Verify(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, checked)
+
+	records, count, err := orm.List(ctx, audit.Filter{}, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, records, 2)
+	assert.Equal(t, audit.BridgeCreated, records[0].EventID)
+	assert.Equal(t, records[1].Hash, records[0].PrevHash)
+
+	records, count, err = orm.List(ctx, audit.Filter{User: "A@B.C"}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Len(t, records, 2)
+
+	records, _, err = orm.List(ctx, audit.Filter{EventID: audit.JobCreated}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.JSONEq(t, `{"user": "a@b.c", "apiToken": "ci", "job": "{}"}`, records[0].Data)
+
+	_, count, err = orm.List(ctx, audit.Filter{Since: time.Now().Add(time.Hour)}, 0, 10)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	t.Run("tampered", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "ALTER TABLE audit_log DISABLE TRIGGER audit_log_append_only")
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, "UPDATE audit_log SET data = '{\"user\": \"x@y.z\"}' WHERE event_id = $1", audit.BridgeCreated)
+		require.NoError(t, err)
+
+		checked, err := orm.Verify(ctx)
+		require.ErrorIs(t, err, audit.ErrChainBroken)
+		assert.Equal(t, 2, checked)
+	})
+}
+
+func TestORM_LocalAuditLog_AppendOnly(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := audit.NewORM(db)
+
+	require.NoError(t, orm.Append(ctx, audit.BridgeCreated, audit.Data{"user": "a@b.c"}))
+	_, err := db.ExecContext(ctx, "DELETE FROM audit_log")
+	require.ErrorContains(t, err, "append-only")
+	_, err = db.ExecContext(ctx, "UPDATE audit_log_head SET record_id = 0, hash = NULL")
+	require.ErrorContains(t, err, "only moves forward")
+	_, err = db.ExecContext(ctx, "DELETE FROM audit_log_head")
+	require.ErrorContains(t, err, "only moves forward")
+}
+
+func TestORM_LocalAuditLog_Truncated(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := audit.NewORM(db)
+
+	require.NoError(t, orm.Append(ctx, audit.BridgeCreated, audit.Data{"user": "a@b.c"}))
+	require.NoError(t, orm.Append(ctx, audit.BridgeDeleted, audit.Data{"user": "a@b.c"}))
+
+	_, err := db.ExecContext(ctx, "ALTER TABLE audit_log DISABLE TRIGGER audit_log_append_only")
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "DELETE FROM audit_log WHERE event_id = $1", audit.BridgeDeleted)
+	require.NoError(t, err)
+
+	checked, err := orm.Verify(ctx)
+	require.ErrorIs(t, err, audit.ErrChainBroken)
+	assert.Equal(t, 1, checked)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/state"
 )
 
 // Script to run Gateway outside of the core node. It works only with simple handlers.
@@ -51,8 +52,17 @@ func main() {
 
 	lf := limits.Factory{Logger: lggr}
 	// Note: these nils are optional dependencies that are not needed for simple handlers.
-	handlerFactory := gateway.NewHandlerFactory(nil, nil, nil, nil, nil, lggr, lf)
-	gw, err := gateway.NewGatewayFromConfig(&cfg, handlerFactory, lggr, lf)
+	var store state.Store
+	if cfg.ReplicaConfig.ReplicaId != "" {
+		// shared by the gateway and its handlers
+		store, err = state.NewStore(cfg.ReplicaConfig.StateBackend, nil, cfg.ReplicaConfig.StateURL)
+		if err != nil {
+			fmt.Println("error creating state store:", err)
+			return
+		}
+	}
+	handlerFactory := gateway.NewHandlerFactory(nil, nil, nil, store, nil, nil, lggr, lf)
+	gw, err := gateway.NewGatewayFromConfig(&cfg, handlerFactory, store, lggr, lf)
 	if err != nil {
 		fmt.Println("error creating Gateway object:", err)
 		return
@@ -146,13 +146,29 @@ type Application interface {
 	JobORM() job.ORM
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	BridgeHealth() *bridges.HealthMonitor
+	// GatewayRegistry tracks the gateways of the running gateway jobs.
+	GatewayRegistry() *gateway.Registry
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
+	// UpdateJob replaces a job with an updated spec, applying it to the running services of the job when they support it.
+	UpdateJob(ctx context.Context, jobID int32, jb *job.Job) error
+	PauseJob(ctx context.Context, jobID int32) error
+	// ImportJobs creates the jobs of a bundle, along with the bridges and external initiators they need, in a single transaction.
+	ImportJobs(ctx context.Context, bundle job.Bundle, parse func(ctx context.Context, ds sqlutil.DataSource, spec string) (job.Job, error)) ([]job.Job, error)
+	ResumeJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// SimulateJobV2 executes the pipeline of an unsaved job in memory, replacing side-effecting tasks with the given stubs.
+	SimulateJobV2(ctx context.Context, jb *job.Job, vars map[string]any, stubs pipeline.TaskStubs) (*pipeline.Run, pipeline.TaskRunResults, error)
+	// ReplayPipelineRunV2 re-executes a recorded run in memory, serving the recorded responses of side-effecting tasks.
+	ReplayPipelineRunV2(ctx context.Context, fixture pipeline.RunFixture) (*pipeline.Run, pipeline.TaskRunResults, error)
+	// RerecordPipelineRunLiveV2 re-executes a finished run in memory against the live endpoints of its bridge and http
+	// tasks, returning a fixture of the re-execution.
+	RerecordPipelineRunLiveV2(ctx context.Context, run pipeline.Run) (pipeline.RunFixture, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]any) (int64, error)
 
@@ -184,6 +200,8 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	bridgeHealth             *bridges.HealthMonitor
+	gatewayRegistry          *gateway.Registry
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider // Note: this will be OIDC instance
 	txmStorageService        txmgr.EvmTxStore
@@ -489,7 +507,11 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 
 	// Initialize Local Users ORM and Authentication Provider specified in config
 	// BasicAdminUsersORM is initialized and required regardless of separate Authentication Provider
-	localAdminUsersORM := localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
+	roles, err := sessions.NewRoles(cfg.WebServer().Roles())
+	if err != nil {
+		return nil, errors.Wrap(err, "NewApplication: invalid WebServer.Roles")
+	}
+	localAdminUsersORM := localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), roles, globalLogger, auditLogger)
 
 	// Initialize Sessions ORM based on environment configured authenticator
 	// localDB auth, LDAP auth, or OIDC auth
@@ -501,39 +523,41 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 	case sessions.LDAPAuth:
 		var err error
 		authenticationProvider, err = ldapauth.NewLDAPAuthenticator(
-			opts.DS, cfg.WebServer().LDAP(), cfg.Insecure().DevWebServer(), globalLogger, auditLogger,
+			opts.DS, cfg.WebServer().LDAP(), roles, cfg.Insecure().DevWebServer(), globalLogger, auditLogger,
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, "NewApplication: failed to initialize LDAP Authentication module")
 		}
-		syncer := ldapauth.NewLDAPServerStateSyncer(opts.DS, cfg.WebServer().LDAP(), globalLogger)
+		syncer := ldapauth.NewLDAPServerStateSyncer(opts.DS, cfg.WebServer().LDAP(), roles, globalLogger)
 		srvcs = append(srvcs, syncer)
 		sessionReaper = utils.NewSleeperTaskCtx(syncer)
 	case sessions.OIDCAuth:
 		var err error
 		authenticationProvider, err = oidcauth.NewOIDCAuthenticator(
-			opts.DS, cfg.WebServer().OIDC(), globalLogger, auditLogger,
+			opts.DS, cfg.WebServer().OIDC(), roles, globalLogger, auditLogger,
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, "NewApplication: failed to initialize OIDC Authentication module")
 		}
 		sessionReaper = oidcauth.NewSessionReaper(opts.DS, cfg.WebServer(), globalLogger)
 	case sessions.LocalAuth:
-		authenticationProvider = localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
+		authenticationProvider = localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), roles, globalLogger, auditLogger)
 		sessionReaper = localauth.NewSessionReaper(opts.DS, cfg.WebServer(), globalLogger)
 	default:
 		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth)
 	}
 
 	var (
-		pipelineORM    = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
-		bridgeORM      = bridges.NewORM(opts.DS)
-		mercuryORM     = mercury.NewORM(opts.DS)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
-		jobORM         = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
-		txmORM         = txmgr.NewTxStore(opts.DS, globalLogger)
-		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
-		workflowORM    = workflowstore.NewInMemoryStore(globalLogger, clockwork.NewRealClock())
+		pipelineORM     = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
+		bridgeORM       = bridges.NewORM(opts.DS)
+		bridgeHealth    = bridges.NewHealthMonitor(cfg.BridgeStatusReporter().CircuitBreakerThreshold(), cfg.BridgeStatusReporter().CircuitBreakerCooldown())
+		mercuryORM      = mercury.NewORM(opts.DS)
+		pipelineRunner  = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), bridgeHealth, legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
+		jobORM          = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
+		txmORM          = txmgr.NewTxStore(opts.DS, globalLogger)
+		streamRegistry  = streams.NewRegistry(globalLogger, pipelineRunner)
+		gatewayRegistry = gateway.NewRegistry()
+		workflowORM     = workflowstore.NewInMemoryStore(globalLogger, clockwork.NewRealClock())
 	)
 	srvcs = append(srvcs, workflowORM)
 
@@ -607,6 +631,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 				opts.DS,
 				opts.CapabilitiesRegistry,
 				creServices.workflowRegistrySyncer,
+				gatewayRegistry,
 				globalLogger,
 				limitsFactory,
 			),
@@ -762,6 +787,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 	bridgeStatusReporter := bridgestatus.NewBridgeStatusReporter(
 		cfg.BridgeStatusReporter(),
 		bridgeORM,
+		bridgeHealth,
 		jobORM,
 		unrestrictedHTTPClient,
 		beholder.GetEmitter(),
@@ -829,6 +855,8 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		bridgeHealth:             bridgeHealth,
+		gatewayRegistry:          gatewayRegistry,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
@@ -1482,6 +1510,14 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) BridgeHealth() *bridges.HealthMonitor {
+	return app.bridgeHealth
+}
+
+func (app *ChainlinkApplication) GatewayRegistry() *gateway.Registry {
+	return app.gatewayRegistry
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
@@ -1533,6 +1569,66 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 	return app.jobSpawner.DeleteJob(ctx, nil, jobID)
 }
 
+func (app *ChainlinkApplication) UpdateJob(ctx context.Context, jobID int32, jb *job.Job) error {
+	// Like DeleteJob, jobs managed by the Feeds Manager must be updated there
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
+	if err != nil {
+		return err
+	}
+
+	if isManaged {
+		return errors.New("job must be updated in the feeds manager")
+	}
+
+	return app.jobSpawner.UpdateJob(ctx, jobID, jb)
+}
+
+// PauseJob stops the services of a job while keeping its spec, keys and run history.
+func (app *ChainlinkApplication) PauseJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.PauseJob(ctx, jobID)
+}
+
+// ResumeJob restarts the services of a paused job.
+func (app *ChainlinkApplication) ResumeJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.ResumeJob(ctx, jobID)
+}
+
+// ImportJobs creates the missing bridges and external initiators of bundle,
+// then parses and creates each of its specs, all in one transaction. parse is
+// given the transaction so that specs can refer to external initiators created
+// in it. The jobs are only started once the transaction has committed.
+func (app *ChainlinkApplication) ImportJobs(ctx context.Context, bundle job.Bundle, parse func(ctx context.Context, ds sqlutil.DataSource, spec string) (job.Job, error)) ([]job.Job, error) {
+	jbs := make([]job.Job, 0, len(bundle.Specs))
+	err := sqlutil.TransactDataSource(ctx, app.ds, nil, func(tx sqlutil.DataSource) error {
+		if err := bundle.CreateDependencies(ctx, app.bridgeORM.WithDataSource(tx)); err != nil {
+			return err
+		}
+		orm := app.jobORM.WithDataSource(tx)
+		for i, spec := range bundle.Specs {
+			jb, err := parse(ctx, tx, spec)
+			if err == nil {
+				err = orm.CreateJob(ctx, &jb)
+			}
+			if err != nil {
+				return &job.BundleJobError{Index: i, Err: err}
+			}
+			jbs = append(jbs, jb)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Like CreateJob, a job that fails to start is still created, with the error recorded against it.
+	for _, jb := range jbs {
+		if err := app.jobSpawner.StartCreatedJob(ctx, jb); err != nil {
+			app.logger.Errorw("Failed to start imported job", "jobID", jb.ID, "err", err)
+		}
+	}
+	return jbs, nil
+}
+
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error) {
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
@@ -1611,6 +1707,42 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(ctx, taskID, result.Value, result.Error)
 }
 
+// SimulateJobV2 executes the job's pipeline without persisting the job or the run. Bridge, HTTP and ETH tx tasks
+// are never executed, their results are taken from stubs instead.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb *job.Job,
+	vars map[string]any,
+	stubs pipeline.TaskStubs,
+) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	if jb.Pipeline.Source == "" {
+		return nil, nil, errors.Errorf("job type %s has no pipeline to simulate", jb.Type)
+	}
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           jb.Type.String(),
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	return pipeline.SimulateRun(ctx, app.pipelineRunner, spec, pipeline.NewVarsFrom(vars), stubs)
+}
+
+// ReplayPipelineRunV2 re-executes the run captured by the fixture without persisting it, so that the results can be
+// compared to the recorded ones.
+func (app *ChainlinkApplication) ReplayPipelineRunV2(ctx context.Context, fixture pipeline.RunFixture) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	return pipeline.ReplayRun(ctx, app.pipelineRunner, fixture)
+}
+
+// RerecordPipelineRunLiveV2 re-executes a finished run without persisting it, sending the requests of its bridge and
+// http tasks to their live endpoints. The fixture holds the responses and results of the re-execution, not of the run.
+func (app *ChainlinkApplication) RerecordPipelineRunLiveV2(ctx context.Context, run pipeline.Run) (pipeline.RunFixture, error) {
+	return pipeline.RerecordRunLive(ctx, app.pipelineRunner, run)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -429,6 +429,10 @@ func (s *Secrets) SetFrom(f *Secrets) (err error) {
 		err = errors.Join(err, commonconfig.NamedMultiErrorList(err2, "CCV"))
 	}
 
+	if err2 := s.ExternalSigner.SetFrom(&f.ExternalSigner); err2 != nil {
+		err = errors.Join(err, commonconfig.NamedMultiErrorList(err2, "ExternalSigner"))
+	}
+
 	_, err = commonconfig.MultiErrorList(err)
 
 	return err
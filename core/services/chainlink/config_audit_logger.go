@@ -16,6 +16,9 @@ func (a auditLoggerConfig) Enabled() bool {
 }
 
 func (a auditLoggerConfig) ForwardToUrl() (commonconfig.URL, error) {
+	if a.c.ForwardToUrl == nil {
+		return commonconfig.URL{}, nil
+	}
 	return *a.c.ForwardToUrl, nil
 }
 
@@ -33,3 +36,7 @@ func (a auditLoggerConfig) JsonWrapperKey() string {
 func (a auditLoggerConfig) Headers() (models.ServiceHeaders, error) {
 	return *a.c.Headers, nil
 }
+
+func (a auditLoggerConfig) LocalEnabled() bool {
+	return *a.c.LocalEnabled
+}
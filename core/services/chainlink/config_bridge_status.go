@@ -47,3 +47,24 @@ func (e *bridgeStatusReporterConfig) IgnoreJoblessBridges() bool {
 	}
 	return *e.c.IgnoreJoblessBridges
 }
+
+func (e *bridgeStatusReporterConfig) HealthCheckInterval() time.Duration {
+	if e.c.HealthCheckInterval == nil {
+		return 30 * time.Second
+	}
+	return e.c.HealthCheckInterval.Duration()
+}
+
+func (e *bridgeStatusReporterConfig) CircuitBreakerThreshold() uint32 {
+	if e.c.CircuitBreakerThreshold == nil {
+		return 0
+	}
+	return *e.c.CircuitBreakerThreshold
+}
+
+func (e *bridgeStatusReporterConfig) CircuitBreakerCooldown() time.Duration {
+	if e.c.CircuitBreakerCooldown == nil {
+		return time.Minute
+	}
+	return e.c.CircuitBreakerCooldown.Duration()
+}
@@ -0,0 +1,57 @@
+package chainlink
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.ExternalSigner = (*externalSignerConfig)(nil)
+
+type externalSignerConfig struct {
+	c toml.ExternalSigner
+	s toml.ExternalSignerSecrets
+}
+
+func (e *externalSignerConfig) Provider() string {
+	return *e.c.Provider
+}
+
+func (e *externalSignerConfig) PKCS11() config.ExternalSignerPKCS11 {
+	return &externalSignerPKCS11Config{c: e.c.PKCS11, s: e.s.PKCS11}
+}
+
+func (e *externalSignerConfig) Remote() config.ExternalSignerRemote {
+	return &externalSignerRemoteConfig{c: e.c.Remote}
+}
+
+type externalSignerPKCS11Config struct {
+	c toml.ExternalSignerPKCS11
+	s toml.ExternalSignerPKCS11Secrets
+}
+
+func (e *externalSignerPKCS11Config) Module() string {
+	return *e.c.Module
+}
+
+func (e *externalSignerPKCS11Config) TokenLabel() string {
+	return *e.c.TokenLabel
+}
+
+func (e *externalSignerPKCS11Config) PIN() string {
+	if e.s.PIN == nil {
+		return ""
+	}
+	return string(*e.s.PIN)
+}
+
+type externalSignerRemoteConfig struct {
+	c toml.ExternalSignerRemote
+}
+
+func (e *externalSignerRemoteConfig) URL() string {
+	return *e.c.URL
+}
+
+func (e *externalSignerRemoteConfig) TLSCertPath() string {
+	return *e.c.TLSCertPath
+}
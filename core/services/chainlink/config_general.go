@@ -596,4 +596,8 @@ func (g *generalConfig) BridgeStatusReporter() coreconfig.BridgeStatusReporter {
 	return &bridgeStatusReporterConfig{c: g.c.BridgeStatusReporter}
 }
 
+func (g *generalConfig) ExternalSigner() coreconfig.ExternalSigner {
+	return &externalSignerConfig{c: g.c.ExternalSigner, s: g.secrets.ExternalSigner}
+}
+
 var zeroSha256Hash = models.Sha256Hash{}
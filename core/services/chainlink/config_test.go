@@ -280,6 +280,7 @@ func TestConfig_Marshal(t *testing.T) {
 		ForwardToUrl:   mustURL("http://localhost:9898"),
 		Headers:        ptr(serviceHeaders),
 		JsonWrapperKey: ptr("event"),
+		LocalEnabled:   ptr(true),
 	}
 
 	full.Feature = toml.Feature{
@@ -405,6 +406,12 @@ func TestConfig_Marshal(t *testing.T) {
 			ForceRedirect: ptr(true),
 			ListenIP:      mustIP("192.158.1.38"),
 		},
+		Roles: []toml.WebServerRole{{
+			Name:        ptr("bridge-operator"),
+			Permissions: []string{"bridges:edit", "jobs:run:42"},
+			LDAPGroupCN: ptr("NodeBridgeOperators"),
+			OIDCClaim:   ptr("NodeBridgeOperators"),
+		}},
 	}
 	full.JobPipeline = toml.JobPipeline{
 		ExternalInitiatorsEnabled: ptr(true),
@@ -649,6 +656,21 @@ func TestConfig_Marshal(t *testing.T) {
 		PollingInterval:      commoncfg.MustNewDuration(5 * time.Minute),
 		IgnoreInvalidBridges: ptr(true),
 		IgnoreJoblessBridges: ptr(false),
+
+		HealthCheckInterval:     commoncfg.MustNewDuration(time.Minute),
+		CircuitBreakerThreshold: ptr[uint32](5),
+		CircuitBreakerCooldown:  commoncfg.MustNewDuration(2 * time.Minute),
+	}
+	full.ExternalSigner = toml.ExternalSigner{
+		Provider: ptr("pkcs11"),
+		PKCS11: toml.ExternalSignerPKCS11{
+			Module:     ptr("/usr/lib/softhsm/libsofthsm2.so"),
+			TokenLabel: ptr("chainlink"),
+		},
+		Remote: toml.ExternalSignerRemote{
+			URL:         ptr("localhost:9443"),
+			TLSCertPath: ptr("/path/to/ca.pem"),
+		},
 	}
 	full.JobDistributor = toml.JobDistributor{
 		DisplayName: ptr("test-node"),
@@ -958,6 +980,7 @@ Enabled = true
 ForwardToUrl = 'http://localhost:9898'
 JsonWrapperKey = 'event'
 Headers = ['Authorization: token', 'X-SomeOther-Header: value with spaces | and a bar+*']
+LocalEnabled = true
 `},
 		{"Feature", Config{Core: toml.Core{Feature: full.Feature}}, `[Feature]
 FeedsManager = true
@@ -1082,6 +1105,12 @@ Host = 'tls-host'
 HTTPSPort = 6789
 KeyPath = 'tls/key/path'
 ListenIP = '192.158.1.38'
+
+[[WebServer.Roles]]
+Name = 'bridge-operator'
+Permissions = ['bridges:edit', 'jobs:run:42']
+LDAPGroupCN = 'NodeBridgeOperators'
+OIDCClaim = 'NodeBridgeOperators'
 `},
 		{"FluxMonitor", Config{Core: toml.Core{FluxMonitor: full.FluxMonitor}}, `[FluxMonitor]
 DefaultTransactionQueueDepth = 100
@@ -1557,7 +1586,9 @@ func TestConfig_Validate(t *testing.T) {
 		{name: "invalid", toml: invalidTOML, exp: `invalid configuration: 10 errors:
 	- P2P.V2.Enabled: invalid value (false): P2P required for OCR or OCR2. Please enable P2P or disable OCR/OCR2.
 	- Database.Lock.LeaseRefreshInterval: invalid value (6s): must be less than or equal to half of LeaseDuration (10s)
-	- WebServer: 8 errors:
+	- WebServer: 10 errors:
+		- Roles.0.Name: invalid value (admin): can not redefine a built-in role
+		- Roles.0.Permissions: invalid value (bridges:write): invalid permission "bridges:write": unknown action "write", expected one of [view run edit admin]
 		- LDAP.BaseDN: invalid value (<nil>): LDAP BaseDN can not be empty
 		- LDAP.BaseUserAttr: invalid value (<nil>): LDAP BaseUserAttr can not be empty
 		- LDAP.UsersDN: invalid value (<nil>): LDAP UsersDN can not be empty
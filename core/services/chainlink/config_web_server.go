@@ -122,6 +122,14 @@ func (w *webServerConfig) OIDC() config.OIDC {
 	return &oidcConfig{c: w.c.OIDC, s: w.s.OIDC}
 }
 
+func (w *webServerConfig) Roles() []config.WebServerRole {
+	var roles []config.WebServerRole
+	for _, r := range w.c.Roles {
+		roles = append(roles, &webServerRoleConfig{c: r})
+	}
+	return roles
+}
+
 func (w *webServerConfig) AuthenticationMethod() string {
 	return *w.c.AuthenticationMethod
 }
@@ -406,3 +414,32 @@ func (l *oidcConfig) UserAPITokenDuration() commonconfig.Duration {
 	}
 	return *l.c.UserAPITokenDuration
 }
+
+type webServerRoleConfig struct {
+	c toml.WebServerRole
+}
+
+func (r *webServerRoleConfig) Name() string {
+	if r.c.Name == nil {
+		return ""
+	}
+	return *r.c.Name
+}
+
+func (r *webServerRoleConfig) Permissions() []string {
+	return r.c.Permissions
+}
+
+func (r *webServerRoleConfig) LDAPGroupCN() string {
+	if r.c.LDAPGroupCN == nil {
+		return ""
+	}
+	return *r.c.LDAPGroupCN
+}
+
+func (r *webServerRoleConfig) OIDCClaim() string {
+	if r.c.OIDCClaim == nil {
+		return ""
+	}
+	return *r.c.OIDCClaim
+}
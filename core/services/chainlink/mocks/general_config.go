@@ -786,6 +786,53 @@ func (_c *GeneralConfig_EVMEnabled_Call) RunAndReturn(run func() bool) *GeneralC
 	return _c
 }
 
+// ExternalSigner provides a mock function with no fields
+func (_m *GeneralConfig) ExternalSigner() config.ExternalSigner {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExternalSigner")
+	}
+
+	var r0 config.ExternalSigner
+	if rf, ok := ret.Get(0).(func() config.ExternalSigner); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.ExternalSigner)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_ExternalSigner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExternalSigner'
+type GeneralConfig_ExternalSigner_Call struct {
+	*mock.Call
+}
+
+// ExternalSigner is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) ExternalSigner() *GeneralConfig_ExternalSigner_Call {
+	return &GeneralConfig_ExternalSigner_Call{Call: _e.mock.On("ExternalSigner")}
+}
+
+func (_c *GeneralConfig_ExternalSigner_Call) Run(run func()) *GeneralConfig_ExternalSigner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_ExternalSigner_Call) Return(_a0 config.ExternalSigner) *GeneralConfig_ExternalSigner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_ExternalSigner_Call) RunAndReturn(run func() config.ExternalSigner) *GeneralConfig_ExternalSigner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Feature provides a mock function with no fields
 func (_m *GeneralConfig) Feature() config.Feature {
 	ret := _m.Called()
@@ -1494,6 +1494,7 @@ func (s *service) generateJob(ctx context.Context, spec string) (*job.Job, error
 	if err != nil {
 		return nil, err
 	}
+	js.TOML = spec
 
 	return &js, nil
 }
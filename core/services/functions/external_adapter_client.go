@@ -42,6 +42,7 @@ type ExternalAdapterClient interface {
 
 type externalAdapterClient struct {
 	adapterURL             url.URL
+	httpClient             *http.Client
 	maxResponseBytes       int64
 	maxRetries             int
 	exponentialBackoffBase time.Duration
@@ -55,6 +56,7 @@ type BridgeAccessor interface {
 
 type bridgeAccessor struct {
 	bridgeORM              bridges.ORM
+	httpClient             *http.Client
 	bridgeName             string
 	maxResponseBytes       int64
 	maxRetries             int
@@ -199,6 +201,9 @@ func (ea *externalAdapterClient) request(
 	retryClient := retryablehttp.NewClient()
 	retryClient.RetryMax = ea.maxRetries
 	retryClient.RetryWaitMin = ea.exponentialBackoffBase
+	if ea.httpClient != nil {
+		retryClient.HTTPClient = ea.httpClient
+	}
 
 	client := retryClient.StandardClient()
 	resp, err := client.Do(req)
@@ -256,7 +261,10 @@ func (ea *externalAdapterClient) request(
 
 func NewBridgeAccessor(bridgeORM bridges.ORM, bridgeName string, maxResponseBytes int64, maxRetries int, exponentialBackoffBase time.Duration) BridgeAccessor {
 	return &bridgeAccessor{
-		bridgeORM:              bridgeORM,
+		bridgeORM: bridgeORM,
+		// Shared by every client, so that the per bridge clients built on top
+		// of it, see bridges.BridgeType.HTTPClient, are reused.
+		httpClient:             retryablehttp.NewClient().HTTPClient,
 		bridgeName:             bridgeName,
 		maxResponseBytes:       maxResponseBytes,
 		maxRetries:             maxRetries,
@@ -269,5 +277,15 @@ func (b *bridgeAccessor) NewExternalAdapterClient(ctx context.Context) (External
 	if err != nil {
 		return nil, err
 	}
-	return NewExternalAdapterClient(url.URL(bridge.URL), b.maxResponseBytes, b.maxRetries, b.exponentialBackoffBase), nil
+	httpClient, err := bridge.HTTPClient(b.httpClient, b.maxResponseBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &externalAdapterClient{
+		adapterURL:             url.URL(bridge.URL),
+		httpClient:             httpClient,
+		maxResponseBytes:       b.maxResponseBytes,
+		maxRetries:             b.maxRetries,
+		exponentialBackoffBase: b.exponentialBackoffBase,
+	}, nil
 }
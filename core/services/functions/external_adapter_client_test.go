@@ -12,9 +12,13 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	bridgemocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 func runFetcherTest(t *testing.T, adapterJSONResponse, expectedSecrets, expectedUserError string, expectedError error) {
@@ -299,3 +303,33 @@ const runComputationSuccessResponse = `{
 		},
 		"statusCode": 200
 	}`
+
+func TestBridgeAccessor_SignedRequests(t *testing.T) {
+	const secret = "0123456789abcdef0123456789abcdef"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		expected := bridges.SignRequest(secret, r.Header.Get(bridges.TimestampHeader), body)
+		if r.Header.Get(bridges.RequestSignatureHeader) != expected {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprintln(w, runComputationSuccessResponse)
+	}))
+	defer ts.Close()
+
+	adapterURL, err := url.Parse(ts.URL)
+	assert.NoError(t, err)
+	bridgeORM := bridgemocks.NewORM(t)
+	bridgeORM.On("FindBridge", mock.Anything, bridges.BridgeName("ea_bridge")).Return(bridges.BridgeType{
+		Name:           "ea_bridge",
+		URL:            models.WebURL(*adapterURL),
+		BridgeSecurity: bridges.BridgeSecurity{RequestSigningSecret: secret},
+	}, nil)
+
+	ea, err := functions.NewBridgeAccessor(bridgeORM, "ea_bridge", 100_000, 0, 0).NewExternalAdapterClient(testutils.Context(t))
+	assert.NoError(t, err)
+	userResult, _, _, err := ea.RunComputation(testutils.Context(t), "requestID1234", "TestJob", "SubOwner", 1, functions.RequestFlags{}, "", &functions.RequestData{})
+	assert.NoError(t, err)
+	assert.Equal(t, "abcdef", string(userResult))
+}
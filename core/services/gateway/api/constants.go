@@ -17,8 +17,13 @@ const (
 	StaleNodeResponseError
 	ConflictError
 	LimitExceededError
+	UnauthorizedError
 )
 
+// ErrUnauthorized is the JSON-RPC error code of requests from users the
+// gateway could not authenticate, which jsonrpc2 does not define.
+const ErrUnauthorized int64 = -32004
+
 func (e ErrorCode) String() string {
 	switch e {
 	case NoError:
@@ -45,6 +50,8 @@ func (e ErrorCode) String() string {
 		return "ConflictError"
 	case LimitExceededError:
 		return "LimitExceededError"
+	case UnauthorizedError:
+		return "UnauthorizedError"
 	default:
 		return "UnknownError"
 	}
@@ -65,6 +72,7 @@ func ToJSONRPCErrorCode(errorCode ErrorCode) int64 {
 		StaleNodeResponseError:   jsonrpc2.ErrInternal,         // Internal Error
 		ConflictError:            jsonrpc2.ErrConflict,         // Conflict
 		LimitExceededError:       jsonrpc2.ErrLimitExceeded,    // Limit Exceeded
+		UnauthorizedError:        ErrUnauthorized,              // Unauthorized
 	}
 
 	code, ok := gatewayErrorToJSONRPCError[errorCode]
@@ -85,6 +93,7 @@ func FromJSONRPCErrorCode(errorCode int64) ErrorCode {
 		jsonrpc2.ErrMethodNotFound:   UnsupportedMethodError,
 		jsonrpc2.ErrLimitExceeded:    LimitExceededError,
 		jsonrpc2.ErrConflict:         ConflictError,
+		ErrUnauthorized:              UnauthorizedError,
 	}
 
 	code, ok := jsonrpcErrorToGatewayError[errorCode]
@@ -109,6 +118,7 @@ func ToHttpErrorCode(errorCode ErrorCode) int {
 		StaleNodeResponseError:   500, // Internal Server Error
 		ConflictError:            409, // Conflict
 		LimitExceededError:       429, // Too Many Requests
+		UnauthorizedError:        401, // Unauthorized
 	}
 
 	code, ok := gatewayErrorToHTTPError[errorCode]
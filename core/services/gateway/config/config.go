@@ -12,7 +12,11 @@ type GatewayConfig struct {
 	ConnectionManagerConfig ConnectionManagerConfig
 	// HTTPClientConfig is configuration for outbound HTTP calls to external endpoints
 	HTTPClientConfig gw_net.HTTPClientConfig
-	Dons             []DONConfig
+	// ReplicaConfig lets several replicas of the gateway serve the same DONs
+	ReplicaConfig ReplicaConfig
+	// UserAuthConfig identifies users, and enforces their quotas, before their requests reach the handlers
+	UserAuthConfig UserAuthConfig
+	Dons           []DONConfig
 }
 
 type ConnectionManagerConfig struct {
@@ -22,6 +26,63 @@ type ConnectionManagerConfig struct {
 	HeartbeatIntervalSec      uint32
 }
 
+// ReplicaConfig configures a gateway replica. Replicas share the state which
+// records which replica a node is connected to, and which replica sent a
+// request to the nodes, and forward messages to each other accordingly.
+type ReplicaConfig struct {
+	// ReplicaId is unique among the replicas of a gateway, replication is disabled if it is empty
+	ReplicaId string
+	// StateBackend is "postgres" (default), "redis" or "memory", which is only
+	// shared by replicas in the same process and is rejected for gateway jobs
+	StateBackend string
+	// StateURL is the URL of the "redis" backend, as redis://[[user]:password@]host[:port][/db] or rediss://
+	StateURL string
+	// StateTTLSec is how long state is kept, it must exceed the longest user request
+	StateTTLSec uint32
+	// AdvertiseURL is the URL of ForwardServerConfig that other replicas forward messages to
+	AdvertiseURL string
+	// ForwardServerConfig is the server accepting messages forwarded by other replicas
+	ForwardServerConfig gw_net.HTTPServerConfig
+	// ForwardingSecret is shared by all replicas, which authenticate to each other with it
+	ForwardingSecret string
+}
+
+// UserAuthConfig configures authentication of users by the gateway, shared by
+// all handlers. Users send an API key as "ApiKey <key>", or a JWT as
+// "Bearer <token>", in the X-Gateway-Auth header. It is disabled if neither
+// APIKeys nor JWKSURL are set.
+type UserAuthConfig struct {
+	APIKeys []APIKeyConfig
+	// JWKSURL serves the keys which JWTs are verified with, JWTs are rejected if it is empty
+	JWKSURL string
+	// JWKSRefreshIntervalSec is how often keys are fetched from JWKSURL, defaults to 300
+	JWKSRefreshIntervalSec uint32
+	// JWTIssuer and JWTAudience are required claims of JWTs, if set
+	JWTIssuer   string
+	JWTAudience string
+	// DefaultQuota applies to users without an entry in Quotas. Quotas are
+	// enforced by each gateway process, so they are rejected if ReplicaConfig.ReplicaId is set
+	DefaultQuota QuotaConfig
+	// Quotas of users, by their identity: the name of their API key, or the subject of their JWTs
+	Quotas map[string]QuotaConfig
+}
+
+type APIKeyConfig struct {
+	// Identity names the user of the key in quotas and metrics
+	Identity string
+	// KeyHash is the hex-encoded SHA-256 hash of the key
+	KeyHash string
+}
+
+// QuotaConfig limits the usage of the gateway by a user. Zero values mean no
+// limit. Replicated gateways do not support quotas.
+type QuotaConfig struct {
+	RequestsPerMinute uint32
+	// BytesPerDay bounds the size of requests and responses, including streamed events, per UTC day
+	BytesPerDay           uint64
+	MaxConcurrentRequests uint32
+}
+
 type DONConfig struct {
 	DonId         string
 	HandlerName   string          // Deprecated: use Handlers instead
@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -26,6 +28,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/monitoring"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/state"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
@@ -34,12 +37,31 @@ var promKeepalivesSent = promauto.NewGaugeVec(prometheus.GaugeOpts{
 	Help: "Metric to track the number of successful keepalive ping messages per DON",
 }, []string{"don_id"})
 
+var (
+	ErrNodeNotFound     = errors.New("node not found")
+	ErrNodeNotConnected = errors.New("node not connected to this gateway replica")
+)
+
 // ConnectionManager holds all connections between Gateway and Nodes.
 type ConnectionManager interface {
 	job.ServiceCtx
 	network.ConnectionAcceptor
 
 	DONConnectionManager(donId string) *donConnectionManager
+	// NewDONConnectionManager creates the connection manager of a DON, which
+	// is not tracked until it is passed to AddDON.
+	NewDONConnectionManager(donConfig *config.DONConfig) (*donConnectionManager, error)
+	// AddDON starts accepting connections from the nodes of a DON, whose
+	// handler must be set.
+	AddDON(ctx context.Context, donConnMgr *donConnectionManager) error
+	// RemoveDON disconnects all nodes of a DON and stops tracking it.
+	RemoveDON(donId string) error
+	// PendingHandshakes returns the number of nodes of a DON which started a
+	// handshake that has not been finalized or aborted yet.
+	PendingHandshakes(donId string) int
+	// DisconnectNode closes the connection of a node to this gateway replica.
+	// The node is free to reconnect.
+	DisconnectNode(donId string, nodeAddress string) error
 	GetPort() int
 }
 
@@ -48,21 +70,32 @@ type connectionManager struct {
 
 	config             *config.ConnectionManagerConfig
 	dons               map[string]*donConnectionManager
+	donsMu             sync.RWMutex
+	running            bool
 	wsServer           network.WebSocketServer
 	clock              clockwork.Clock
 	connAttempts       map[string]*connAttempt
 	connAttemptCounter uint64
 	connAttemptsMu     sync.Mutex
 	gMetrics           *monitoring.GatewayMetrics
-	lggr               logger.Logger
+	// nil unless replication is enabled
+	replicas *replicator
+	lggr     logger.Logger
 }
 
 func (m *connectionManager) HealthReport() map[string]error {
 	hr := map[string]error{m.Name(): m.Healthy()}
+	if m.replicas != nil {
+		services.CopyHealth(hr, m.replicas.HealthReport())
+	}
+	m.donsMu.RLock()
+	defer m.donsMu.RUnlock()
 	for _, d := range m.dons {
+		d.nodesMu.RLock()
 		for _, n := range d.nodes {
 			services.CopyHealth(hr, n.conn.HealthReport())
 		}
+		d.nodesMu.RUnlock()
 	}
 	return hr
 }
@@ -72,68 +105,87 @@ func (m *connectionManager) Name() string { return m.lggr.Name() }
 type donConnectionManager struct {
 	donConfig  *config.DONConfig
 	nodes      map[string]*nodeState
+	nodesMu    sync.RWMutex
+	started    bool
 	handler    handlers.Handler
 	closeWait  sync.WaitGroup
 	shutdownCh services.StopChan
 	gMetrics   *monitoring.GatewayMetrics
+	replicas   *replicator
 	lggr       logger.Logger
 }
 
 type nodeState struct {
 	name string
 	conn network.WSConnectionWrapper
+	// stopCh is closed when the node is removed from its DON.
+	stopCh services.StopChan
+
+	statusMu sync.Mutex
+	// wsConn is the current connection of the node, nil if it is disconnected.
+	wsConn        *websocket.Conn
+	connectedAt   time.Time
+	lastHeartbeat time.Time
+}
+
+// NodeStatus is the state of the connection of a node to this gateway replica.
+type NodeStatus struct {
+	Address   string
+	Name      string
+	Connected bool
+	// ConnectedAt is the time the current connection was established, zero
+	// if the node is disconnected.
+	ConnectedAt time.Time
+	// LastHeartbeat is the time of the last keepalive pong from the node, on
+	// any of its connections. Zero if it never responded to a keepalive.
+	LastHeartbeat time.Time
 }
 
 // immutable
 type connAttempt struct {
 	nodeState   *nodeState
+	donId       string
 	nodeAddress string
 	challenge   network.ChallengeElems
 	timestamp   uint32
 }
 
 func NewConnectionManager(gwConfig *config.GatewayConfig, clock clockwork.Clock, gMetrics *monitoring.GatewayMetrics, lggr logger.Logger, lf limits.Factory) (ConnectionManager, error) {
-	dons := make(map[string]*donConnectionManager)
-	for _, donConfig := range gwConfig.Dons {
-		if donConfig.DonId == "" {
-			return nil, errors.New("empty DON ID")
-		}
-		_, ok := dons[donConfig.DonId]
-		if ok {
-			return nil, fmt.Errorf("duplicate DON ID %s", donConfig.DonId)
-		}
-		nodes := make(map[string]*nodeState)
-		for _, nodeConfig := range donConfig.Members {
-			nodeAddress := strings.ToLower(nodeConfig.Address)
-			_, ok := nodes[nodeAddress]
-			if ok {
-				return nil, fmt.Errorf("duplicate node address %s in DON %s", nodeAddress, donConfig.DonId)
-			}
-			connWrapper := network.NewWSConnectionWrapper(lggr)
-			if connWrapper == nil {
-				return nil, fmt.Errorf("error creating WSConnectionWrapper for node %s", nodeAddress)
-			}
-			nodes[nodeAddress] = &nodeState{
-				name: nodeConfig.Name,
-				conn: connWrapper,
-			}
-		}
-		dons[donConfig.DonId] = &donConnectionManager{
-			donConfig:  &donConfig,
-			nodes:      nodes,
-			shutdownCh: make(chan struct{}),
-			gMetrics:   gMetrics,
-			lggr:       logger.Named(lggr, "DONConnectionManager."+donConfig.DonId),
-		}
-	}
+	return newConnectionManager(gwConfig, nil, clock, gMetrics, lggr, lf)
+}
+
+// newConnectionManager creates a connection manager which shares the state of
+// replicas through store, if replication is enabled. If store is nil, it is
+// created from the config.
+func newConnectionManager(gwConfig *config.GatewayConfig, store state.Store, clock clockwork.Clock, gMetrics *monitoring.GatewayMetrics, lggr logger.Logger, lf limits.Factory) (*connectionManager, error) {
 	connMgr := &connectionManager{
 		config:       &gwConfig.ConnectionManagerConfig,
-		dons:         dons,
+		dons:         make(map[string]*donConnectionManager),
 		connAttempts: make(map[string]*connAttempt),
 		clock:        clock,
 		gMetrics:     gMetrics,
 		lggr:         logger.Named(lggr, "ConnectionManager"),
 	}
+	if gwConfig.ReplicaConfig.ReplicaId != "" {
+		replicas, err := newReplicator(gwConfig, store, lggr, lf)
+		if err != nil {
+			return nil, err
+		}
+		replicas.connMgr = connMgr
+		connMgr.replicas = replicas
+	}
+	for i := range gwConfig.Dons {
+		donConfig := &gwConfig.Dons[i]
+		_, ok := connMgr.dons[donConfig.DonId]
+		if ok {
+			return nil, fmt.Errorf("duplicate DON ID %s", donConfig.DonId)
+		}
+		donConnMgr, err := newDONConnectionManager(donConfig, gMetrics, connMgr.replicas, lggr)
+		if err != nil {
+			return nil, err
+		}
+		connMgr.dons[donConfig.DonId] = donConnMgr
+	}
 	wsServer, err := network.NewWebSocketServer(&gwConfig.NodeServerConfig, connMgr, lggr, lf)
 	if err != nil {
 		return nil, err
@@ -142,23 +194,90 @@ func NewConnectionManager(gwConfig *config.GatewayConfig, clock clockwork.Clock,
 	return connMgr, nil
 }
 
+func newDONConnectionManager(donConfig *config.DONConfig, gMetrics *monitoring.GatewayMetrics, replicas *replicator, lggr logger.Logger) (*donConnectionManager, error) {
+	if donConfig.DonId == "" {
+		return nil, errors.New("empty DON ID")
+	}
+	donConnMgr := &donConnectionManager{
+		donConfig:  donConfig,
+		nodes:      make(map[string]*nodeState),
+		shutdownCh: make(chan struct{}),
+		gMetrics:   gMetrics,
+		replicas:   replicas,
+		lggr:       logger.Named(lggr, "DONConnectionManager."+donConfig.DonId),
+	}
+	for _, nodeConfig := range donConfig.Members {
+		nodeAddress := strings.ToLower(nodeConfig.Address)
+		_, ok := donConnMgr.nodes[nodeAddress]
+		if ok {
+			return nil, fmt.Errorf("duplicate node address %s in DON %s", nodeAddress, donConfig.DonId)
+		}
+		nodeState, err := donConnMgr.newNodeState(nodeAddress, nodeConfig.Name)
+		if err != nil {
+			return nil, err
+		}
+		donConnMgr.nodes[nodeAddress] = nodeState
+	}
+	return donConnMgr, nil
+}
+
 func (m *connectionManager) DONConnectionManager(donId string) *donConnectionManager {
+	m.donsMu.RLock()
+	defer m.donsMu.RUnlock()
 	return m.dons[donId]
 }
 
+func (m *connectionManager) NewDONConnectionManager(donConfig *config.DONConfig) (*donConnectionManager, error) {
+	return newDONConnectionManager(donConfig, m.gMetrics, m.replicas, m.lggr)
+}
+
+func (m *connectionManager) AddDON(ctx context.Context, donConnMgr *donConnectionManager) error {
+	m.donsMu.Lock()
+	defer m.donsMu.Unlock()
+	donId := donConnMgr.donConfig.DonId
+	if _, ok := m.dons[donId]; ok {
+		return fmt.Errorf("duplicate DON ID %s", donId)
+	}
+	if m.running {
+		if err := donConnMgr.start(ctx, m.config.HeartbeatIntervalSec); err != nil {
+			return err
+		}
+	}
+	m.dons[donId] = donConnMgr
+	m.lggr.Infow("added DON", "donID", donId)
+	return nil
+}
+
+func (m *connectionManager) RemoveDON(donId string) error {
+	m.donsMu.Lock()
+	donConnMgr, ok := m.dons[donId]
+	delete(m.dons, donId)
+	m.donsMu.Unlock()
+	if !ok {
+		return fmt.Errorf("DON %s not found", donId)
+	}
+	donConnMgr.shutdown()
+	donConnMgr.closeWait.Wait()
+	m.lggr.Infow("removed DON", "donID", donId)
+	return nil
+}
+
 func (m *connectionManager) Start(ctx context.Context) error {
 	return m.StartOnce("ConnectionManager", func() error {
 		m.lggr.Info("starting connection manager")
+		m.donsMu.Lock()
 		for _, donConnMgr := range m.dons {
-			donConnMgr.closeWait.Add(len(donConnMgr.nodes))
-			for nodeAddress, nodeState := range donConnMgr.nodes {
-				if err := nodeState.conn.Start(ctx); err != nil {
-					return err
-				}
-				go donConnMgr.readLoop(nodeAddress, nodeState)
+			if err := donConnMgr.start(ctx, m.config.HeartbeatIntervalSec); err != nil {
+				m.donsMu.Unlock()
+				return err
+			}
+		}
+		m.running = true
+		m.donsMu.Unlock()
+		if m.replicas != nil {
+			if err := m.replicas.Start(ctx); err != nil {
+				return err
 			}
-			donConnMgr.closeWait.Add(1)
-			go donConnMgr.keepaliveLoop(m.config.HeartbeatIntervalSec)
 		}
 		return m.wsServer.Start(ctx)
 	})
@@ -168,13 +287,17 @@ func (m *connectionManager) Close() error {
 	return m.StopOnce("ConnectionManager", func() (err error) {
 		m.lggr.Info("closing connection manager")
 		err = errors.Join(err, m.wsServer.Close())
-		for _, donConnMgr := range m.dons {
-			close(donConnMgr.shutdownCh)
-			for _, nodeState := range donConnMgr.nodes {
-				nodeState.conn.Close()
-			}
+		if m.replicas != nil {
+			err = errors.Join(err, m.replicas.Close())
 		}
-		for _, donConnMgr := range m.dons {
+		m.donsMu.Lock()
+		m.running = false
+		dons := slices.Collect(maps.Values(m.dons))
+		m.donsMu.Unlock()
+		for _, donConnMgr := range dons {
+			donConnMgr.shutdown()
+		}
+		for _, donConnMgr := range dons {
 			donConnMgr.closeWait.Wait()
 		}
 		return
@@ -188,12 +311,12 @@ func (m *connectionManager) StartHandshake(authHeader []byte) (attemptId string,
 		return "", nil, errors.Join(network.ErrAuthHeaderParse, err)
 	}
 	nodeAddress := "0x" + hex.EncodeToString(signer)
-	donConnMgr, ok := m.dons[authHeaderElems.DonId]
-	if !ok {
+	donConnMgr := m.DONConnectionManager(authHeaderElems.DonId)
+	if donConnMgr == nil {
 		return "", nil, network.ErrAuthInvalidDonId
 	}
-	nodeState, ok := donConnMgr.nodes[nodeAddress]
-	if !ok {
+	nodeState := donConnMgr.node(nodeAddress)
+	if nodeState == nil {
 		return "", nil, network.ErrAuthInvalidNode
 	}
 	if authHeaderElems.GatewayId != m.config.AuthGatewayId {
@@ -204,14 +327,14 @@ func (m *connectionManager) StartHandshake(authHeader []byte) (attemptId string,
 	if ts < nowTs-m.config.AuthTimestampToleranceSec || nowTs+m.config.AuthTimestampToleranceSec < ts {
 		return "", nil, network.ErrAuthInvalidTimestamp
 	}
-	attemptId, challenge, err = m.newAttempt(nodeState, nodeAddress, ts)
+	attemptId, challenge, err = m.newAttempt(nodeState, authHeaderElems.DonId, nodeAddress, ts)
 	if err != nil {
 		return "", nil, err
 	}
 	return attemptId, challenge, nil
 }
 
-func (m *connectionManager) newAttempt(nodeSt *nodeState, nodeAddress string, timestamp uint32) (string, []byte, error) {
+func (m *connectionManager) newAttempt(nodeSt *nodeState, donId string, nodeAddress string, timestamp uint32) (string, []byte, error) {
 	challengeBytes := make([]byte, m.config.AuthChallengeLen)
 	_, err := rand.Read(challengeBytes)
 	if err != nil {
@@ -222,7 +345,7 @@ func (m *connectionManager) newAttempt(nodeSt *nodeState, nodeAddress string, ti
 	defer m.connAttemptsMu.Unlock()
 	m.connAttemptCounter++
 	newId := fmt.Sprintf("%s_%d", nodeAddress, m.connAttemptCounter)
-	m.connAttempts[newId] = &connAttempt{nodeState: nodeSt, nodeAddress: nodeAddress, challenge: challenge, timestamp: timestamp}
+	m.connAttempts[newId] = &connAttempt{nodeState: nodeSt, donId: donId, nodeAddress: nodeAddress, challenge: challenge, timestamp: timestamp}
 	return newId, network.PackChallenge(&challenge), nil
 }
 
@@ -239,15 +362,34 @@ func (m *connectionManager) FinalizeHandshake(attemptId string, response []byte,
 	if err != nil || attempt.nodeAddress != "0x"+hex.EncodeToString(signer) {
 		return network.ErrChallengeInvalidSignature
 	}
+	select {
+	case <-attempt.nodeState.stopCh:
+		// removed from its DON during the handshake
+		return network.ErrAuthInvalidNode
+	default:
+	}
+	nodeSt := attempt.nodeState
 	if conn != nil {
 		conn.SetPongHandler(func(data string) error {
 			m.lggr.Debugw("received keepalive pong from node", "nodeAddress", attempt.nodeAddress)
-			m.gMetrics.RecordKeepalivePongsReceived(context.Background(), attempt.nodeAddress, attempt.nodeState.name)
+			m.gMetrics.RecordKeepalivePongsReceived(context.Background(), attempt.nodeAddress, nodeSt.name)
+			nodeSt.recordHeartbeat(m.clock.Now())
 			return nil
 		})
 	}
-	attempt.nodeState.conn.Reset(conn)
+	if closeCh := nodeSt.conn.Reset(conn); closeCh != nil {
+		nodeSt.setConnected(conn, m.clock.Now())
+		go func() {
+			<-closeCh
+			nodeSt.setDisconnected(conn)
+		}()
+	}
 	m.lggr.Infof("node %s connected", attempt.nodeAddress)
+	if m.replicas != nil {
+		if err := m.replicas.recordNode(context.Background(), attempt.donId, attempt.nodeAddress); err != nil {
+			m.lggr.Errorw("failed to record node connected to this replica", "nodeAddress", attempt.nodeAddress, "err", err)
+		}
+	}
 	m.gMetrics.RecordNodeConnectedEvent(context.Background(), attempt.nodeAddress, attempt.nodeState.name)
 	return nil
 }
@@ -259,10 +401,204 @@ func (m *connectionManager) AbortHandshake(attemptId string) {
 	delete(m.connAttempts, attemptId)
 }
 
+func (m *connectionManager) PendingHandshakes(donId string) int {
+	m.connAttemptsMu.Lock()
+	defer m.connAttemptsMu.Unlock()
+	var count int
+	for _, attempt := range m.connAttempts {
+		if attempt.donId == donId {
+			count++
+		}
+	}
+	return count
+}
+
+func (m *connectionManager) DisconnectNode(donId string, nodeAddress string) error {
+	donConnMgr := m.DONConnectionManager(donId)
+	if donConnMgr == nil {
+		return fmt.Errorf("%w: unknown DON %s", ErrNodeNotFound, donId)
+	}
+	nodeAddress = strings.ToLower(nodeAddress)
+	nodeState := donConnMgr.node(nodeAddress)
+	if nodeState == nil {
+		return fmt.Errorf("%w: %s is not a member of DON %s", ErrNodeNotFound, nodeAddress, donId)
+	}
+	conn := nodeState.currentConn()
+	if conn == nil {
+		return fmt.Errorf("%w: %s", ErrNodeNotConnected, nodeAddress)
+	}
+	nodeState.conn.Reset(nil)
+	// reported as disconnected right away, rather than once the close is noticed
+	nodeState.setDisconnected(conn)
+	m.lggr.Infow("disconnected node", "donID", donId, "nodeAddress", nodeAddress)
+	return nil
+}
+
 func (m *connectionManager) GetPort() int {
 	return m.wsServer.GetPort()
 }
 
+func (m *donConnectionManager) newNodeState(nodeAddress string, name string) (*nodeState, error) {
+	connWrapper := network.NewWSConnectionWrapper(m.lggr)
+	if connWrapper == nil {
+		return nil, fmt.Errorf("error creating WSConnectionWrapper for node %s", nodeAddress)
+	}
+	return &nodeState{
+		name:   name,
+		conn:   connWrapper,
+		stopCh: make(services.StopChan),
+	}, nil
+}
+
+func (n *nodeState) setConnected(conn *websocket.Conn, now time.Time) {
+	n.statusMu.Lock()
+	defer n.statusMu.Unlock()
+	n.wsConn = conn
+	n.connectedAt = now
+}
+
+// setDisconnected is called once conn is closed, which may have been replaced
+// by a new connection of the node already.
+func (n *nodeState) setDisconnected(conn *websocket.Conn) {
+	n.statusMu.Lock()
+	defer n.statusMu.Unlock()
+	if n.wsConn == conn {
+		n.wsConn = nil
+		n.connectedAt = time.Time{}
+	}
+}
+
+func (n *nodeState) currentConn() *websocket.Conn {
+	n.statusMu.Lock()
+	defer n.statusMu.Unlock()
+	return n.wsConn
+}
+
+func (n *nodeState) recordHeartbeat(now time.Time) {
+	n.statusMu.Lock()
+	defer n.statusMu.Unlock()
+	n.lastHeartbeat = now
+}
+
+func (n *nodeState) status(nodeAddress string) NodeStatus {
+	n.statusMu.Lock()
+	defer n.statusMu.Unlock()
+	return NodeStatus{
+		Address:       nodeAddress,
+		Name:          n.name,
+		Connected:     n.wsConn != nil,
+		ConnectedAt:   n.connectedAt,
+		LastHeartbeat: n.lastHeartbeat,
+	}
+}
+
+// NodeStatuses returns the state of the connections of the nodes of the DON,
+// sorted by address.
+func (m *donConnectionManager) NodeStatuses() []NodeStatus {
+	m.nodesMu.RLock()
+	defer m.nodesMu.RUnlock()
+	statuses := make([]NodeStatus, 0, len(m.nodes))
+	for nodeAddress, nodeState := range m.nodes {
+		statuses = append(statuses, nodeState.status(nodeAddress))
+	}
+	slices.SortFunc(statuses, func(a, b NodeStatus) int {
+		return strings.Compare(a.Address, b.Address)
+	})
+	return statuses
+}
+
+func (m *donConnectionManager) start(ctx context.Context, heartbeatIntervalSec uint32) error {
+	m.nodesMu.Lock()
+	defer m.nodesMu.Unlock()
+	for nodeAddress, nodeState := range m.nodes {
+		if err := m.startNode(ctx, nodeAddress, nodeState); err != nil {
+			return err
+		}
+	}
+	m.started = true
+	m.closeWait.Add(1)
+	go m.keepaliveLoop(heartbeatIntervalSec)
+	return nil
+}
+
+// startNode must be called with nodesMu held.
+func (m *donConnectionManager) startNode(ctx context.Context, nodeAddress string, nodeState *nodeState) error {
+	if err := nodeState.conn.Start(ctx); err != nil {
+		return err
+	}
+	m.closeWait.Add(1)
+	go m.readLoop(nodeAddress, nodeState)
+	return nil
+}
+
+// shutdown stops all loops and connections, closeWait is done once they have exited.
+func (m *donConnectionManager) shutdown() {
+	close(m.shutdownCh)
+	m.nodesMu.RLock()
+	defer m.nodesMu.RUnlock()
+	for _, nodeState := range m.nodes {
+		nodeState.conn.Close()
+	}
+}
+
+func (m *donConnectionManager) node(nodeAddress string) *nodeState {
+	m.nodesMu.RLock()
+	defer m.nodesMu.RUnlock()
+	return m.nodes[nodeAddress]
+}
+
+// AddNodes starts accepting connections from the members which are not nodes
+// of the DON yet. A node whose name changed is disconnected and added again.
+func (m *donConnectionManager) AddNodes(ctx context.Context, members []config.NodeConfig) error {
+	m.nodesMu.Lock()
+	defer m.nodesMu.Unlock()
+	for _, nodeConfig := range members {
+		nodeAddress := strings.ToLower(nodeConfig.Address)
+		existing, ok := m.nodes[nodeAddress]
+		if ok && existing.name == nodeConfig.Name {
+			continue
+		}
+		if ok {
+			m.stopNode(nodeAddress, existing)
+		}
+		nodeState, err := m.newNodeState(nodeAddress, nodeConfig.Name)
+		if err != nil {
+			return err
+		}
+		if m.started {
+			if err := m.startNode(ctx, nodeAddress, nodeState); err != nil {
+				return err
+			}
+		}
+		m.nodes[nodeAddress] = nodeState
+		m.lggr.Infow("added node", "nodeAddress", nodeAddress, "name", nodeConfig.Name)
+	}
+	return nil
+}
+
+// RemoveNodes disconnects the nodes of the DON which are not among members.
+func (m *donConnectionManager) RemoveNodes(members []config.NodeConfig) {
+	keep := make(map[string]struct{}, len(members))
+	for _, nodeConfig := range members {
+		keep[strings.ToLower(nodeConfig.Address)] = struct{}{}
+	}
+	m.nodesMu.Lock()
+	defer m.nodesMu.Unlock()
+	for nodeAddress, nodeState := range m.nodes {
+		if _, ok := keep[nodeAddress]; !ok {
+			m.stopNode(nodeAddress, nodeState)
+			m.lggr.Infow("removed node", "nodeAddress", nodeAddress, "name", nodeState.name)
+		}
+	}
+}
+
+// stopNode must be called with nodesMu held.
+func (m *donConnectionManager) stopNode(nodeAddress string, nodeState *nodeState) {
+	delete(m.nodes, nodeAddress)
+	close(nodeState.stopCh)
+	nodeState.conn.Close()
+}
+
 func (m *donConnectionManager) SetHandler(handler handlers.Handler) {
 	m.handler = handler
 }
@@ -275,20 +611,56 @@ func (m *donConnectionManager) SendToNode(ctx context.Context, nodeAddress strin
 	if err != nil {
 		return fmt.Errorf("error encoding request for node %s: %w", nodeAddress, err)
 	}
-	nodeState := m.nodes[nodeAddress]
+	if m.replicas == nil {
+		return m.writeToNode(ctx, nodeAddress, data)
+	}
+	// Responses from the node may arrive on another replica, which forwards
+	// them to this one.
+	if err = m.replicas.recordRequest(ctx, m.donConfig.DonId, req.ID); err != nil {
+		return fmt.Errorf("error recording request %s: %w", req.ID, err)
+	}
+	err = m.writeToNode(ctx, nodeAddress, data)
+	if err == nil {
+		return nil
+	}
+	forwarded, fwdErr := m.replicas.forwardRequest(ctx, m.donConfig.DonId, nodeAddress, data)
+	if !forwarded {
+		return errors.Join(err, fwdErr)
+	}
+	return fwdErr
+}
+
+// writeToNode writes to the connection of a node with this gateway replica.
+func (m *donConnectionManager) writeToNode(ctx context.Context, nodeAddress string, data []byte) error {
+	nodeState := m.node(nodeAddress)
 	if nodeState == nil {
 		return fmt.Errorf("node %s not found", nodeAddress)
 	}
 	return nodeState.conn.Write(ctx, websocket.BinaryMessage, data)
 }
 
+// handleNodeMessage passes a message from a node to the handler of the DON.
+func (m *donConnectionManager) handleNodeMessage(ctx context.Context, resp *jsonrpc.Response[json.RawMessage], nodeAddress string) error {
+	var name string
+	if nodeState := m.node(nodeAddress); nodeState != nil {
+		name = nodeState.name
+	}
+	startTime := time.Now()
+	err := m.handler.HandleNodeMessage(ctx, resp, nodeAddress)
+	m.gMetrics.RecordNodeMsgHandlerInvocation(ctx, nodeAddress, name, err == nil)
+	m.gMetrics.RecordNodeMsgHandlerDuration(ctx, nodeAddress, name, time.Since(startTime), err == nil)
+	return err
+}
+
 func (m *donConnectionManager) readLoop(nodeAddress string, nodeState *nodeState) {
+	defer m.closeWait.Done()
 	ctx, cancel := m.shutdownCh.NewCtx()
 	defer cancel()
 	for {
 		select {
 		case <-m.shutdownCh:
-			m.closeWait.Done()
+			return
+		case <-nodeState.stopCh:
 			return
 		case item := <-nodeState.conn.ReadChannel():
 			var resp jsonrpc.Response[json.RawMessage]
@@ -297,10 +669,16 @@ func (m *donConnectionManager) readLoop(nodeAddress string, nodeState *nodeState
 				m.lggr.Errorw("parse error when reading from node", "nodeAddress", nodeAddress, "err", err)
 				break
 			}
-			startTime := time.Now()
-			err = m.handler.HandleNodeMessage(ctx, &resp, nodeAddress)
-			m.gMetrics.RecordNodeMsgHandlerInvocation(ctx, nodeAddress, nodeState.name, err == nil)
-			m.gMetrics.RecordNodeMsgHandlerDuration(ctx, nodeAddress, nodeState.name, time.Since(startTime), err == nil)
+			if m.replicas != nil {
+				forwarded, fwdErr := m.replicas.forwardResponse(ctx, m.donConfig.DonId, nodeAddress, resp.ID, item.Data)
+				if fwdErr != nil {
+					m.lggr.Errorw("error forwarding message from node to replica", "err", fwdErr, "nodeAddress", nodeAddress, "responseID", resp.ID)
+				}
+				if forwarded {
+					break
+				}
+			}
+			err = m.handleNodeMessage(ctx, &resp, nodeAddress)
 			if err != nil {
 				m.lggr.Errorw("error when calling HandleNodeMessage", "err", err, "nodeAddress", nodeAddress, "nodeState", nodeState.name, "responseID", resp.ID)
 			}
@@ -328,7 +706,10 @@ func (m *donConnectionManager) keepaliveLoop(intervalSec uint32) {
 			return
 		case <-keepaliveTicker.C:
 			errorCount := 0
-			for nodeAddress, nodeState := range m.nodes {
+			m.nodesMu.RLock()
+			nodes := maps.Clone(m.nodes)
+			m.nodesMu.RUnlock()
+			for nodeAddress, nodeState := range nodes {
 				err := nodeState.conn.Write(ctx, websocket.PingMessage, []byte{})
 				m.gMetrics.RecordKeepalivePingsSent(ctx, nodeAddress, nodeState.name, err == nil)
 				if err != nil {
@@ -336,7 +717,7 @@ func (m *donConnectionManager) keepaliveLoop(intervalSec uint32) {
 					errorCount++
 				}
 			}
-			promKeepalivesSent.WithLabelValues(m.donConfig.DonId).Set(float64(len(m.nodes) - errorCount))
+			promKeepalivesSent.WithLabelValues(m.donConfig.DonId).Set(float64(len(nodes) - errorCount))
 			m.lggr.Infow("sent keepalive pings to nodes", "donID", m.donConfig.DonId, "errCount", errorCount)
 		}
 	}
@@ -4,8 +4,13 @@ import (
 	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/require"
 
@@ -252,3 +257,156 @@ func newConnectionManager(t *testing.T, gwConfig *config.GatewayConfig, clock cl
 	require.NoError(t, err)
 	return mgr
 }
+
+func TestConnectionManager_AddRemoveNodes(t *testing.T) {
+	t.Parallel()
+
+	gwConfig, nodes := newTestConfig(t, 3)
+	clock := clockwork.NewFakeClock()
+	mgr := newConnectionManager(t, gwConfig, clock)
+	require.NoError(t, mgr.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, mgr.Close()) })
+
+	members := func(nodes ...gc.TestNode) []config.NodeConfig {
+		var nodeConfigs []config.NodeConfig
+		for _, node := range nodes {
+			nodeConfigs = append(nodeConfigs, config.NodeConfig{Name: node.Address, Address: node.Address})
+		}
+		return nodeConfigs
+	}
+	authHeaderElems := network.AuthHeaderElems{
+		Timestamp: uint32(clock.Now().Unix()),
+		DonId:     "my_don_1",
+		GatewayId: "my_gateway_no_3",
+	}
+	donMgr := mgr.DONConnectionManager("my_don_1")
+	donMgr.RemoveNodes(members(nodes[0], nodes[1]))
+	_, _, err := mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, nodes[2].PrivateKey))
+	require.ErrorIs(t, err, network.ErrAuthInvalidNode)
+
+	require.NoError(t, donMgr.AddNodes(testutils.Context(t), members(nodes[0], nodes[1], nodes[2])))
+	_, _, err = mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, nodes[2].PrivateKey))
+	require.NoError(t, err)
+
+	// a node removed during its handshake is rejected
+	attemptId, challenge, err := mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, nodes[0].PrivateKey))
+	require.NoError(t, err)
+	donMgr.RemoveNodes(members(nodes[1], nodes[2]))
+	response, err := gc.SignData(nodes[0].PrivateKey, challenge)
+	require.NoError(t, err)
+	require.ErrorIs(t, mgr.FinalizeHandshake(attemptId, response, nil), network.ErrAuthInvalidNode)
+	require.ErrorContains(t, donMgr.SendToNode(testutils.Context(t), nodes[0].Address, &jsonrpc.Request[json.RawMessage]{}), "not found")
+}
+
+func TestConnectionManager_AddRemoveDON(t *testing.T) {
+	t.Parallel()
+
+	gwConfig, nodes := newTestConfig(t, 2)
+	clock := clockwork.NewFakeClock()
+	mgr := newConnectionManager(t, gwConfig, clock)
+	require.NoError(t, mgr.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, mgr.Close()) })
+
+	authHeaderElems := network.AuthHeaderElems{
+		Timestamp: uint32(clock.Now().Unix()),
+		DonId:     "my_don_2",
+		GatewayId: "my_gateway_no_3",
+	}
+	_, _, err := mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, nodes[1].PrivateKey))
+	require.ErrorIs(t, err, network.ErrAuthInvalidDonId)
+
+	donMgr, err := mgr.NewDONConnectionManager(&config.DONConfig{
+		DonId:   "my_don_2",
+		Members: []config.NodeConfig{{Name: "node_1", Address: nodes[1].Address}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mgr.AddDON(testutils.Context(t), donMgr))
+	require.ErrorContains(t, mgr.AddDON(testutils.Context(t), donMgr), "duplicate DON ID my_don_2")
+	_, _, err = mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, nodes[1].PrivateKey))
+	require.NoError(t, err)
+
+	require.NoError(t, mgr.RemoveDON("my_don_2"))
+	require.Error(t, mgr.RemoveDON("my_don_2"))
+	_, _, err = mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, nodes[1].PrivateKey))
+	require.ErrorIs(t, err, network.ErrAuthInvalidDonId)
+	require.NotNil(t, mgr.DONConnectionManager("my_don_1"))
+}
+
+// newWebSocketPair returns the server and client ends of a WebSocket connection.
+func newWebSocketPair(t *testing.T) (server *websocket.Conn, client *websocket.Conn) {
+	serverConnCh := make(chan *websocket.Conn, 1)
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		serverConnCh <- conn
+	}))
+	t.Cleanup(httpServer.Close)
+	client, _, err := websocket.DefaultDialer.DialContext(testutils.Context(t), "ws"+strings.TrimPrefix(httpServer.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return <-serverConnCh, client
+}
+
+func TestConnectionManager_NodeStatuses(t *testing.T) {
+	t.Parallel()
+
+	gwConfig, nodes := newTestConfig(t, 2)
+	clock := clockwork.NewFakeClock()
+	mgr := newConnectionManager(t, gwConfig, clock)
+	require.NoError(t, mgr.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, mgr.Close()) })
+	donMgr := mgr.DONConnectionManager("my_don_1")
+	nodeStatus := func(nodeAddress string) gateway.NodeStatus {
+		for _, status := range donMgr.NodeStatuses() {
+			if status.Address == nodeAddress {
+				return status
+			}
+		}
+		t.Fatalf("no status of node %s", nodeAddress)
+		return gateway.NodeStatus{}
+	}
+
+	authHeaderElems := network.AuthHeaderElems{
+		Timestamp: uint32(clock.Now().Unix()),
+		DonId:     "my_don_1",
+		GatewayId: "my_gateway_no_3",
+	}
+	attemptId, challenge, err := mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, nodes[0].PrivateKey))
+	require.NoError(t, err)
+	require.Equal(t, 1, mgr.PendingHandshakes("my_don_1"))
+	require.Equal(t, 0, mgr.PendingHandshakes("my_don_2"))
+	require.ErrorIs(t, mgr.DisconnectNode("my_don_1", nodes[0].Address), gateway.ErrNodeNotConnected)
+
+	serverConn, clientConn := newWebSocketPair(t)
+	response, err := gc.SignData(nodes[0].PrivateKey, challenge)
+	require.NoError(t, err)
+	require.NoError(t, mgr.FinalizeHandshake(attemptId, response, serverConn))
+	require.Equal(t, 0, mgr.PendingHandshakes("my_don_1"))
+
+	connectedAt := clock.Now()
+	clock.Advance(time.Minute)
+	require.NoError(t, clientConn.WriteControl(websocket.PongMessage, nil, time.Now().Add(time.Second)))
+	require.Eventually(t, func() bool {
+		return !nodeStatus(nodes[0].Address).LastHeartbeat.IsZero()
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	require.Len(t, donMgr.NodeStatuses(), 2)
+	require.Equal(t, gateway.NodeStatus{
+		Address:       nodes[0].Address,
+		Name:          "node_0",
+		Connected:     true,
+		ConnectedAt:   connectedAt,
+		LastHeartbeat: clock.Now(),
+	}, nodeStatus(nodes[0].Address))
+	require.False(t, nodeStatus(nodes[1].Address).Connected)
+
+	require.ErrorContains(t, mgr.DisconnectNode("my_don_2", nodes[0].Address), "unknown DON my_don_2")
+	require.ErrorIs(t, mgr.DisconnectNode("my_don_1", "0x0"), gateway.ErrNodeNotFound)
+	require.NoError(t, mgr.DisconnectNode("my_don_1", nodes[0].Address))
+	require.False(t, nodeStatus(nodes[0].Address).Connected)
+	// the node notices its connection was closed
+	_, _, err = clientConn.ReadMessage()
+	require.Error(t, err)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/state"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	workflowsyncerv2 "github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncer/v2"
@@ -28,11 +29,16 @@ type Delegate struct {
 	capabilitiesRegistry   core.CapabilitiesRegistry
 	workflowRegistrySyncer workflowsyncerv2.WorkflowRegistrySyncer
 	lf                     limits.Factory
+	// nil if the running gateways are not tracked
+	registry *Registry
 }
 
 var _ job.Delegate = (*Delegate)(nil)
+var _ job.SpecUpdater = (*Delegate)(nil)
 
-func NewDelegate(legacyChains legacyevm.LegacyChainContainer, ks keystore.Eth, ds sqlutil.DataSource, capabilitiesRegistry core.CapabilitiesRegistry, workflowRegistrySyncer workflowsyncerv2.WorkflowRegistrySyncer, lggr logger.Logger, lf limits.Factory) *Delegate {
+// NewDelegate creates the delegate of gateway jobs, whose gateways are added to
+// registry while they run, if it is not nil.
+func NewDelegate(legacyChains legacyevm.LegacyChainContainer, ks keystore.Eth, ds sqlutil.DataSource, capabilitiesRegistry core.CapabilitiesRegistry, workflowRegistrySyncer workflowsyncerv2.WorkflowRegistrySyncer, registry *Registry, lggr logger.Logger, lf limits.Factory) *Delegate {
 	return &Delegate{
 		legacyChains:           legacyChains,
 		ks:                     ks,
@@ -41,6 +47,7 @@ func NewDelegate(legacyChains legacyevm.LegacyChainContainer, ks keystore.Eth, d
 		lggr:                   lggr,
 		workflowRegistrySyncer: workflowRegistrySyncer,
 		lf:                     lf,
+		registry:               registry,
 	}
 }
 
@@ -59,22 +66,63 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 		return nil, errors.Errorf("services.Delegate expects a *jobSpec.GatewaySpec to be present, got %v", spec)
 	}
 
-	var gatewayConfig config.GatewayConfig
-	err2 := json.Unmarshal(spec.GatewaySpec.GatewayConfig.Bytes(), &gatewayConfig)
-	if err2 != nil {
-		return nil, errors.Wrap(err2, "unmarshal gateway config")
+	gatewayConfig, err := unmarshalGatewayConfig(spec)
+	if err != nil {
+		return nil, err
 	}
 	httpClient, err := network.NewHTTPClient(gatewayConfig.HTTPClientConfig, d.lggr)
 	if err != nil {
 		return nil, err
 	}
-	handlerFactory := NewHandlerFactory(d.legacyChains, d.ds, httpClient, d.capabilitiesRegistry, d.workflowRegistrySyncer, d.lggr, d.lf)
-	gateway, err := NewGatewayFromConfig(&gatewayConfig, handlerFactory, d.lggr, d.lf)
+	var store state.Store
+	if replicaConfig := gatewayConfig.ReplicaConfig; replicaConfig.ReplicaId != "" {
+		// replicas of a job run on different nodes, which do not share memory
+		if replicaConfig.StateBackend == state.BackendMemory {
+			return nil, errors.Errorf("the %s state backend is not shared by the replicas of a gateway job", state.BackendMemory)
+		}
+		store, err = state.NewStore(replicaConfig.StateBackend, d.ds, replicaConfig.StateURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+	handlerFactory := NewHandlerFactory(d.legacyChains, d.ds, httpClient, store, d.capabilitiesRegistry, d.workflowRegistrySyncer, d.lggr, d.lf)
+	gateway, err := NewGatewayFromConfig(gatewayConfig, handlerFactory, store, d.lggr, d.lf)
 	if err != nil {
 		return nil, err
 	}
 
-	return []job.ServiceCtx{gateway}, nil
+	if d.registry == nil {
+		return []job.ServiceCtx{gateway}, nil
+	}
+	return []job.ServiceCtx{gateway, &registration{registry: d.registry, jobID: spec.ID, gateway: gateway}}, nil
+}
+
+// UpdateServices applies the DONs of an updated gateway spec to the running
+// gateway, so that nodes and handler configs change without dropping the
+// connections of users and nodes.
+func (d *Delegate) UpdateServices(ctx context.Context, spec job.Job, services []job.ServiceCtx) error {
+	if spec.GatewaySpec == nil {
+		return errors.Errorf("services.Delegate expects a *jobSpec.GatewaySpec to be present, got %v", spec)
+	}
+	gatewayConfig, err := unmarshalGatewayConfig(spec)
+	if err != nil {
+		return err
+	}
+	for _, service := range services {
+		if gateway, ok := service.(Gateway); ok {
+			return gateway.UpdateConfig(ctx, gatewayConfig)
+		}
+	}
+	return errors.New("no running gateway")
+}
+
+func unmarshalGatewayConfig(spec job.Job) (*config.GatewayConfig, error) {
+	var gatewayConfig config.GatewayConfig
+	err := json.Unmarshal(spec.GatewaySpec.GatewayConfig.Bytes(), &gatewayConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal gateway config")
+	}
+	return &gatewayConfig, nil
 }
 
 func ValidatedGatewaySpec(tomlString string) (job.Job, error) {
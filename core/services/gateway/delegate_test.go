@@ -1,10 +1,16 @@
 package gateway_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/settings/limits"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
 )
 
@@ -54,3 +60,74 @@ schemaVersion = 1
 		})
 	}
 }
+
+func TestDelegate_ServicesForSpec_Registry(t *testing.T) {
+	t.Parallel()
+
+	jb, err := gateway.ValidatedGatewaySpec(`
+type = "gateway"
+schemaVersion = 1
+name = "gateway"
+[gatewayConfig.NodeServerConfig]
+Path = "/node"
+[gatewayConfig.UserServerConfig]
+Path = "/user"
+[[gatewayConfig.Dons]]
+DonId = "my_don"
+HandlerName = "dummy"
+`)
+	require.NoError(t, err)
+	jb.ID = 7
+	lggr := logger.Test(t)
+	registry := gateway.NewRegistry()
+	delegate := gateway.NewDelegate(nil, nil, nil, nil, nil, registry, lggr, limits.Factory{Logger: lggr})
+
+	services, err := delegate.ServicesForSpec(testutils.Context(t), jb)
+	require.NoError(t, err)
+	require.Len(t, services, 2)
+	for _, service := range services {
+		servicetest.Run(t, service)
+	}
+	gw, ok := registry.Get(7)
+	require.True(t, ok)
+	require.Equal(t, services[0], gw)
+	require.Equal(t, []int32{7}, registry.JobIDs())
+
+	require.NoError(t, services[1].Close())
+	_, ok = registry.Get(7)
+	require.False(t, ok)
+}
+
+func TestDelegate_ServicesForSpec_ReplicaStateBackend(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.Test(t)
+	delegate := gateway.NewDelegate(nil, nil, nil, nil, nil, nil, lggr, limits.Factory{Logger: lggr})
+	for _, tc := range []struct {
+		name         string
+		stateBackend string
+		expectedErr  string
+	}{
+		{"defaults to postgres", "", "the postgres state backend requires a database"},
+		{"memory", "memory", "the memory state backend is not shared by the replicas of a gateway job"},
+		{"redis without URL", "redis", "the redis state backend requires a URL"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			jb, err := gateway.ValidatedGatewaySpec(fmt.Sprintf(`
+type = "gateway"
+schemaVersion = 1
+name = "gateway"
+[gatewayConfig.ReplicaConfig]
+ReplicaId = "a"
+StateBackend = "%s"
+[[gatewayConfig.Dons]]
+DonId = "my_don"
+HandlerName = "dummy"
+`, tc.stateBackend))
+			require.NoError(t, err)
+
+			_, err = delegate.ServicesForSpec(testutils.Context(t), jb)
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -25,6 +28,7 @@ import (
 	handlerscommon "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/monitoring"
 	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/state"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
@@ -33,10 +37,26 @@ var promRequest = promauto.NewCounterVec(prometheus.CounterOpts{
 	Help: "Metric to track received requests and response codes",
 }, []string{"response_code"})
 
+// maxPendingStreamEvents bounds the events of a streamed request which are
+// not sent to the user yet.
+const maxPendingStreamEvents = 100
+
 type Gateway interface {
 	job.ServiceCtx
-	gw_net.HTTPRequestHandler
+	gw_net.StreamingHTTPRequestHandler
 
+	// UpdateConfig applies the DONs of cfg to the running gateway without
+	// dropping node connections or pending user requests. Changes which can
+	// not be applied at runtime are rejected with an error wrapping
+	// handlers.ErrRestartRequired. If an error is returned, part of cfg may
+	// have been applied already, so the gateway should be restarted.
+	UpdateConfig(ctx context.Context, cfg *config.GatewayConfig) error
+	// Status returns the state of the DON connections and handlers of this
+	// gateway replica.
+	Status() Status
+	// DisconnectNode closes the connection of a node of a DON to this gateway
+	// replica, e.g. when it misbehaves. The node is free to reconnect.
+	DisconnectNode(donID string, nodeAddress string) error
 	GetUserPort() int
 	GetNodePort() int
 }
@@ -54,12 +74,30 @@ type gateway struct {
 	httpServer         gw_net.HTTPServer
 	handlers           map[string]handlers.Handler
 	serviceNameToDonID map[string]string
+	handlersMu         sync.RWMutex
 	connMgr            ConnectionManager
 	gMetrics           *monitoring.GatewayMetrics
 	lggr               logger.Logger
+
+	// Only set if users are authenticated by the gateway.
+	userAuth   *userAuthenticator
+	userQuotas *userQuotas
+
+	// Only set for gateways created from a config, which can be updated. It
+	// is replaced with handlersMu held.
+	config         *config.GatewayConfig
+	handlerFactory HandlerFactory
+	updateMu       sync.Mutex
 }
 
-func NewGatewayFromConfig(cfg *config.GatewayConfig, handlerFactory HandlerFactory, lggr logger.Logger, lf limits.Factory) (Gateway, error) {
+// NewGatewayFromConfig creates a gateway. If replication is enabled, replicas
+// share their state through store, which is created from the config if nil.
+func NewGatewayFromConfig(cfg *config.GatewayConfig, handlerFactory HandlerFactory, store state.Store, lggr logger.Logger, lf limits.Factory) (Gateway, error) {
+	// The servers apply defaults to their config, keep it as given so that
+	// updates can be compared with it. Neither is the config of the caller.
+	original := *cfg
+	applied := *cfg
+	cfg = &applied
 	codec := &api.JsonRPCCodec{}
 	gMetrics, err := monitoring.NewGatewayMetrics()
 	if err != nil {
@@ -69,64 +107,117 @@ func NewGatewayFromConfig(cfg *config.GatewayConfig, handlerFactory HandlerFacto
 	if err != nil {
 		return nil, err
 	}
-	connMgr, err := NewConnectionManager(cfg, clockwork.NewRealClock(), gMetrics, lggr, lf)
+	dons, serviceNameToDonID, err := validateDONs(cfg.Dons)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Dons, original.Dons = dons, dons
+	var userAuth *userAuthenticator
+	if userAuthEnabled(&cfg.UserAuthConfig) {
+		if userAuth, err = newUserAuthenticator(&cfg.UserAuthConfig, lggr); err != nil {
+			return nil, fmt.Errorf("invalid user auth config: %w", err)
+		}
+	}
+	if cfg.ReplicaConfig.ReplicaId != "" && quotasEnabled(&cfg.UserAuthConfig) {
+		return nil, errors.New("invalid user auth config: quotas are not shared by the replicas of a gateway")
+	}
+	connMgr, err := newConnectionManager(cfg, store, clockwork.NewRealClock(), gMetrics, lggr, lf)
 	if err != nil {
 		return nil, err
 	}
 
 	handlerMap := make(map[string]handlers.Handler)
-	serviceNameToDonID := make(map[string]string)
-
-	for _, donConfig := range cfg.Dons {
-		_, ok := handlerMap[donConfig.DonId]
-		if ok {
-			return nil, fmt.Errorf("duplicate DON ID %s", donConfig.DonId)
-		}
+	for i := range cfg.Dons {
+		donConfig := &cfg.Dons[i]
 		donConnMgr := connMgr.DONConnectionManager(donConfig.DonId)
 		if donConnMgr == nil {
 			return nil, fmt.Errorf("connection manager ID %s not found", donConfig.DonId)
 		}
-		for idx, nodeConfig := range donConfig.Members {
-			donConfig.Members[idx].Address = strings.ToLower(nodeConfig.Address)
-			if !common.IsHexAddress(nodeConfig.Address) {
-				return nil, fmt.Errorf("invalid node address %s", nodeConfig.Address)
-			}
-		}
-
-		// Convert old-style handler config to the new style.
-		var handlers []config.Handler
-		if donConfig.HandlerName != "" {
-			handlers = append(handlers, config.Handler{
-				Name:   donConfig.HandlerName,
-				Config: donConfig.HandlerConfig,
-			})
-		}
-
-		handlers = append(handlers, donConfig.Handlers...)
-		handler, err := NewMultiHandler(handlerFactory, handlers, &donConfig, donConnMgr)
+		handler, err := NewMultiHandler(handlerFactory, donHandlers(donConfig), donConfig, donConnMgr)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create multi-handler for DON %s: %w", donConfig.DonId, err)
 		}
 
 		handlerMap[donConfig.DonId] = handler
+		donConnMgr.SetHandler(handler)
+	}
+	gw := newGateway(codec, httpServer, handlerMap, serviceNameToDonID, connMgr, gMetrics, lggr)
+	gw.config = &original
+	gw.handlerFactory = handlerFactory
+	if userAuth != nil {
+		gw.userAuth = userAuth
+		gw.userQuotas = newUserQuotas(&cfg.UserAuthConfig, clockwork.NewRealClock())
+	}
+	return gw, nil
+}
 
-		for _, h := range handlers {
+// validateDONs checks the DONs of a gateway config. It returns a copy of them
+// with normalized node addresses, and the DON ID of each service name.
+func validateDONs(dons []config.DONConfig) ([]config.DONConfig, map[string]string, error) {
+	dons = slices.Clone(dons)
+	donIDs := make(map[string]struct{})
+	serviceNameToDonID := make(map[string]string)
+	for i := range dons {
+		donConfig := &dons[i]
+		_, ok := donIDs[donConfig.DonId]
+		if ok {
+			return nil, nil, fmt.Errorf("duplicate DON ID %s", donConfig.DonId)
+		}
+		donIDs[donConfig.DonId] = struct{}{}
+		donConfig.Members = slices.Clone(donConfig.Members)
+		for idx, nodeConfig := range donConfig.Members {
+			donConfig.Members[idx].Address = strings.ToLower(nodeConfig.Address)
+			if !common.IsHexAddress(nodeConfig.Address) {
+				return nil, nil, fmt.Errorf("invalid node address %s", nodeConfig.Address)
+			}
+		}
+		for _, h := range donHandlers(donConfig) {
 			if h.ServiceName != "" {
 				_, ok := serviceNameToDonID[h.ServiceName]
 				if ok {
-					return nil, fmt.Errorf("duplicate service name %s for DON ID %s", h.ServiceName, donConfig.DonId)
+					return nil, nil, fmt.Errorf("duplicate service name %s for DON ID %s", h.ServiceName, donConfig.DonId)
 				}
 
 				serviceNameToDonID[h.ServiceName] = donConfig.DonId
 			}
 		}
+	}
+	return dons, serviceNameToDonID, nil
+}
 
-		donConnMgr.SetHandler(handler)
+// donServiceNames returns the DON ID of each service name of valid DONs. A
+// name claimed by more than one DON, as after a partly applied update which
+// moved it, keeps the first.
+func donServiceNames(dons []config.DONConfig) map[string]string {
+	serviceNameToDonID := make(map[string]string)
+	for i := range dons {
+		for _, h := range donHandlers(&dons[i]) {
+			if _, ok := serviceNameToDonID[h.ServiceName]; h.ServiceName != "" && !ok {
+				serviceNameToDonID[h.ServiceName] = dons[i].DonId
+			}
+		}
 	}
-	return NewGateway(codec, httpServer, handlerMap, serviceNameToDonID, connMgr, gMetrics, lggr), nil
+	return serviceNameToDonID
+}
+
+func donHandlers(donConfig *config.DONConfig) []config.Handler {
+	// Convert old-style handler config to the new style.
+	var handlers []config.Handler
+	if donConfig.HandlerName != "" {
+		handlers = append(handlers, config.Handler{
+			Name:   donConfig.HandlerName,
+			Config: donConfig.HandlerConfig,
+		})
+	}
+
+	return append(handlers, donConfig.Handlers...)
 }
 
 func NewGateway(codec api.Codec, httpServer gw_net.HTTPServer, handlers map[string]handlers.Handler, serviceNameToDonID map[string]string, connMgr ConnectionManager, gMetrics *monitoring.GatewayMetrics, lggr logger.Logger) Gateway {
+	return newGateway(codec, httpServer, handlers, serviceNameToDonID, connMgr, gMetrics, lggr)
+}
+
+func newGateway(codec api.Codec, httpServer gw_net.HTTPServer, handlers map[string]handlers.Handler, serviceNameToDonID map[string]string, connMgr ConnectionManager, gMetrics *monitoring.GatewayMetrics, lggr logger.Logger) *gateway {
 	gw := &gateway{
 		codec:              codec,
 		httpServer:         httpServer,
@@ -143,6 +234,8 @@ func NewGateway(codec api.Codec, httpServer gw_net.HTTPServer, handlers map[stri
 func (g *gateway) Start(ctx context.Context) error {
 	return g.StartOnce("Gateway", func() error {
 		g.lggr.Info("starting gateway")
+		g.handlersMu.RLock()
+		defer g.handlersMu.RUnlock()
 		for _, handler := range g.handlers {
 			if err := handler.Start(ctx); err != nil {
 				return err
@@ -151,6 +244,11 @@ func (g *gateway) Start(ctx context.Context) error {
 		if err := g.connMgr.Start(ctx); err != nil {
 			return err
 		}
+		if g.userAuth != nil {
+			if err := g.userAuth.Start(ctx); err != nil {
+				return err
+			}
+		}
 		return g.httpServer.Start(ctx)
 	})
 }
@@ -159,7 +257,12 @@ func (g *gateway) Close() error {
 	return g.StopOnce("Gateway", func() (err error) {
 		g.lggr.Info("closing gateway")
 		err = errors.Join(err, g.httpServer.Close())
+		if g.userAuth != nil {
+			err = errors.Join(err, g.userAuth.Close())
+		}
 		err = errors.Join(err, g.connMgr.Close())
+		g.handlersMu.RLock()
+		defer g.handlersMu.RUnlock()
 		for _, handler := range g.handlers {
 			err = errors.Join(err, handler.Close())
 		}
@@ -167,13 +270,197 @@ func (g *gateway) Close() error {
 	})
 }
 
+func (g *gateway) UpdateConfig(ctx context.Context, cfg *config.GatewayConfig) (err error) {
+	// Holding the state lock keeps Close from running during the update.
+	if !g.IfStarted(func() { err = g.updateConfig(ctx, cfg) }) {
+		return fmt.Errorf("can not update gateway config: %w", g.Ready())
+	}
+	return err
+}
+
+// updateConfig applies the DONs of cfg. If that fails part way, the config of
+// the gateway is set to the DONs as they are running, so that the next update
+// is compared with them.
+func (g *gateway) updateConfig(ctx context.Context, cfg *config.GatewayConfig) (err error) {
+	g.updateMu.Lock()
+	defer g.updateMu.Unlock()
+
+	if g.config == nil {
+		return errors.New("gateway was not created from a config")
+	}
+	if !reflect.DeepEqual(g.config.UserServerConfig, cfg.UserServerConfig) ||
+		!reflect.DeepEqual(g.config.NodeServerConfig, cfg.NodeServerConfig) ||
+		!reflect.DeepEqual(g.config.ConnectionManagerConfig, cfg.ConnectionManagerConfig) ||
+		!reflect.DeepEqual(g.config.HTTPClientConfig, cfg.HTTPClientConfig) ||
+		!reflect.DeepEqual(g.config.ReplicaConfig, cfg.ReplicaConfig) ||
+		!reflect.DeepEqual(g.config.UserAuthConfig, cfg.UserAuthConfig) {
+		return fmt.Errorf("only DONs can be updated at runtime: %w", handlers.ErrRestartRequired)
+	}
+	dons, serviceNameToDonID, err := validateDONs(cfg.Dons)
+	if err != nil {
+		return err
+	}
+
+	// The DONs not yet updated or removed, and those which are running with
+	// their new config.
+	pending := make(map[string]*config.DONConfig, len(g.config.Dons))
+	for i := range g.config.Dons {
+		pending[g.config.Dons[i].DonId] = &g.config.Dons[i]
+	}
+	var applied []config.DONConfig
+	defer func() {
+		if err == nil {
+			return
+		}
+		for i := range g.config.Dons {
+			if old, ok := pending[g.config.Dons[i].DonId]; ok {
+				applied = append(applied, *old)
+			}
+		}
+		g.setDONs(applied, donServiceNames(applied))
+		g.lggr.Errorw("partly applied gateway config", "dons", len(applied), "err", err)
+	}()
+	for i := range dons {
+		donConfig := &dons[i]
+		old, ok := pending[donConfig.DonId]
+		if !ok {
+			err = g.addDON(ctx, donConfig)
+		} else if !reflect.DeepEqual(old, donConfig) {
+			err = g.updateDON(ctx, donConfig)
+		}
+		if err != nil {
+			// A DON which failed to update keeps running with its old config,
+			// which stays pending.
+			return fmt.Errorf("failed to apply config of DON %s: %w", donConfig.DonId, err)
+		}
+		delete(pending, donConfig.DonId)
+		applied = append(applied, *donConfig)
+	}
+	for donID := range pending {
+		// The handler of the DON is removed even if this fails
+		delete(pending, donID)
+		if err = g.removeDON(donID); err != nil {
+			return fmt.Errorf("failed to remove DON %s: %w", donID, err)
+		}
+	}
+
+	g.setDONs(dons, serviceNameToDonID)
+	g.lggr.Infow("updated gateway config", "dons", len(dons))
+	return nil
+}
+
+// setDONs records the DONs of the gateway config, and routes user requests by
+// service name to them. It must be called with updateMu held.
+func (g *gateway) setDONs(dons []config.DONConfig, serviceNameToDonID map[string]string) {
+	updated := *g.config
+	updated.Dons = dons
+	g.handlersMu.Lock()
+	g.serviceNameToDonID = serviceNameToDonID
+	g.config = &updated
+	g.handlersMu.Unlock()
+}
+
+func (g *gateway) addDON(ctx context.Context, donConfig *config.DONConfig) error {
+	donConnMgr, err := g.connMgr.NewDONConnectionManager(donConfig)
+	if err != nil {
+		return err
+	}
+	handler, err := NewMultiHandler(g.handlerFactory, donHandlers(donConfig), donConfig, donConnMgr)
+	if err != nil {
+		return fmt.Errorf("failed to create multi-handler: %w", err)
+	}
+	donConnMgr.SetHandler(handler)
+	if err = handler.Start(ctx); err != nil {
+		return err
+	}
+	if err = g.connMgr.AddDON(ctx, donConnMgr); err != nil {
+		return errors.Join(err, handler.Close())
+	}
+	g.handlersMu.Lock()
+	g.handlers[donConfig.DonId] = handler
+	g.handlersMu.Unlock()
+	return nil
+}
+
+// updateDON adds new nodes before the handler learns about them, and removes
+// old ones after it has stopped sending to them.
+func (g *gateway) updateDON(ctx context.Context, donConfig *config.DONConfig) error {
+	donConnMgr := g.connMgr.DONConnectionManager(donConfig.DonId)
+	g.handlersMu.RLock()
+	handler, ok := g.handlers[donConfig.DonId].(*multiHandler)
+	g.handlersMu.RUnlock()
+	if donConnMgr == nil || !ok {
+		return fmt.Errorf("DON %s can not be updated: %w", donConfig.DonId, handlers.ErrRestartRequired)
+	}
+	if err := donConnMgr.AddNodes(ctx, donConfig.Members); err != nil {
+		return err
+	}
+	if err := handler.updateHandlers(ctx, donHandlers(donConfig), donConfig); err != nil {
+		return err
+	}
+	donConnMgr.RemoveNodes(donConfig.Members)
+	return nil
+}
+
+func (g *gateway) removeDON(donID string) error {
+	g.handlersMu.Lock()
+	handler := g.handlers[donID]
+	delete(g.handlers, donID)
+	g.handlersMu.Unlock()
+	err := g.connMgr.RemoveDON(donID)
+	if handler != nil {
+		err = errors.Join(err, handler.Close())
+	}
+	return err
+}
+
 // Called by the server
 func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte, auth string) (rawResponse []byte, httpStatusCode int) {
+	callback := handlerscommon.NewCallback()
+	return g.processRequest(ctx, rawRequest, auth, callback, callback.Wait, nil)
+}
+
+// Called by the server for users who stream their request. Events sent by the
+// handler are passed on to stream, and count against the bytes quota of the
+// user like the response.
+func (g *gateway) ProcessStreamingRequest(ctx context.Context, rawRequest []byte, auth string, stream gw_net.EventStream) (rawResponse []byte, httpStatusCode int) {
+	callback := handlerscommon.NewStreamingCallback(maxPendingStreamEvents)
+	var streamedBytes int
+	wait := func(ctx context.Context) (handlers.UserCallbackPayload, error) {
+		return callback.Wait(ctx, func(event handlers.UserCallbackEvent) {
+			if err := stream.SendEvent(event.Type, event.Data); err != nil {
+				g.lggr.Debugw("failed to send event to user", "eventType", event.Type, "err", err)
+				return
+			}
+			streamedBytes += len(event.Data)
+		})
+	}
+	// wait passes on every event before it returns, so the count is final once read
+	return g.processRequest(ctx, rawRequest, auth, callback, wait, func() int { return streamedBytes })
+}
+
+// processRequest passes a user request to its handler, with callback, and
+// waits for the response. streamedBytes returns the size of the events sent
+// to the user before the response, if they stream the request.
+func (g *gateway) processRequest(ctx context.Context, rawRequest []byte, auth string, callback handlers.Callback, wait func(context.Context) (handlers.UserCallbackPayload, error), streamedBytes func() int) (rawResponse []byte, httpStatusCode int) {
 	// decode
 	jsonRequest, err := jsonrpc2.DecodeRequest[json.RawMessage](rawRequest, auth)
 	if err != nil {
 		return newError("", api.UserMessageParseError, err.Error())
 	}
+	if g.userAuth != nil {
+		release, errCode, err := g.admitUser(ctx, len(rawRequest))
+		if err != nil {
+			return newError(jsonRequest.ID, errCode, err.Error())
+		}
+		defer func() {
+			responseBytes := len(rawResponse)
+			if streamedBytes != nil {
+				responseBytes += streamedBytes()
+			}
+			release(responseBytes)
+		}()
+	}
 	msg, err := g.codec.DecodeJSONRequest(jsonRequest)
 	if err != nil {
 		return newError(jsonRequest.ID, api.UserMessageParseError, err.Error())
@@ -183,7 +470,9 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte, auth st
 	if msg == nil || msg.Body.DonId == "" {
 		// if no DON ID is specified, it is a new JsonRPC request. Use the service name as handler key
 		// Let's map the service name to the DON ID and find the handler
+		g.handlersMu.RLock()
 		hk, ok := g.serviceNameToDonID[jsonRequest.ServiceName()]
+		g.handlersMu.RUnlock()
 		if !ok {
 			return newError(jsonRequest.ID, api.HandlerError, "Service name not found: "+jsonRequest.ServiceName())
 		}
@@ -196,14 +485,15 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte, auth st
 		}
 		handlerKey = msg.Body.DonId
 	}
+	g.handlersMu.RLock()
 	h, ok := g.handlers[handlerKey]
+	g.handlersMu.RUnlock()
 	if !ok {
 		return newError(jsonRequest.ID, api.UnsupportedDONIdError, "Unsupported DON ID or Handler: "+handlerKey)
 	}
 	// send to the right handler
 	startTime := time.Now()
 	var method string
-	callback := handlerscommon.NewCallback()
 	if isLegacyRequest {
 		method = msg.Body.Method
 		err = h.HandleLegacyUserMessage(ctx, msg, callback)
@@ -215,7 +505,7 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte, auth st
 		return newError(jsonRequest.ID, api.HandlerError, err.Error())
 	}
 	// await response
-	response, err := callback.Wait(ctx)
+	response, err := wait(ctx)
 	duration := time.Since(startTime)
 	if err != nil {
 		response := api.RequestTimeoutError
@@ -231,6 +521,35 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte, auth st
 	return response.RawResponse, api.ToHttpErrorCode(response.ErrorCode)
 }
 
+// admitUser authenticates the user of a request, and admits it if it is within
+// the quota of the user. Once the request is processed, release must be called
+// with the size of the response.
+func (g *gateway) admitUser(ctx context.Context, requestBytes int) (release func(responseBytes int), errCode api.ErrorCode, err error) {
+	identity, err := g.userAuth.authenticate(gw_net.UserAuthFromContext(ctx))
+	if err != nil {
+		g.gMetrics.RecordUserRequest(ctx, "", monitoring.UserRequestUnauthenticated)
+		return nil, api.UnauthorizedError, err
+	}
+	releaseQuota, err := g.userQuotas.acquire(identity, requestBytes)
+	if err != nil {
+		result := monitoring.UserRequestRateLimited
+		var qErr *quotaError
+		if errors.As(err, &qErr) {
+			result = qErr.result
+		}
+		g.gMetrics.RecordUserRequest(ctx, identity, result)
+		return nil, api.LimitExceededError, err
+	}
+	g.gMetrics.RecordUserRequest(ctx, identity, monitoring.UserRequestAdmitted)
+	g.gMetrics.RecordUserBytes(ctx, identity, "in", requestBytes)
+	g.gMetrics.RecordUserConcurrentRequests(ctx, identity, 1)
+	return func(responseBytes int) {
+		releaseQuota(responseBytes)
+		g.gMetrics.RecordUserBytes(ctx, identity, "out", responseBytes)
+		g.gMetrics.RecordUserConcurrentRequests(ctx, identity, -1)
+	}, api.NoError, nil
+}
+
 func newError(id string, errCode api.ErrorCode, errMsg string) ([]byte, int) {
 	response := jsonrpc2.Response[json.RawMessage]{
 		Version: jsonrpc2.JsonRpcVersion,
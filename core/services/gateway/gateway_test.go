@@ -2,9 +2,11 @@ package gateway_test
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	handlermocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/monitoring"
+	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
 	netmocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network/mocks"
 )
 
@@ -54,7 +57,7 @@ func (h *handlerFactory) NewHandler(handlerType gateway.HandlerType, _ json.RawM
 
 func newGatewayHandler(t *testing.T) gateway.HandlerFactory {
 	lggr := logger.Test(t)
-	return gateway.NewHandlerFactory(nil, nil, nil, nil, nil, lggr, limits.Factory{Logger: lggr})
+	return gateway.NewHandlerFactory(nil, nil, nil, nil, nil, nil, lggr, limits.Factory{Logger: lggr})
 }
 
 func TestGateway_NewGatewayFromConfig_ValidConfig(t *testing.T) {
@@ -75,7 +78,7 @@ Address = "0x0001020304050607080900010203040506070809"
 `)
 
 	lggr := logger.Test(t)
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), newGatewayHandler(t), lggr, limits.Factory{Logger: lggr})
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), newGatewayHandler(t), nil, lggr, limits.Factory{Logger: lggr})
 	require.NoError(t, err)
 }
 
@@ -93,7 +96,7 @@ HandlerName = "dummy"
 `)
 
 	lggr := logger.Test(t)
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), newGatewayHandler(t), lggr, limits.Factory{Logger: lggr})
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), newGatewayHandler(t), nil, lggr, limits.Factory{Logger: lggr})
 	require.Error(t, err)
 }
 
@@ -107,7 +110,7 @@ HandlerName = "no_such_handler"
 `)
 
 	lggr := logger.Test(t)
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), newGatewayHandler(t), lggr, limits.Factory{Logger: lggr})
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), newGatewayHandler(t), nil, lggr, limits.Factory{Logger: lggr})
 	require.Error(t, err)
 }
 
@@ -121,7 +124,7 @@ SomeOtherField = "abcd"
 `)
 
 	lggr := logger.Test(t)
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), newGatewayHandler(t), lggr, limits.Factory{Logger: lggr})
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), newGatewayHandler(t), nil, lggr, limits.Factory{Logger: lggr})
 	require.Error(t, err)
 }
 
@@ -139,15 +142,66 @@ Address = "0xnot_an_address"
 `)
 
 	lggr := logger.Test(t)
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), newGatewayHandler(t), lggr, limits.Factory{Logger: lggr})
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), newGatewayHandler(t), nil, lggr, limits.Factory{Logger: lggr})
 	require.Error(t, err)
 }
 
+func TestGateway_NewGatewayFromConfig_InvalidReplicaConfig(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.Test(t)
+	for _, tc := range []struct {
+		name          string
+		replicaConfig string
+		expectedErr   string
+	}{
+		{"missing advertise URL", `ReplicaId = "a"`, "replica advertise URL is required"},
+		{"short secret", `ReplicaId = "a"
+AdvertiseURL = "http://localhost/forward"
+ForwardingSecret = "secret"`, "replica forwarding secret must be at least 32 characters"},
+		{"unsupported backend", `ReplicaId = "a"
+AdvertiseURL = "http://localhost/forward"
+ForwardingSecret = "0123456789abcdef0123456789abcdef"
+StateBackend = "etcd"`, `unsupported state backend "etcd"`},
+		{"no database", `ReplicaId = "a"
+AdvertiseURL = "http://localhost/forward"
+ForwardingSecret = "0123456789abcdef0123456789abcdef"`, "the postgres state backend requires a database"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tomlConfig := buildConfig("[replicaConfig]\n" + tc.replicaConfig)
+			_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), newGatewayHandler(t), nil, lggr, limits.Factory{Logger: lggr})
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestGateway_NewGatewayFromConfig_ReplicatedQuotas(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.Test(t)
+	tomlConfig := buildConfig(fmt.Sprintf(`
+[userAuthConfig]
+[[userAuthConfig.APIKeys]]
+Identity = "alice"
+KeyHash = "%x"
+
+[userAuthConfig.Quotas.alice]
+MaxConcurrentRequests = 1
+
+[replicaConfig]
+ReplicaId = "a"
+AdvertiseURL = "http://localhost/forward"
+ForwardingSecret = "0123456789abcdef0123456789abcdef"
+`, sha256.Sum256([]byte("alice-key"))))
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), newGatewayHandler(t), nil, lggr, limits.Factory{Logger: lggr})
+	require.EqualError(t, err, "invalid user auth config: quotas are not shared by the replicas of a gateway")
+}
+
 func TestGateway_CleanStartAndClose(t *testing.T) {
 	t.Parallel()
 
 	lggr := logger.Test(t)
-	gatewayObj, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, buildConfig("")), newGatewayHandler(t), lggr, limits.Factory{Logger: lggr})
+	gatewayObj, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, buildConfig("")), newGatewayHandler(t), nil, lggr, limits.Factory{Logger: lggr})
 	require.NoError(t, err)
 	servicetest.Run(t, gatewayObj)
 }
@@ -303,6 +357,43 @@ func TestGateway_NewRequest_HandlerResponse(t *testing.T) {
 	require.Equal(t, 200, statusCode)
 }
 
+type eventRecorder struct {
+	events []string
+}
+
+func (r *eventRecorder) SendEvent(eventType string, data []byte) error {
+	r.events = append(r.events, eventType+" "+string(data))
+	return nil
+}
+
+func TestGateway_ProcessStreamingRequest(t *testing.T) {
+	t.Parallel()
+
+	gw, handler := newGatewayWithMockHandler(t)
+	handler.On("HandleJSONRPCUserMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		request := args.Get(1).(jsonrpc.Request[json.RawMessage])
+		callback := args.Get(2).(handlers.Callback)
+		require.NoError(t, handlers.SendEvent(callback, "progress", map[string]int{"step": 1}))
+		require.NoError(t, handlers.SendEvent(callback, "progress", map[string]int{"step": 2}))
+		rawResult := json.RawMessage(`{"result":"OK"}`)
+		rawMsg, err := json.Marshal(&jsonrpc.Response[json.RawMessage]{
+			Version: jsonrpc.JsonRpcVersion,
+			ID:      request.ID,
+			Result:  &rawResult,
+			Method:  request.Method,
+		})
+		require.NoError(t, err)
+		require.NoError(t, callback.SendResponse(handlers.UserCallbackPayload{RawResponse: rawMsg, ErrorCode: api.NoError}))
+	})
+
+	stream := &eventRecorder{}
+	req := newJSONRpcRequest(t, "abcd", "testDON", []byte(`{"type":"new"}`))
+	response, statusCode := gw.ProcessStreamingRequest(testutils.Context(t), req, "", stream)
+	requireJSONRPCResult(t, "testDON", response, "abcd", `{"result":"OK"}`)
+	require.Equal(t, 200, statusCode)
+	require.Equal(t, []string{`progress {"step":1}`, `progress {"step":2}`}, stream.events)
+}
+
 func TestGateway_ProcessRequest_HandlerTimeout(t *testing.T) {
 	t.Parallel()
 
@@ -393,7 +484,7 @@ Address = "0x0001020304050607080900010203040506070809"
 	}
 	mhf := &handlerFactory{handlers: handlersObj}
 
-	gatewayObj, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), mhf, lggr, limits.Factory{Logger: lggr})
+	gatewayObj, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), mhf, nil, lggr, limits.Factory{Logger: lggr})
 	require.NoError(t, err)
 
 	method := "dummy.dummy"
@@ -424,3 +515,266 @@ Address = "0x0001020304050607080900010203040506070809"
 	requireJSONRPCResult(t, method, response, "abcd",
 		`{"result":"OK"}`)
 }
+
+func TestGateway_ProcessRequest_UserAuth(t *testing.T) {
+	t.Parallel()
+
+	tomlConfig := buildConfig(fmt.Sprintf(`
+[userAuthConfig]
+[[userAuthConfig.APIKeys]]
+Identity = "alice"
+KeyHash = "%x"
+
+[userAuthConfig.DefaultQuota]
+RequestsPerMinute = 2
+
+[[dons]]
+DonId = "1"
+
+[[dons.Handlers]]
+Name = "dummy"
+ServiceName = "dummy"
+
+[[dons.Members]]
+Name = "node one"
+Address = "0x0001020304050607080900010203040506070809"
+`, sha256.Sum256([]byte("alice-key"))))
+
+	lggr := logger.Test(t)
+	mhf := &handlerFactory{handlers: map[string]handlers.Handler{"dummy": newMockHandler(t, "dummy.dummy")}}
+	gatewayObj, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), mhf, nil, lggr, limits.Factory{Logger: lggr})
+	require.NoError(t, err)
+
+	method := "dummy.dummy"
+	req := newJSONRpcRequest(t, "abcd", method, []byte(`{"type":"new"}`))
+	response, statusCode := gatewayObj.ProcessRequest(testutils.Context(t), req, "")
+	requireJSONRPCError(t, response, "abcd", api.ErrUnauthorized, "missing or unsupported credential")
+	require.Equal(t, 401, statusCode)
+
+	ctx := gw_net.ContextWithUserAuth(testutils.Context(t), "ApiKey bob-key")
+	response, statusCode = gatewayObj.ProcessRequest(ctx, req, "")
+	requireJSONRPCError(t, response, "abcd", api.ErrUnauthorized, "invalid API key")
+	require.Equal(t, 401, statusCode)
+
+	ctx = gw_net.ContextWithUserAuth(testutils.Context(t), "ApiKey alice-key")
+	response, statusCode = gatewayObj.ProcessRequest(ctx, req, "")
+	require.Equal(t, 200, statusCode, string(response))
+	requireJSONRPCResult(t, method, response, "abcd", `{"result":"OK"}`)
+
+	legacyReq := newSignedLegacyRequest(t, "abcd", method, "1", []byte{})
+	response, statusCode = gatewayObj.ProcessRequest(ctx, legacyReq, "")
+	require.Equal(t, 200, statusCode, string(response))
+
+	response, statusCode = gatewayObj.ProcessRequest(ctx, req, "")
+	requireJSONRPCError(t, response, "abcd", jsonrpc.ErrLimitExceeded, "requests per minute quota exceeded")
+	require.Equal(t, 429, statusCode)
+}
+
+func TestGateway_ProcessStreamingRequest_UserQuotaCountsEvents(t *testing.T) {
+	t.Parallel()
+
+	tomlConfig := buildConfig(fmt.Sprintf(`
+[userAuthConfig]
+[[userAuthConfig.APIKeys]]
+Identity = "alice"
+KeyHash = "%x"
+
+[userAuthConfig.DefaultQuota]
+BytesPerDay = 1000
+
+[[dons]]
+DonId = "1"
+
+[[dons.Handlers]]
+Name = "dummy"
+ServiceName = "dummy"
+`, sha256.Sum256([]byte("alice-key"))))
+
+	const method = "dummy.dummy"
+	handler := handlermocks.NewHandler(t)
+	handler.On("Methods").Return([]string{method})
+	handler.On("HandleJSONRPCUserMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		request := args.Get(1).(jsonrpc.Request[json.RawMessage])
+		callback := args.Get(2).(handlers.Callback)
+		require.NoError(t, handlers.SendEvent(callback, "progress", strings.Repeat("a", 1000)))
+		rawResult := json.RawMessage(`{"result":"OK"}`)
+		rawMsg, err := json.Marshal(&jsonrpc.Response[json.RawMessage]{
+			Version: jsonrpc.JsonRpcVersion,
+			ID:      request.ID,
+			Result:  &rawResult,
+			Method:  request.Method,
+		})
+		require.NoError(t, err)
+		require.NoError(t, callback.SendResponse(handlers.UserCallbackPayload{RawResponse: rawMsg, ErrorCode: api.NoError}))
+	})
+	lggr := logger.Test(t)
+	mhf := &handlerFactory{handlers: map[string]handlers.Handler{"dummy": handler}}
+	gatewayObj, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), mhf, nil, lggr, limits.Factory{Logger: lggr})
+	require.NoError(t, err)
+
+	ctx := gw_net.ContextWithUserAuth(testutils.Context(t), "ApiKey alice-key")
+	req := newJSONRpcRequest(t, "abcd", method, []byte(`{"type":"new"}`))
+	stream := &eventRecorder{}
+	response, statusCode := gatewayObj.ProcessStreamingRequest(ctx, req, "", stream)
+	require.Equal(t, 200, statusCode, string(response))
+	require.Len(t, stream.events, 1)
+
+	// the request and its response alone fit the quota many times over
+	response, statusCode = gatewayObj.ProcessRequest(ctx, req, "")
+	requireJSONRPCError(t, response, "abcd", jsonrpc.ErrLimitExceeded, "bytes per day quota exceeded")
+	require.Equal(t, 429, statusCode)
+}
+
+func TestGateway_UpdateConfig(t *testing.T) {
+	t.Parallel()
+
+	const (
+		nodeOne = "0x0001020304050607080900010203040506070809"
+		nodeTwo = "0x0001020304050607080900010203040506070810"
+	)
+	lggr := logger.Test(t)
+	gw, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, buildConfig(`
+[[dons]]
+DonId = "my_don_1"
+HandlerName = "dummy"
+
+[[dons.Members]]
+Name = "node one"
+Address = "`+nodeOne+`"
+`)), newGatewayHandler(t), nil, lggr, limits.Factory{Logger: lggr})
+	require.NoError(t, err)
+	servicetest.Run(t, gw)
+
+	ctx := testutils.Context(t)
+	sendRequest := func(donID string) []byte {
+		response, _ := gw.ProcessRequest(ctx, newSignedLegacyRequest(t, "abc", "request", donID, []byte{}), "")
+		return response
+	}
+	requireJSONRPCError(t, sendRequest("my_don_2"), "abc", jsonrpc.ErrInvalidParams, "Unsupported DON ID or Handler: my_don_2")
+
+	// add a DON, and replace the node of the existing one
+	require.NoError(t, gw.UpdateConfig(ctx, parseTOMLConfig(t, buildConfig(`
+[[dons]]
+DonId = "my_don_1"
+HandlerName = "dummy"
+
+[[dons.Members]]
+Name = "node two"
+Address = "`+nodeTwo+`"
+
+[[dons]]
+DonId = "my_don_2"
+HandlerName = "dummy"
+
+[[dons.Members]]
+Name = "node one"
+Address = "`+nodeOne+`"
+`))))
+	// the nodes are known, but not connected
+	requireJSONRPCError(t, sendRequest("my_don_1"), "abc", jsonrpc.ErrInvalidRequest, "no active connection")
+	requireJSONRPCError(t, sendRequest("my_don_2"), "abc", jsonrpc.ErrInvalidRequest, "no active connection")
+
+	// remove a DON
+	require.NoError(t, gw.UpdateConfig(ctx, parseTOMLConfig(t, buildConfig(`
+[[dons]]
+DonId = "my_don_2"
+HandlerName = "dummy"
+`))))
+	requireJSONRPCError(t, sendRequest("my_don_1"), "abc", jsonrpc.ErrInvalidParams, "Unsupported DON ID or Handler: my_don_1")
+
+	// server settings can not be updated at runtime
+	err = gw.UpdateConfig(ctx, parseTOMLConfig(t, buildConfig(`
+[connectionManagerConfig]
+HeartbeatIntervalSec = 10
+`)))
+	require.ErrorIs(t, err, handlers.ErrRestartRequired)
+
+	err = gw.UpdateConfig(ctx, parseTOMLConfig(t, buildConfig(`
+[[dons]]
+DonId = "my_don_2"
+HandlerName = "no_such_handler"
+`)))
+	require.ErrorContains(t, err, "unsupported handler type no_such_handler")
+
+	// a partly applied update leaves the added DON running
+	err = gw.UpdateConfig(ctx, parseTOMLConfig(t, buildConfig(`
+[[dons]]
+DonId = "my_don_3"
+HandlerName = "dummy"
+
+[[dons.Members]]
+Name = "node two"
+Address = "`+nodeTwo+`"
+
+[[dons]]
+DonId = "my_don_4"
+HandlerName = "no_such_handler"
+`)))
+	require.ErrorContains(t, err, "unsupported handler type no_such_handler")
+	requireJSONRPCError(t, sendRequest("my_don_3"), "abc", jsonrpc.ErrInvalidRequest, "no active connection")
+
+	// the next update is compared with the DONs which are running
+	cfg := parseTOMLConfig(t, buildConfig(`
+[[dons]]
+DonId = "my_don_3"
+HandlerName = "dummy"
+
+[[dons.Members]]
+Name = "node one"
+Address = "`+strings.ToUpper(nodeOne)+`"
+`))
+	require.NoError(t, gw.UpdateConfig(ctx, cfg))
+	requireJSONRPCError(t, sendRequest("my_don_2"), "abc", jsonrpc.ErrInvalidParams, "Unsupported DON ID or Handler: my_don_2")
+	require.Equal(t, strings.ToUpper(nodeOne), cfg.Dons[0].Members[0].Address, "the given config is not modified")
+}
+
+type statusReportingHandler struct {
+	handlers.Handler
+	status handlers.Status
+}
+
+func (h *statusReportingHandler) Status() handlers.Status { return h.status }
+
+func TestGateway_Status(t *testing.T) {
+	t.Parallel()
+
+	const nodeOne = "0x0001020304050607080900010203040506070809"
+	tomlConfig := buildConfig(`
+[[dons]]
+DonId = "my_don_1"
+
+[[dons.Handlers]]
+Name = "dummy"
+
+[[dons.Handlers]]
+Name = "reporting"
+
+[[dons.Members]]
+Name = "node one"
+Address = "` + nodeOne + `"
+`)
+	dummy := handlermocks.NewHandler(t)
+	dummy.On("Methods").Return([]string{"dummy.dummy"})
+	reporting := handlermocks.NewHandler(t)
+	reporting.On("Methods").Return([]string{"reporting.reporting"})
+	status := handlers.Status{PendingRequests: 3, ResponseCache: &handlers.CacheStats{Entries: 2, Hits: 5, Misses: 1}}
+	mhf := &handlerFactory{handlers: map[string]handlers.Handler{
+		"dummy":     dummy,
+		"reporting": &statusReportingHandler{Handler: reporting, status: status},
+	}}
+	lggr := logger.Test(t)
+	gatewayObj, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), mhf, nil, lggr, limits.Factory{Logger: lggr})
+	require.NoError(t, err)
+
+	require.Equal(t, gateway.Status{DONs: []gateway.DONStatus{{
+		DonID: "my_don_1",
+		Nodes: []gateway.NodeStatus{{Address: nodeOne, Name: "node one"}},
+		Handlers: []gateway.HandlerStatus{
+			{Name: "dummy"},
+			{Name: "reporting", Status: &status},
+		},
+	}}}, gatewayObj.Status())
+
+	require.ErrorIs(t, gatewayObj.DisconnectNode("my_don_1", nodeOne), gateway.ErrNodeNotConnected)
+	require.ErrorIs(t, gatewayObj.DisconnectNode("my_don_2", nodeOne), gateway.ErrNodeNotFound)
+}
@@ -62,6 +62,7 @@ type savedCallback struct {
 }
 
 var _ handlers.Handler = (*handler)(nil)
+var _ handlers.ConfigUpdater = (*handler)(nil)
 
 func NewHandler(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, httpClient network.HTTPClient, lggr logger.Logger) (*handler, error) {
 	var cfg HandlerConfig
@@ -139,7 +140,10 @@ func (h *handler) handleWebAPITriggerMessage(ctx context.Context, msg *api.Messa
 
 func (h *handler) handleWebAPIOutgoingMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
 	h.lggr.Debugw("handling webAPI outgoing message", "messageId", msg.Body.MessageId, "nodeAddr", nodeAddr)
-	if !h.nodeRateLimiter.Allow(nodeAddr) {
+	h.mu.Lock()
+	nodeRateLimiter := h.nodeRateLimiter
+	h.mu.Unlock()
+	if !nodeRateLimiter.Allow(nodeAddr) {
 		return fmt.Errorf("rate limit exceeded for node %s", nodeAddr)
 	}
 	var payload Request
@@ -244,6 +248,25 @@ func (h *handler) HandleNodeMessage(ctx context.Context, resp *jsonrpc.Response[
 	return err
 }
 
+// UpdateConfig applies a new handler config, which takes effect for the next
+// messages, and a new DON config, to which the next requests are sent.
+func (h *handler) UpdateConfig(handlerConfig json.RawMessage, donConfig *config.DONConfig) error {
+	var cfg HandlerConfig
+	if err := json.Unmarshal(handlerConfig, &cfg); err != nil {
+		return err
+	}
+	nodeRateLimiter, err := ratelimit.NewRateLimiter(cfg.NodeRateLimiter)
+	if err != nil {
+		return err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.config = cfg
+	h.donConfig = donConfig
+	h.nodeRateLimiter = nodeRateLimiter
+	return nil
+}
+
 func (h *handler) Start(context.Context) error {
 	return nil
 }
@@ -261,6 +284,8 @@ func (h *handler) HandleLegacyUserMessage(ctx context.Context, msg *api.Message,
 	h.mu.Lock()
 	h.savedCallbacks[msg.Body.MessageId] = &savedCallback{msg.Body.MessageId, callback}
 	don := h.don
+	cfg := h.config
+	members := h.donConfig.Members
 	h.mu.Unlock()
 	body := msg.Body
 	var payload webapicap.TriggerRequestPayload
@@ -292,7 +317,7 @@ func (h *handler) HandleLegacyUserMessage(ctx context.Context, msg *api.Message,
 		})
 	}
 
-	if uint(time.Now().Unix())-h.config.MaxAllowedMessageAgeSec > uint(payload.Timestamp) {
+	if uint(time.Now().Unix())-cfg.MaxAllowedMessageAgeSec > uint(payload.Timestamp) {
 		h.lggr.Errorw("stale message")
 		return callback.SendResponse(handlers.UserCallbackPayload{
 			RawResponse: codec.EncodeNewErrorResponse(
@@ -331,7 +356,7 @@ func (h *handler) HandleLegacyUserMessage(ctx context.Context, msg *api.Message,
 		})
 	}
 	// Send original request to all nodes
-	for _, member := range h.donConfig.Members {
+	for _, member := range members {
 		err = errors.Join(err, don.SendToNode(ctx, member.Address, req))
 	}
 	return err
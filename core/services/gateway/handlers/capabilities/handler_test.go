@@ -511,3 +511,15 @@ func nodeRequest(msg *api.Message) *jsonrpc.Request[json.RawMessage] {
 	}
 	return req
 }
+
+func TestHandler_UpdateConfig(t *testing.T) {
+	handler, _, _, nodes := setupHandler(t)
+	donConfig := &config.DONConfig{Members: []config.NodeConfig{{Name: "node_0", Address: nodes[0].Address}}}
+
+	require.Error(t, handler.UpdateConfig(json.RawMessage(`{"nodeRateLimiter":`), donConfig))
+	require.NoError(t, handler.UpdateConfig(json.RawMessage(`{"nodeRateLimiter":{"globalRPS":1,"globalBurst":1,"perSenderRPS":1,"perSenderBurst":1},"maxAllowedMessageAgeSec":60}`), donConfig))
+	require.Equal(t, uint(60), handler.config.MaxAllowedMessageAgeSec)
+	require.Same(t, donConfig, handler.donConfig)
+	require.True(t, handler.nodeRateLimiter.Allow(nodes[0].Address))
+	require.False(t, handler.nodeRateLimiter.Allow(nodes[0].Address))
+}
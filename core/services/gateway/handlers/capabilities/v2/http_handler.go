@@ -22,9 +22,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/capabilities/v2/metrics"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/state"
 )
 
 var _ handlers.Handler = (*gatewayHandler)(nil)
+var _ handlers.StatusReporter = (*gatewayHandler)(nil)
 
 const (
 	handlerName                          = "HTTPCapabilityHandler"
@@ -58,7 +60,7 @@ type gatewayHandler struct {
 
 type ResponseCache interface {
 	// Set caches a response if it is cacheable (2xx or 4xx status codes) and the cache is empty or expired for the given request.
-	Set(workflowID string, req gateway_common.OutboundHTTPRequest, response gateway_common.OutboundHTTPResponse)
+	Set(ctx context.Context, workflowID string, req gateway_common.OutboundHTTPRequest, response gateway_common.OutboundHTTPResponse)
 
 	// Fetch retrieves a response from the cache if it exists and the age of cached response is less than the max age of the request.
 	// If the cached response is expired or not cached, it fetches a new response from the fetchFn.
@@ -67,6 +69,9 @@ type ResponseCache interface {
 
 	// DeleteExpired removes all cached responses that have exceeded their TTL (Time To Live).
 	DeleteExpired(ctx context.Context) int
+
+	// Stats returns the number of cached responses, and how many fetches were served from the cache.
+	Stats() handlers.CacheStats
 }
 
 type ServiceConfig struct {
@@ -109,7 +114,10 @@ type RetryConfig struct {
 	Multiplier float64 `json:"multiplier"`
 }
 
-func NewGatewayHandler(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, httpClient network.HTTPClient, lggr logger.Logger, lf limits.Factory) (*gatewayHandler, error) {
+// NewGatewayHandler returns the handler of a DON. Its responses to outbound
+// HTTP requests are shared with the other replicas of the gateway through
+// store, unless it is nil.
+func NewGatewayHandler(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, httpClient network.HTTPClient, store state.Store, lggr logger.Logger, lf limits.Factory) (*gatewayHandler, error) {
 	var cfg ServiceConfig
 	err := json.Unmarshal(handlerConfig, &cfg)
 	if err != nil {
@@ -132,6 +140,10 @@ func NewGatewayHandler(handlerConfig json.RawMessage, donConfig *config.DONConfi
 
 	metadataHandler := NewWorkflowMetadataHandler(lggr, cfg, don, donConfig, metrics)
 	triggerHandler := NewHTTPTriggerHandler(lggr, cfg, donConfig, don, metadataHandler, userRateLimiter, metrics)
+	cache := newResponseCache(lggr, cfg.OutboundRequestCacheTTLMs, metrics)
+	if store != nil {
+		cache = newSharedResponseCache(lggr, cfg.OutboundRequestCacheTTLMs, store, donConfig.DonId, metrics)
+	}
 	return &gatewayHandler{
 		config:          cfg,
 		don:             don,
@@ -139,7 +151,7 @@ func NewGatewayHandler(handlerConfig json.RawMessage, donConfig *config.DONConfi
 		httpClient:      httpClient,
 		nodeRateLimiter: nodeRateLimiter,
 		stopCh:          make(services.StopChan),
-		responseCache:   newResponseCache(lggr, cfg.OutboundRequestCacheTTLMs, metrics),
+		responseCache:   cache,
 		triggerHandler:  triggerHandler,
 		metadataHandler: metadataHandler,
 		metrics:         metrics,
@@ -353,7 +365,7 @@ func (h *gatewayHandler) makeOutgoingRequest(ctx context.Context, resp *jsonrpc.
 		} else {
 			outboundResp = callback()
 			if req.CacheSettings.Store {
-				h.responseCache.Set(workflowID, req, outboundResp)
+				h.responseCache.Set(ctx, workflowID, req, outboundResp)
 			}
 		}
 		h.metrics.IncrementActionCapabilityRequestCount(ctx, nodeAddr, h.lggr)
@@ -366,6 +378,14 @@ func (h *gatewayHandler) makeOutgoingRequest(ctx context.Context, resp *jsonrpc.
 	return nil
 }
 
+func (h *gatewayHandler) Status() handlers.Status {
+	cacheStats := h.responseCache.Stats()
+	return handlers.Status{
+		PendingRequests: h.triggerHandler.PendingRequests(),
+		ResponseCache:   &cacheStats,
+	}
+}
+
 func (h *gatewayHandler) HealthReport() map[string]error {
 	return map[string]error{handlerName: h.Healthy()}
 }
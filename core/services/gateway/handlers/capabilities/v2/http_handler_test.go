@@ -20,6 +20,7 @@ import (
 	gateway_common "github.com/smartcontractkit/chainlink-common/pkg/types/gateway"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	triggermocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/capabilities/v2/mocks"
 	handlermocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
@@ -39,7 +40,7 @@ func TestNewGatewayHandler(t *testing.T) {
 		mockHTTPClient := httpmocks.NewHTTPClient(t)
 		lggr := logger.Test(t)
 
-		handler, err := NewGatewayHandler(configBytes, donConfig, mockDon, mockHTTPClient, lggr, limits.Factory{Logger: lggr})
+		handler, err := NewGatewayHandler(configBytes, donConfig, mockDon, mockHTTPClient, nil, lggr, limits.Factory{Logger: lggr})
 		require.NoError(t, err)
 		require.NotNil(t, handler)
 		require.NotNil(t, handler.responseCache)
@@ -54,7 +55,7 @@ func TestNewGatewayHandler(t *testing.T) {
 		mockHTTPClient := httpmocks.NewHTTPClient(t)
 		lggr := logger.Test(t)
 
-		handler, err := NewGatewayHandler(invalidConfig, donConfig, mockDon, mockHTTPClient, lggr, limits.Factory{Logger: lggr})
+		handler, err := NewGatewayHandler(invalidConfig, donConfig, mockDon, mockHTTPClient, nil, lggr, limits.Factory{Logger: lggr})
 		require.Error(t, err)
 		require.Nil(t, handler)
 	})
@@ -74,7 +75,7 @@ func TestNewGatewayHandler(t *testing.T) {
 		mockHTTPClient := httpmocks.NewHTTPClient(t)
 		lggr := logger.Test(t)
 
-		handler, err := NewGatewayHandler(configBytes, donConfig, mockDon, mockHTTPClient, lggr, limits.Factory{Logger: lggr})
+		handler, err := NewGatewayHandler(configBytes, donConfig, mockDon, mockHTTPClient, nil, lggr, limits.Factory{Logger: lggr})
 		require.Error(t, err)
 		require.Nil(t, handler)
 	})
@@ -97,7 +98,7 @@ func TestNewGatewayHandler(t *testing.T) {
 		mockHTTPClient := httpmocks.NewHTTPClient(t)
 		lggr := logger.Test(t)
 
-		handler, err := NewGatewayHandler(configBytes, donConfig, mockDon, mockHTTPClient, lggr, limits.Factory{Logger: lggr})
+		handler, err := NewGatewayHandler(configBytes, donConfig, mockDon, mockHTTPClient, nil, lggr, limits.Factory{Logger: lggr})
 		require.NoError(t, err)
 		require.NotNil(t, handler)
 		require.Equal(t, defaultCleanUpPeriodMs, handler.config.CleanUpPeriodMs) // Default value
@@ -348,7 +349,7 @@ func newMockResponseCache() *mockResponseCache {
 	}
 }
 
-func (m *mockResponseCache) Set(workflowID string, req gateway_common.OutboundHTTPRequest, response gateway_common.OutboundHTTPResponse) {
+func (m *mockResponseCache) Set(ctx context.Context, workflowID string, req gateway_common.OutboundHTTPRequest, response gateway_common.OutboundHTTPResponse) {
 	m.setCallCount++
 }
 
@@ -365,6 +366,10 @@ func (m *mockResponseCache) DeleteExpired(ctx context.Context) int {
 	return 0
 }
 
+func (m *mockResponseCache) Stats() handlers.CacheStats {
+	return handlers.CacheStats{}
+}
+
 func TestGatewayHandler_Start_CallsDeleteExpired(t *testing.T) {
 	cfg := serviceCfg()
 	cfg.CleanUpPeriodMs = 100 // fast cleanup for test
@@ -377,7 +382,7 @@ func TestGatewayHandler_Start_CallsDeleteExpired(t *testing.T) {
 	mockHTTPClient := httpmocks.NewHTTPClient(t)
 	lggr := logger.Test(t)
 
-	handler, err := NewGatewayHandler(configBytes, donConfig, mockDon, mockHTTPClient, lggr, limits.Factory{Logger: lggr})
+	handler, err := NewGatewayHandler(configBytes, donConfig, mockDon, mockHTTPClient, nil, lggr, limits.Factory{Logger: lggr})
 	require.NoError(t, err)
 	require.NotNil(t, handler)
 	mockCache := newMockResponseCache()
@@ -426,7 +431,7 @@ func createTestHandlerWithConfig(t *testing.T, cfg ServiceConfig) *gatewayHandle
 	mockHTTPClient := httpmocks.NewHTTPClient(t)
 	lggr := logger.Test(t)
 
-	handler, err := NewGatewayHandler(configBytes, donConfig, mockDon, mockHTTPClient, lggr, limits.Factory{Logger: lggr})
+	handler, err := NewGatewayHandler(configBytes, donConfig, mockDon, mockHTTPClient, nil, lggr, limits.Factory{Logger: lggr})
 	require.NoError(t, err)
 	require.NotNil(t, handler)
 
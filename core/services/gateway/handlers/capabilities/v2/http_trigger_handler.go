@@ -40,6 +40,30 @@ const (
 	maxWorkflowTagLength   = 32 // Maximum workflow tag length
 )
 
+// Types of the events sent to users who stream their trigger request, before
+// the aggregated response.
+const (
+	// EventTypeAccepted is sent once the request is authorized, with an AcceptedEvent.
+	EventTypeAccepted = "accepted"
+	// EventTypeSentToNode is sent for every node the request is sent to, with a NodeEvent.
+	EventTypeSentToNode = "sent_to_node"
+	// EventTypeNodeAcknowledged is sent for every node which responds to the request, with a NodeEvent.
+	EventTypeNodeAcknowledged = "node_acknowledged"
+)
+
+// AcceptedEvent is the data of an EventTypeAccepted event.
+type AcceptedEvent struct {
+	WorkflowID  string `json:"workflowId"`
+	ExecutionID string `json:"executionId"`
+}
+
+// NodeEvent is the data of the events about a single node.
+type NodeEvent struct {
+	NodeAddress string `json:"nodeAddress"`
+	// Error is the error the node responded with, if any.
+	Error string `json:"error,omitempty"`
+}
+
 type savedCallback struct {
 	handlers.Callback
 	requestStartTime   time.Time
@@ -67,6 +91,8 @@ type HTTPTriggerHandler interface {
 	job.ServiceCtx
 	HandleUserTriggerRequest(ctx context.Context, req *jsonrpc.Request[json.RawMessage], callback handlers.Callback, requestStartTime time.Time) error
 	HandleNodeTriggerResponse(ctx context.Context, resp *jsonrpc.Response[json.RawMessage], nodeAddr string) error
+	// PendingRequests returns the number of trigger requests awaiting the responses of nodes.
+	PendingRequests() int
 }
 
 func NewHTTPTriggerHandler(lggr logger.Logger, cfg ServiceConfig, donConfig *config.DONConfig, don handlers.DON, workflowMetadataHandler *WorkflowMetadataHandler, userRateLimiter limits.RateLimiter, metrics *metrics.Metrics) *httpTriggerHandler {
@@ -120,8 +146,9 @@ func (h *httpTriggerHandler) HandleUserTriggerRequest(ctx context.Context, req *
 	if err != nil {
 		return err
 	}
+	h.sendEvent(callback, req.ID, EventTypeAccepted, AcceptedEvent{WorkflowID: workflowID, ExecutionID: executionID})
 
-	return h.sendWithRetries(ctx, executionID, reqWithKey, doneCh)
+	return h.sendWithRetries(ctx, executionID, reqWithKey, callback, doneCh)
 }
 
 func (h *httpTriggerHandler) validatedTriggerRequest(ctx context.Context, req *jsonrpc.Request[json.RawMessage], callback handlers.Callback) (*jsonrpc.Request[gateway_common.HTTPTriggerRequest], error) {
@@ -416,6 +443,12 @@ func (h *httpTriggerHandler) cleanupCallback(requestID string) {
 	delete(h.callbacks, requestID)
 }
 
+func (h *httpTriggerHandler) PendingRequests() int {
+	h.callbacksMu.Lock()
+	defer h.callbacksMu.Unlock()
+	return len(h.callbacks)
+}
+
 func (h *httpTriggerHandler) HandleNodeTriggerResponse(ctx context.Context, resp *jsonrpc.Response[json.RawMessage], nodeAddr string) error {
 	h.lggr.Debugw("handling trigger response", "requestID", resp.ID, "nodeAddr", nodeAddr, "error", resp.Error, "result", resp.Result)
 	h.callbacksMu.Lock()
@@ -428,6 +461,11 @@ func (h *httpTriggerHandler) HandleNodeTriggerResponse(ctx context.Context, resp
 	if err != nil {
 		return err
 	}
+	ack := NodeEvent{NodeAddress: nodeAddr}
+	if resp.Error != nil {
+		ack.Error = resp.Error.Message
+	}
+	h.sendEvent(saved.Callback, resp.ID, EventTypeNodeAcknowledged, ack)
 	if aggResp == nil {
 		h.lggr.Debugw("Not enough responses to aggregate", "requestID", resp.ID, "nodeAddress", nodeAddr)
 		return nil
@@ -517,6 +555,14 @@ func isValidJSON(data []byte) bool {
 	}
 }
 
+// sendEvent sends an event to users who stream their request. Users are not
+// guaranteed to receive every event, so failures are only logged.
+func (h *httpTriggerHandler) sendEvent(callback handlers.Callback, requestID string, eventType string, data any) {
+	if err := handlers.SendEvent(callback, eventType, data); err != nil {
+		h.lggr.Debugw("failed to send event to user", "eventType", eventType, "requestID", requestID, "err", err)
+	}
+}
+
 func (h *httpTriggerHandler) handleUserError(ctx context.Context, requestID string, code int64, message string, callback handlers.Callback) {
 	resp := &jsonrpc.Response[json.RawMessage]{
 		Version: "2.0",
@@ -546,7 +592,7 @@ func (h *httpTriggerHandler) handleUserError(ctx context.Context, requestID stri
 // sendWithRetries attempts to send the request to all DON members,
 // retrying failed nodes until either all succeed or the max trigger request duration is reached.
 // doneCh is closed when the callback has been responded to (quorum reached), allowing immediate termination.
-func (h *httpTriggerHandler) sendWithRetries(ctx context.Context, executionID string, req *jsonrpc.Request[json.RawMessage], doneCh <-chan struct{}) error {
+func (h *httpTriggerHandler) sendWithRetries(ctx context.Context, executionID string, req *jsonrpc.Request[json.RawMessage], callback handlers.Callback, doneCh <-chan struct{}) error {
 	if doneCh == nil {
 		return errors.New("doneCh cannot be nil")
 	}
@@ -586,6 +632,7 @@ func (h *httpTriggerHandler) sendWithRetries(ctx context.Context, executionID st
 			} else {
 				// Mark this node as successful
 				successfulNodes[member.Address] = true
+				h.sendEvent(callback, req.ID, EventTypeSentToNode, NodeEvent{NodeAddress: member.Address})
 			}
 		}
 
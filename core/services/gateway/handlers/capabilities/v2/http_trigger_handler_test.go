@@ -466,6 +466,59 @@ func TestHttpTriggerHandler_HandleNodeTriggerResponse(t *testing.T) {
 		require.Equal(t, nodeResp.Result, resp.Result)
 	})
 
+	t.Run("streams progress to the user", func(t *testing.T) {
+		handler, mockDon := createTestTriggerHandler(t)
+		privateKey := createTestPrivateKey(t)
+		registerWorkflow(t, handler, workflowID, privateKey)
+		callback := hc.NewStreamingCallback(10)
+
+		triggerReq := createTestTriggerRequest(workflowID)
+		reqBytes, err := json.Marshal(triggerReq)
+		require.NoError(t, err)
+		rawParams := json.RawMessage(reqBytes)
+		req := &jsonrpc.Request[json.RawMessage]{
+			Version: "2.0",
+			ID:      requestID,
+			Method:  gateway_common.MethodWorkflowExecute,
+			Params:  &rawParams,
+		}
+		req.Auth = createTestJWTToken(t, req, privateKey)
+
+		mockDon.EXPECT().SendToNode(mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(3)
+		err = handler.HandleUserTriggerRequest(testutils.Context(t), req, callback, time.Now())
+		require.NoError(t, err)
+
+		rawRes := json.RawMessage(`{"result":"success"}`)
+		nodeResp := &jsonrpc.Response[json.RawMessage]{
+			Version: "2.0",
+			ID:      requestID,
+			Result:  &rawRes,
+		}
+		for _, node := range []string{"node1", "node2", "node3"} {
+			require.NoError(t, handler.HandleNodeTriggerResponse(testutils.Context(t), nodeResp, node))
+		}
+
+		var events []handlers.UserCallbackEvent
+		payload, err := callback.Wait(t.Context(), func(event handlers.UserCallbackEvent) {
+			events = append(events, event)
+		})
+		require.NoError(t, err)
+		require.Equal(t, api.NoError, payload.ErrorCode)
+
+		require.Len(t, events, 7)
+		require.Equal(t, EventTypeAccepted, events[0].Type)
+		var accepted AcceptedEvent
+		require.NoError(t, json.Unmarshal(events[0].Data, &accepted))
+		require.Equal(t, workflowID, accepted.WorkflowID)
+		require.NotEmpty(t, accepted.ExecutionID)
+		for i, node := range []string{"node1", "node2", "node3"} {
+			require.Equal(t, EventTypeSentToNode, events[1+i].Type)
+			require.JSONEq(t, `{"nodeAddress":"`+node+`"}`, string(events[1+i].Data))
+			require.Equal(t, EventTypeNodeAcknowledged, events[4+i].Type)
+			require.JSONEq(t, `{"nodeAddress":"`+node+`"}`, string(events[4+i].Data))
+		}
+	})
+
 	t.Run("callback not found", func(t *testing.T) {
 		handler, _ := createTestTriggerHandler(t)
 
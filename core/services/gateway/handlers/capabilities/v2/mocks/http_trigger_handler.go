@@ -170,6 +170,51 @@ func (_c *HTTPTriggerHandler_HandleUserTriggerRequest_Call) RunAndReturn(run fun
 	return _c
 }
 
+// PendingRequests provides a mock function with no fields
+func (_m *HTTPTriggerHandler) PendingRequests() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingRequests")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// HTTPTriggerHandler_PendingRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingRequests'
+type HTTPTriggerHandler_PendingRequests_Call struct {
+	*mock.Call
+}
+
+// PendingRequests is a helper method to define mock.On call
+func (_e *HTTPTriggerHandler_Expecter) PendingRequests() *HTTPTriggerHandler_PendingRequests_Call {
+	return &HTTPTriggerHandler_PendingRequests_Call{Call: _e.mock.On("PendingRequests")}
+}
+
+func (_c *HTTPTriggerHandler_PendingRequests_Call) Run(run func()) *HTTPTriggerHandler_PendingRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HTTPTriggerHandler_PendingRequests_Call) Return(_a0 int) *HTTPTriggerHandler_PendingRequests_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HTTPTriggerHandler_PendingRequests_Call) RunAndReturn(run func() int) *HTTPTriggerHandler_PendingRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *HTTPTriggerHandler) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -2,23 +2,35 @@ package v2
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/capabilities/v2/metrics"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/state"
 )
 
 // responseCache is a thread-safe cache for storing HTTP responses.
 // It uses a map to store responses keyed by a unique identifier generated from the request
 // cache key is prefixed by workflowID to avoid collisions between different workflows.
+// Replicas of a gateway also share cached responses through a state store, as
+// the nodes of a DON making the same request may be connected to different replicas.
 type responseCache struct {
 	cacheMu sync.Mutex
 	cache   map[string]*cachedResponse
-	lggr    logger.Logger
-	ttl     time.Duration
-	metrics *metrics.Metrics
+	// store is nil unless the gateway is replicated
+	store     state.Store
+	keyPrefix string
+	lggr      logger.Logger
+	ttl       time.Duration
+	metrics   *metrics.Metrics
+	hits      atomic.Uint64
+	misses    atomic.Uint64
 }
 
 type cachedResponse struct {
@@ -26,6 +38,12 @@ type cachedResponse struct {
 	storedAt time.Time
 }
 
+// sharedResponse is a cached response as kept in the state store.
+type sharedResponse struct {
+	Response gateway.OutboundHTTPResponse `json:"response"`
+	StoredAt time.Time                    `json:"storedAt"`
+}
+
 func newResponseCache(lggr logger.Logger, ttlMs int, metrics *metrics.Metrics) *responseCache {
 	return &responseCache{
 		cache:   make(map[string]*cachedResponse),
@@ -35,6 +53,15 @@ func newResponseCache(lggr logger.Logger, ttlMs int, metrics *metrics.Metrics) *
 	}
 }
 
+// newSharedResponseCache returns a cache whose responses are shared, through
+// store, with the other replicas of the gateway serving the DON.
+func newSharedResponseCache(lggr logger.Logger, ttlMs int, store state.Store, donID string, metrics *metrics.Metrics) *responseCache {
+	rc := newResponseCache(lggr, ttlMs, metrics)
+	rc.store = store
+	rc.keyPrefix = "gateway/" + handlerName + "/" + donID + "/response/"
+	return rc
+}
+
 // isCacheableStatusCode returns true if the HTTP status code indicates a cacheable response.
 // This includes successful responses (2xx) and client errors (4xx)
 func isCacheableStatusCode(statusCode int) bool {
@@ -60,30 +87,70 @@ func (rc *responseCache) Fetch(ctx context.Context, workflowID string, req gatew
 	defer rc.cacheMu.Unlock()
 	cacheMaxAge := time.Duration(req.CacheSettings.MaxAgeMs) * time.Millisecond
 	cachedResp, exists := rc.cache[req.Hash()]
+	if !exists && rc.store != nil {
+		cachedResp, exists = rc.fetchShared(ctx, req)
+	}
 	if exists && cachedResp.storedAt.Add(cacheMaxAge).After(time.Now()) {
 		rc.metrics.IncrementCacheHitCount(ctx, rc.lggr)
+		rc.hits.Add(1)
 		return cachedResp.response
 	}
+	rc.misses.Add(1)
 	response := fetchFn()
 	if storeOnFetch && isCacheableStatusCode(response.StatusCode) && rc.isExpiredOrNotCached(workflowID, req) {
-		rc.cache[req.Hash()] = &cachedResponse{
-			response: response,
-			storedAt: time.Now(),
-		}
+		rc.put(ctx, req, response)
 	}
 	return response
 }
 
 // Set caches a response if it is cacheable (2xx or 4xx and cache is empty or expired for the given request)
-func (rc *responseCache) Set(workflowID string, req gateway.OutboundHTTPRequest, response gateway.OutboundHTTPResponse) {
+func (rc *responseCache) Set(ctx context.Context, workflowID string, req gateway.OutboundHTTPRequest, response gateway.OutboundHTTPResponse) {
 	rc.cacheMu.Lock()
 	defer rc.cacheMu.Unlock()
 	if isCacheableStatusCode(response.StatusCode) && rc.isExpiredOrNotCached(workflowID, req) {
-		rc.cache[req.Hash()] = &cachedResponse{
-			response: response,
-			storedAt: time.Now(),
-		}
+		rc.put(ctx, req, response)
+	}
+}
+
+// put caches a response, and shares it with the other replicas.
+// IMPORTANT: MUST be called with the cacheMu locked.
+func (rc *responseCache) put(ctx context.Context, req gateway.OutboundHTTPRequest, response gateway.OutboundHTTPResponse) {
+	storedAt := time.Now()
+	rc.cache[req.Hash()] = &cachedResponse{
+		response: response,
+		storedAt: storedAt,
+	}
+	if rc.store == nil {
+		return
 	}
+	value, err := json.Marshal(sharedResponse{Response: response, StoredAt: storedAt})
+	if err != nil {
+		rc.lggr.Errorw("failed to encode shared HTTP response", "err", err)
+		return
+	}
+	if err = rc.store.Set(ctx, rc.keyPrefix+req.Hash(), string(value), rc.ttl); err != nil {
+		rc.lggr.Errorw("failed to share HTTP response", "err", err)
+	}
+}
+
+// fetchShared returns a response cached by any replica, and caches it locally.
+// IMPORTANT: MUST be called with the cacheMu locked.
+func (rc *responseCache) fetchShared(ctx context.Context, req gateway.OutboundHTTPRequest) (*cachedResponse, bool) {
+	value, err := rc.store.Get(ctx, rc.keyPrefix+req.Hash())
+	if errors.Is(err, state.ErrNotFound) {
+		return nil, false
+	} else if err != nil {
+		rc.lggr.Errorw("failed to fetch shared HTTP response", "err", err)
+		return nil, false
+	}
+	var shared sharedResponse
+	if err = json.Unmarshal([]byte(value), &shared); err != nil {
+		rc.lggr.Errorw("failed to decode shared HTTP response", "err", err)
+		return nil, false
+	}
+	cachedResp := &cachedResponse{response: shared.Response, storedAt: shared.StoredAt}
+	rc.cache[req.Hash()] = cachedResp
+	return cachedResp, true
 }
 
 func (rc *responseCache) DeleteExpired(ctx context.Context) int {
@@ -102,3 +169,15 @@ func (rc *responseCache) DeleteExpired(ctx context.Context) int {
 	rc.metrics.RecordCacheSize(ctx, int64(len(rc.cache)), rc.lggr)
 	return expiredCount
 }
+
+// Stats returns the number of cached responses, and of the fetches which were
+// served from the cache or not.
+func (rc *responseCache) Stats() handlers.CacheStats {
+	rc.cacheMu.Lock()
+	defer rc.cacheMu.Unlock()
+	return handlers.CacheStats{
+		Entries: len(rc.cache),
+		Hits:    rc.hits.Load(),
+		Misses:  rc.misses.Load(),
+	}
+}
@@ -4,11 +4,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	gateway_common "github.com/smartcontractkit/chainlink-common/pkg/types/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/capabilities/v2/metrics"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/state"
 )
 
 func createCacheTestMetrics(t *testing.T) *metrics.Metrics {
@@ -326,7 +329,7 @@ func TestSet(t *testing.T) {
 		req := createTestRequest("GET", "https://example.com/set")
 		response := createTestResponse(200, "response to cache")
 
-		cache.Set(workflowID, req, response)
+		cache.Set(t.Context(), workflowID, req, response)
 
 		cachedEntry, exists := cache.cache[req.Hash()]
 		require.True(t, exists)
@@ -337,7 +340,7 @@ func TestSet(t *testing.T) {
 		req := createTestRequest("GET", "https://example.com/nonset")
 		response := createTestResponse(500, "server error")
 
-		cache.Set(workflowID, req, response)
+		cache.Set(t.Context(), workflowID, req, response)
 
 		_, exists := cache.cache[req.Hash()]
 		require.False(t, exists, "5xx response should not be cached")
@@ -348,10 +351,10 @@ func TestSet(t *testing.T) {
 		originalResponse := createTestResponse(200, "original")
 		newResponse := createTestResponse(200, "new")
 
-		cache.Set(workflowID, req, originalResponse)
+		cache.Set(t.Context(), workflowID, req, originalResponse)
 
 		// Immediately try to set again
-		cache.Set(workflowID, req, newResponse)
+		cache.Set(t.Context(), workflowID, req, newResponse)
 
 		cachedEntry, exists := cache.cache[req.Hash()]
 		require.True(t, exists)
@@ -367,7 +370,7 @@ func TestSet(t *testing.T) {
 		}
 
 		newResponse := createTestResponse(200, "fresh")
-		cache.Set(workflowID, req, newResponse)
+		cache.Set(t.Context(), workflowID, req, newResponse)
 
 		cachedEntry, exists := cache.cache[req.Hash()]
 		require.True(t, exists)
@@ -418,6 +421,20 @@ func TestDeleteExpired(t *testing.T) {
 	})
 }
 
+func TestStats(t *testing.T) {
+	cache := newResponseCache(logger.Test(t), 10000, createCacheTestMetrics(t))
+	workflowID := "workflow-123"
+	req := createTestRequest("GET", "https://example.com/stats")
+	fetchFn := func() gateway_common.OutboundHTTPResponse {
+		return createTestResponse(200, "data")
+	}
+
+	require.Equal(t, handlers.CacheStats{}, cache.Stats())
+	cache.Fetch(t.Context(), workflowID, req, fetchFn, true)
+	cache.Fetch(t.Context(), workflowID, req, fetchFn, true)
+	require.Equal(t, handlers.CacheStats{Entries: 1, Hits: 1, Misses: 1}, cache.Stats())
+}
+
 func TestEdgeCases(t *testing.T) {
 	t.Run("zero TTL cache", func(t *testing.T) {
 		testMetrics := createCacheTestMetrics(t)
@@ -427,7 +444,7 @@ func TestEdgeCases(t *testing.T) {
 
 		require.True(t, cache.isExpiredOrNotCached(workflowID, req))
 
-		cache.Set(workflowID, req, createTestResponse(200, "test"))
+		cache.Set(t.Context(), workflowID, req, createTestResponse(200, "test"))
 		count := cache.DeleteExpired(t.Context())
 		require.Equal(t, 1, count, "entry should be immediately expired")
 	})
@@ -444,7 +461,7 @@ func TestEdgeCases(t *testing.T) {
 			Headers:    nil,
 		}
 
-		cache.Set(workflowID, req, resp)
+		cache.Set(t.Context(), workflowID, req, resp)
 
 		result := cache.Fetch(t.Context(), workflowID, req, func() gateway_common.OutboundHTTPResponse {
 			return resp
@@ -464,6 +481,37 @@ func TestEdgeCases(t *testing.T) {
 		hash := emptyReq.Hash()
 		require.NotEmpty(t, hash)
 
-		cache.Set(workflowID, emptyReq, createTestResponse(200, "test"))
+		cache.Set(t.Context(), workflowID, emptyReq, createTestResponse(200, "test"))
 	})
 }
+
+func TestFetch_SharedBetweenReplicas(t *testing.T) {
+	testMetrics := createCacheTestMetrics(t)
+	store := state.NewMemoryStore(clockwork.NewRealClock())
+	replicaA := newSharedResponseCache(logger.Test(t), 10000, store, "don-1", testMetrics)
+	replicaB := newSharedResponseCache(logger.Test(t), 10000, store, "don-1", testMetrics)
+	otherDON := newSharedResponseCache(logger.Test(t), 10000, store, "don-2", testMetrics)
+	workflowID := "workflow-123"
+	req := createTestRequest("GET", "https://example.com/shared")
+	expectedResp := createTestResponse(200, "shared data")
+
+	result := replicaA.Fetch(t.Context(), workflowID, req, func() gateway_common.OutboundHTTPResponse {
+		return expectedResp
+	}, true)
+	require.Equal(t, expectedResp, result)
+
+	var fetchCalled bool
+	result = replicaB.Fetch(t.Context(), workflowID, req, func() gateway_common.OutboundHTTPResponse {
+		fetchCalled = true
+		return createTestResponse(200, "should not be called")
+	}, true)
+	require.False(t, fetchCalled, "the response cached by replica A is used")
+	require.Equal(t, expectedResp, result)
+	require.Equal(t, 1, replicaB.Stats().Entries)
+
+	otherDON.Fetch(t.Context(), workflowID, req, func() gateway_common.OutboundHTTPResponse {
+		fetchCalled = true
+		return expectedResp
+	}, true)
+	require.True(t, fetchCalled, "responses are not shared between DONs")
+}
@@ -41,3 +41,60 @@ func NewCallback() *Callback {
 	ch := make(chan handlers.UserCallbackPayload, 1)
 	return &Callback{ch: ch}
 }
+
+// StreamingCallback is a Callback which also passes events on to the user,
+// before the response.
+type StreamingCallback struct {
+	*Callback
+	events chan handlers.UserCallbackEvent
+}
+
+var _ handlers.StreamingCallback = (*StreamingCallback)(nil)
+
+func (c *StreamingCallback) SendEvent(event handlers.UserCallbackEvent) error {
+	if c.sent.Load() {
+		return errors.New("response already sent: events must be sent before the response")
+	}
+	select {
+	case c.events <- event:
+		return nil
+	default:
+		return errors.New("event dropped: too many events waiting to be sent")
+	}
+}
+
+// Wait passes the events sent before the response to onEvent, as they are
+// sent, and returns the response.
+func (c *StreamingCallback) Wait(ctx context.Context, onEvent func(handlers.UserCallbackEvent)) (handlers.UserCallbackPayload, error) {
+	if !c.waitCalled.CompareAndSwap(false, true) {
+		return handlers.UserCallbackPayload{}, errors.New("Wait can only be called once per Callback instance")
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return handlers.UserCallbackPayload{}, ctx.Err()
+		case event := <-c.events:
+			onEvent(event)
+		case r := <-c.ch:
+			// pass on the events which were sent before the response, but
+			// not received yet
+			for {
+				select {
+				case event := <-c.events:
+					onEvent(event)
+				default:
+					return r, nil
+				}
+			}
+		}
+	}
+}
+
+// NewStreamingCallback returns a StreamingCallback which holds up to
+// maxPendingEvents events not passed on yet.
+func NewStreamingCallback(maxPendingEvents int) *StreamingCallback {
+	return &StreamingCallback{
+		Callback: NewCallback(),
+		events:   make(chan handlers.UserCallbackEvent, maxPendingEvents),
+	}
+}
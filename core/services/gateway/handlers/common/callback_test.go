@@ -27,3 +27,43 @@ func Test_Callback(t *testing.T) {
 	_, err = cb.Wait(t.Context())
 	require.ErrorContains(t, err, "Wait can only be called once per Callback instance")
 }
+
+func Test_StreamingCallback(t *testing.T) {
+	cb := NewStreamingCallback(2)
+	payload := handlers.UserCallbackPayload{RawResponse: []byte("test")}
+	event := handlers.UserCallbackEvent{Type: "progress", Data: []byte(`{}`)}
+
+	require.NoError(t, cb.SendEvent(event))
+	require.NoError(t, cb.SendEvent(event))
+	require.ErrorContains(t, cb.SendEvent(event), "event dropped")
+
+	require.NoError(t, cb.SendResponse(payload))
+	require.ErrorContains(t, cb.SendEvent(event), "response already sent")
+
+	var events []handlers.UserCallbackEvent
+	resp, err := cb.Wait(t.Context(), func(e handlers.UserCallbackEvent) {
+		events = append(events, e)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, payload, resp)
+	assert.Equal(t, []handlers.UserCallbackEvent{event, event}, events)
+
+	_, err = cb.Wait(t.Context(), func(handlers.UserCallbackEvent) {})
+	require.ErrorContains(t, err, "Wait can only be called once per Callback instance")
+}
+
+func Test_SendEvent(t *testing.T) {
+	require.NoError(t, handlers.SendEvent(NewCallback(), "progress", map[string]string{"step": "1"}))
+
+	cb := NewStreamingCallback(1)
+	require.NoError(t, handlers.SendEvent(cb, "progress", map[string]string{"step": "1"}))
+	require.NoError(t, cb.SendResponse(handlers.UserCallbackPayload{}))
+	var events []handlers.UserCallbackEvent
+	_, err := cb.Wait(t.Context(), func(e handlers.UserCallbackEvent) {
+		events = append(events, e)
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "progress", events[0].Type)
+	assert.JSONEq(t, `{"step":"1"}`, string(events[0].Data))
+}
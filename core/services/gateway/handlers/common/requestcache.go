@@ -18,6 +18,8 @@ import (
 type RequestCache[T any] interface {
 	NewRequest(lggr logger.Logger, request *api.Message, callback handlers.Callback, responseData *T) error
 	ProcessResponse(response *api.Message, process ResponseProcessor[T]) error
+	// Len returns the number of pending requests.
+	Len() int
 }
 
 // If aggregated != nil then the aggregated response is ready and the entry will be deleted from RequestCache.
@@ -108,6 +110,12 @@ func (c *requestCache[T]) ProcessResponse(response *api.Message, process Respons
 	return nil
 }
 
+func (c *requestCache[T]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.cache)
+}
+
 func (c *requestCache[T]) deleteAndSendOnce(key globalId, callbackResponse handlers.UserCallbackPayload) error {
 	c.mu.Lock()
 	entry, deleted := c.cache[key]
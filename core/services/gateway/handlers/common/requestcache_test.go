@@ -138,4 +138,5 @@ func TestRequestCache_MaxSize(t *testing.T) {
 
 	req.Body.MessageId = "cc"
 	require.Error(t, cache.NewRequest(lggr, req, callback, initialState))
+	require.Equal(t, 2, cache.Len())
 }
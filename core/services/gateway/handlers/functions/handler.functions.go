@@ -6,7 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -79,14 +82,15 @@ type functionsHandler struct {
 	services.StateMachine
 
 	handlerConfig              FunctionsHandlerConfig
-	donConfig                  *config.DONConfig
+	handlerConfigMu            sync.Mutex
+	donConfig                  atomic.Pointer[config.DONConfig]
 	don                        handlers.DON
 	pendingRequests            hc.RequestCache[PendingRequest]
 	allowlist                  fallow.OnchainAllowlist
 	subscriptions              fsub.OnchainSubscriptions
 	minimumBalance             *assets.Link
-	userRateLimiter            *ratelimit.RateLimiter
-	nodeRateLimiter            *ratelimit.RateLimiter
+	userRateLimiter            atomic.Pointer[ratelimit.RateLimiter]
+	nodeRateLimiter            atomic.Pointer[ratelimit.RateLimiter]
 	allowedHeartbeatInitiators map[string]struct{}
 	chStop                     services.StopChan
 	lggr                       logger.Logger
@@ -100,6 +104,8 @@ type PendingRequest struct {
 }
 
 var _ handlers.Handler = (*functionsHandler)(nil)
+var _ handlers.ConfigUpdater = (*functionsHandler)(nil)
+var _ handlers.StatusReporter = (*functionsHandler)(nil)
 
 func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, legacyChains legacyevm.LegacyChainContainer, ds sqlutil.DataSource, lggr logger.Logger) (handlers.Handler, error) {
 	var cfg FunctionsHandlerConfig
@@ -128,18 +134,9 @@ func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *con
 			return nil, err2
 		}
 	}
-	var userRateLimiter, nodeRateLimiter *ratelimit.RateLimiter
-	if cfg.UserRateLimiter != nil {
-		userRateLimiter, err = ratelimit.NewRateLimiter(*cfg.UserRateLimiter)
-		if err != nil {
-			return nil, err
-		}
-	}
-	if cfg.NodeRateLimiter != nil {
-		nodeRateLimiter, err = ratelimit.NewRateLimiter(*cfg.NodeRateLimiter)
-		if err != nil {
-			return nil, err
-		}
+	userRateLimiter, nodeRateLimiter, err := newRateLimiters(cfg)
+	if err != nil {
+		return nil, err
 	}
 	var subscriptions fsub.OnchainSubscriptions
 	if cfg.OnchainSubscriptions != nil {
@@ -182,20 +179,67 @@ func NewFunctionsHandler(
 	nodeRateLimiter *ratelimit.RateLimiter,
 	allowedHeartbeatInitiators map[string]struct{},
 	lggr logger.Logger) handlers.Handler {
-	return &functionsHandler{
+	h := &functionsHandler{
 		handlerConfig:              cfg,
-		donConfig:                  donConfig,
 		don:                        don,
 		pendingRequests:            pendingRequestsCache,
 		allowlist:                  allowlist,
 		subscriptions:              subscriptions,
 		minimumBalance:             minimumBalance,
-		userRateLimiter:            userRateLimiter,
-		nodeRateLimiter:            nodeRateLimiter,
 		allowedHeartbeatInitiators: allowedHeartbeatInitiators,
 		chStop:                     make(services.StopChan),
 		lggr:                       lggr,
 	}
+	h.donConfig.Store(donConfig)
+	h.userRateLimiter.Store(userRateLimiter)
+	h.nodeRateLimiter.Store(nodeRateLimiter)
+	return h
+}
+
+func newRateLimiters(cfg FunctionsHandlerConfig) (userRateLimiter, nodeRateLimiter *ratelimit.RateLimiter, err error) {
+	if cfg.UserRateLimiter != nil {
+		userRateLimiter, err = ratelimit.NewRateLimiter(*cfg.UserRateLimiter)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if cfg.NodeRateLimiter != nil {
+		nodeRateLimiter, err = ratelimit.NewRateLimiter(*cfg.NodeRateLimiter)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return userRateLimiter, nodeRateLimiter, nil
+}
+
+// UpdateConfig applies changes to the DON and the rate limiters. Requests
+// which are already pending are aggregated with the new DON config.
+func (h *functionsHandler) UpdateConfig(handlerConfig json.RawMessage, donConfig *config.DONConfig) error {
+	var cfg FunctionsHandlerConfig
+	if err := json.Unmarshal(handlerConfig, &cfg); err != nil {
+		return err
+	}
+	h.handlerConfigMu.Lock()
+	defer h.handlerConfigMu.Unlock()
+	current, updated := h.handlerConfig, cfg
+	current.UserRateLimiter, current.NodeRateLimiter = nil, nil
+	updated.UserRateLimiter, updated.NodeRateLimiter = nil, nil
+	if !reflect.DeepEqual(current, updated) {
+		return fmt.Errorf("only rate limiters can be updated: %w", handlers.ErrRestartRequired)
+	}
+	userRateLimiter, nodeRateLimiter, err := newRateLimiters(cfg)
+	if err != nil {
+		return err
+	}
+	h.handlerConfig = cfg
+	h.donConfig.Store(donConfig)
+	h.userRateLimiter.Store(userRateLimiter)
+	h.nodeRateLimiter.Store(nodeRateLimiter)
+	return nil
+}
+
+func (h *functionsHandler) Status() handlers.Status {
+	return handlers.Status{PendingRequests: h.pendingRequests.Len()}
 }
 
 func (h *functionsHandler) Methods() []string {
@@ -210,12 +254,12 @@ func (h *functionsHandler) HandleLegacyUserMessage(ctx context.Context, msg *api
 	sender := common.HexToAddress(msg.Body.Sender)
 	if h.allowlist != nil && !h.allowlist.Allow(sender) {
 		h.lggr.Debugw("received a message from a non-allowlisted address", "sender", msg.Body.Sender)
-		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
+		promHandlerError.WithLabelValues(h.donConfig.Load().DonId, ErrNotAllowlisted.Error()).Inc()
 		return ErrNotAllowlisted
 	}
-	if h.userRateLimiter != nil && !h.userRateLimiter.Allow(msg.Body.Sender) {
+	if userRateLimiter := h.userRateLimiter.Load(); userRateLimiter != nil && !userRateLimiter.Allow(msg.Body.Sender) {
 		h.lggr.Debugw("rate-limited", "sender", msg.Body.Sender)
-		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrRateLimited.Error()).Inc()
+		promHandlerError.WithLabelValues(h.donConfig.Load().DonId, ErrRateLimited.Error()).Inc()
 		return ErrRateLimited
 	}
 	if msg.Body.Method == MethodSecretsSet && h.subscriptions != nil && h.minimumBalance != nil {
@@ -237,13 +281,13 @@ func (h *functionsHandler) HandleLegacyUserMessage(ctx context.Context, msg *api
 	case MethodHeartbeat:
 		if _, ok := h.allowedHeartbeatInitiators[msg.Body.Sender]; !ok {
 			h.lggr.Debugw("received heartbeat request from a non-allowed sender", "sender", msg.Body.Sender)
-			promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
+			promHandlerError.WithLabelValues(h.donConfig.Load().DonId, ErrNotAllowlisted.Error()).Inc()
 			return ErrUnsupportedMethod
 		}
 		return h.handleRequest(ctx, msg, callback)
 	default:
 		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
-		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrUnsupportedMethod.Error()).Inc()
+		promHandlerError.WithLabelValues(h.donConfig.Load().DonId, ErrUnsupportedMethod.Error()).Inc()
 		return ErrUnsupportedMethod
 	}
 }
@@ -253,17 +297,17 @@ func (h *functionsHandler) handleRequest(ctx context.Context, msg *api.Message,
 	err := h.pendingRequests.NewRequest(h.lggr, msg, callback, &PendingRequest{request: msg, responses: make(map[string]*api.Message)})
 	if err != nil {
 		h.lggr.Warnw("handleRequest: error adding new request", "sender", msg.Body.Sender, "err", err)
-		promHandlerError.WithLabelValues(h.donConfig.DonId, err.Error()).Inc()
+		promHandlerError.WithLabelValues(h.donConfig.Load().DonId, err.Error()).Inc()
 		return err
 	}
 	req, err := hc.ValidatedRequestFromMessage(msg)
 	if err != nil {
 		h.lggr.Debugw("handleRequest: failed to validate message", "sender", msg.Body.Sender, "err", err)
-		promHandlerError.WithLabelValues(h.donConfig.DonId, err.Error()).Inc()
+		promHandlerError.WithLabelValues(h.donConfig.Load().DonId, err.Error()).Inc()
 		return err
 	}
 	// Send to all nodes.
-	for _, member := range h.donConfig.Members {
+	for _, member := range h.donConfig.Load().Members {
 		err := h.don.SendToNode(ctx, member.Address, req)
 		if err != nil {
 			h.lggr.Debugw("handleRequest: failed to send to a node", "node", member.Address, "err", err)
@@ -282,7 +326,7 @@ func (h *functionsHandler) HandleNodeMessage(ctx context.Context, resp *jsonrpc.
 		return errors.New("message sender mismatch when reading from node ")
 	}
 	h.lggr.Debugw("HandleNodeMessage: processing message", "nodeAddr", nodeAddr, "receiver", msg.Body.Receiver, "id", msg.Body.MessageId)
-	if h.nodeRateLimiter != nil && !h.nodeRateLimiter.Allow(nodeAddr) {
+	if nodeRateLimiter := h.nodeRateLimiter.Load(); nodeRateLimiter != nil && !nodeRateLimiter.Allow(nodeAddr) {
 		h.lggr.Debugw("rate-limited", "sender", nodeAddr)
 		return errors.New("rate-limited")
 	}
@@ -313,16 +357,17 @@ func (h *functionsHandler) processSecretsResponse(response *api.Message, respons
 		return nil, responseData, err
 	}
 	// user response is ready with either F+1 successes or N-F failures
+	donConfig := h.donConfig.Load()
 	if responsePayload.Success {
 		responseData.successful = append(responseData.successful, response)
-		if len(responseData.successful) >= h.donConfig.F+1 {
+		if len(responseData.successful) >= donConfig.F+1 {
 			// return success to the user
 			callbackPayload, err := newSecretsResponse(responseData.request, true, responseData.successful)
 			return callbackPayload, responseData, err
 		}
 	} else {
 		responseData.errors = append(responseData.errors, response)
-		if len(responseData.errors) >= len(h.donConfig.Members)-h.donConfig.F {
+		if len(responseData.errors) >= len(donConfig.Members)-donConfig.F {
 			// return error to the user
 			callbackPayload, err := newSecretsResponse(responseData.request, false, responseData.errors)
 			return callbackPayload, responseData, err
@@ -372,7 +417,7 @@ func (h *functionsHandler) processHeartbeatResponse(response *api.Message, respo
 	responseData.responses[response.Body.Sender] = response
 
 	// user response is ready with F+1 node responses
-	if len(responseData.responses) >= h.donConfig.F+1 {
+	if len(responseData.responses) >= h.donConfig.Load().F+1 {
 		var responseList []*api.Message
 		for _, response := range responseData.responses {
 			responseList = append(responseList, response)
@@ -243,3 +243,46 @@ func TestFunctionsHandler_HandleUserMessage_Timeout(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, userRequestMsg.Body.MessageId, msg.Body.MessageId)
 }
+
+func TestFunctionsHandler_UpdateConfig(t *testing.T) {
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, don, allowlist, subscriptions := newFunctionsHandlerForATestDON(t, nodes, time.Hour*24, user.Address)
+	userRequestMsg := newSignedMessage(t, "1234", "secrets_set", "don_id", user.PrivateKey)
+	cb := hc.NewCallback()
+	allowlist.On("Allow", common.HexToAddress(user.Address)).Return(true, nil)
+	subscriptions.On("GetMaxUserBalance", common.HexToAddress(user.Address)).Return(big.NewInt(1000), nil)
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	require.NoError(t, handler.HandleLegacyUserMessage(testutils.Context(t), &userRequestMsg, cb))
+
+	donConfig := &config.DONConfig{F: 2}
+	for id, n := range nodes {
+		donConfig.Members = append(donConfig.Members, config.NodeConfig{Name: fmt.Sprintf("node_%d", id), Address: n.Address})
+	}
+	updater, ok := handler.(handlers.ConfigUpdater)
+	require.True(t, ok)
+	err := updater.UpdateConfig(json.RawMessage(`{"chainId":"1"}`), donConfig)
+	require.ErrorIs(t, err, handlers.ErrRestartRequired)
+	require.NoError(t, updater.UpdateConfig(json.RawMessage(`{"userRateLimiter":{"globalRPS":100,"globalBurst":100,"perSenderRPS":1,"perSenderBurst":1}}`), donConfig))
+
+	// The pending request is answered once F+1 nodes of the new config agree.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sendNodeReponse(t, handler, userRequestMsg, nodes, []bool{true, true, true, false})
+	}()
+	response, err := cb.Wait(t.Context())
+	require.NoError(t, err)
+	<-done
+	codec := api.JsonRPCCodec{}
+	msg, err := codec.DecodeLegacyResponse(response.RawResponse)
+	require.NoError(t, err)
+	var payload functions.CombinedResponse
+	require.NoError(t, json.Unmarshal(msg.Body.Payload, &payload))
+	require.True(t, payload.Success)
+	require.Len(t, payload.NodeResponses, 3)
+
+	userRequestMsg = newSignedMessage(t, "5678", "secrets_set", "don_id", user.PrivateKey)
+	require.NoError(t, handler.HandleLegacyUserMessage(testutils.Context(t), &userRequestMsg, hc.NewCallback()))
+	userRequestMsg = newSignedMessage(t, "9012", "secrets_set", "don_id", user.PrivateKey)
+	require.ErrorIs(t, handler.HandleLegacyUserMessage(testutils.Context(t), &userRequestMsg, hc.NewCallback()), functions.ErrRateLimited)
+}
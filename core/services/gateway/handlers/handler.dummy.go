@@ -29,6 +29,7 @@ type savedCallback struct {
 }
 
 var _ Handler = (*dummyHandler)(nil)
+var _ ConfigUpdater = (*dummyHandler)(nil)
 
 func NewDummyHandler(donConfig *config.DONConfig, don DON, lggr logger.Logger) (Handler, error) {
 	return &dummyHandler{
@@ -51,6 +52,7 @@ func (d *dummyHandler) HandleLegacyUserMessage(ctx context.Context, msg *api.Mes
 	d.mu.Lock()
 	d.savedCallbacks[msg.Body.MessageId] = &savedCallback{msg.Body.MessageId, callback}
 	don := d.don
+	members := d.donConfig.Members
 	d.mu.Unlock()
 	params, err := json.Marshal(msg)
 	if err != nil {
@@ -63,7 +65,7 @@ func (d *dummyHandler) HandleLegacyUserMessage(ctx context.Context, msg *api.Mes
 		Method:  msg.Body.Method,
 		Params:  &rawParams,
 	}
-	for _, member := range d.donConfig.Members {
+	for _, member := range members {
 		err = errors.Join(err, don.SendToNode(ctx, member.Address, req))
 	}
 	return err
@@ -96,6 +98,15 @@ func (d *dummyHandler) HandleNodeMessage(ctx context.Context, resp *jsonrpc.Resp
 	return nil
 }
 
+// UpdateConfig only takes the new DON config, the dummy handler has no config
+// of its own.
+func (d *dummyHandler) UpdateConfig(_ json.RawMessage, donConfig *config.DONConfig) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.donConfig = donConfig
+	return nil
+}
+
 func (d *dummyHandler) Start(context.Context) error {
 	return nil
 }
@@ -83,3 +83,54 @@ func TestDummyHandler_BasicFlow(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "1234", responseMsg.Body.MessageId)
 }
+
+func TestDummyHandler_UpdateConfig(t *testing.T) {
+	t.Parallel()
+
+	donConfig := config.DONConfig{
+		Members: []config.NodeConfig{
+			{Name: "node one", Address: "addr_1"},
+		},
+	}
+
+	connMgr := testConnManager{}
+	handler, err := handlers.NewDummyHandler(&donConfig, &connMgr, logger.Test(t))
+	require.NoError(t, err)
+	ctx := testutils.Context(t)
+
+	msg := api.Message{
+		Body: api.MessageBody{
+			MessageId: "1234",
+			Method:    "testMethod",
+			DonId:     "test_don",
+		},
+	}
+	key, err := crypto.HexToECDSA(privateKey)
+	require.NoError(t, err)
+	require.NoError(t, msg.Sign(key))
+	cb := hc.NewCallback()
+	require.NoError(t, handler.HandleLegacyUserMessage(ctx, &msg, cb))
+	require.Equal(t, 1, connMgr.sendCounter)
+
+	updater, ok := handler.(handlers.ConfigUpdater)
+	require.True(t, ok)
+	require.NoError(t, updater.UpdateConfig(nil, &config.DONConfig{
+		Members: []config.NodeConfig{
+			{Name: "node one", Address: "addr_1"},
+			{Name: "node two", Address: "addr_2"},
+			{Name: "node three", Address: "addr_3"},
+		},
+	}))
+
+	// The request sent before the update still gets its response.
+	resp, err := hc.ValidatedResponseFromMessage(&msg)
+	require.NoError(t, err)
+	require.NoError(t, handler.HandleNodeMessage(ctx, resp, msg.Body.Sender))
+	_, err = cb.Wait(t.Context())
+	require.NoError(t, err)
+
+	msg.Body.MessageId = "5678"
+	require.NoError(t, msg.Sign(key))
+	require.NoError(t, handler.HandleLegacyUserMessage(ctx, &msg, hc.NewCallback()))
+	require.Equal(t, 4, connMgr.sendCounter, "new requests go to the new members")
+}
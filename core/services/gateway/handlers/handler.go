@@ -3,10 +3,12 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	jsonrpc "github.com/smartcontractkit/chainlink-common/pkg/jsonrpc2"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
@@ -21,6 +23,35 @@ type Callback interface {
 	SendResponse(cb UserCallbackPayload) error
 }
 
+// UserCallbackEvent is an update on a user request, e.g. on its progress, sent
+// to users who stream their request before the response.
+type UserCallbackEvent struct {
+	Type string
+	Data json.RawMessage
+}
+
+// StreamingCallback is the Callback of a user who streams their request.
+type StreamingCallback interface {
+	Callback
+	// SendEvent sends an event to the user. It does not block, and fails once
+	// the response has been sent.
+	SendEvent(event UserCallbackEvent) error
+}
+
+// SendEvent sends an event of the given type, with data encoded as JSON, if
+// the user of callback streams their request. Otherwise, it does nothing.
+func SendEvent(callback Callback, eventType string, data any) error {
+	streamingCallback, ok := callback.(StreamingCallback)
+	if !ok {
+		return nil
+	}
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return streamingCallback.SendEvent(UserCallbackEvent{Type: eventType, Data: rawData})
+}
+
 // Handler implements service-specific logic for managing messages from users and nodes.
 // There is one Handler object created for each DON.
 //
@@ -52,8 +83,44 @@ type Handler interface {
 	Methods() []string
 }
 
+// ErrRestartRequired is returned for config changes which can only be applied
+// by recreating the Handler, or the whole gateway.
+var ErrRestartRequired = errors.New("config change requires a restart")
+
+// ConfigUpdater is implemented by Handlers which can apply a new config while
+// they run, without dropping the requests they are processing.
+type ConfigUpdater interface {
+	// UpdateConfig replaces the handler config and the DON config the Handler
+	// was created with, e.g. after nodes are added to or removed from the DON.
+	// Changes it can not apply at runtime are rejected with an error wrapping
+	// ErrRestartRequired.
+	UpdateConfig(handlerConfig json.RawMessage, donConfig *config.DONConfig) error
+}
+
 // Representation of a DON from a Handler's perspective.
 type DON interface {
 	// Thread-safe
 	SendToNode(ctx context.Context, nodeAddress string, req *jsonrpc.Request[json.RawMessage]) error
 }
+
+// Status is a snapshot of the state of a Handler, which admins inspect to
+// troubleshoot the gateway.
+type Status struct {
+	// PendingRequests is the number of user requests awaiting the responses
+	// of nodes.
+	PendingRequests int
+	// ResponseCache is nil for Handlers without a cache of responses.
+	ResponseCache *CacheStats
+}
+
+// CacheStats describes the usage of a cache since the Handler was created.
+type CacheStats struct {
+	Entries int
+	Hits    uint64
+	Misses  uint64
+}
+
+// StatusReporter is implemented by Handlers which report their Status.
+type StatusReporter interface {
+	Status() Status
+}
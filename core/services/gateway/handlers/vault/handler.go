@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -38,12 +39,14 @@ import (
 const (
 	defaultCleanUpPeriod                    = 5 * time.Second
 	defaultPublicKeyGetCacheDurationSeconds = 300
+	defaultRequestTimeoutSec                = 30
 )
 
 var (
-	_                                 gwhandlers.Handler = (*handler)(nil)
-	errInsufficientResponsesForQuorum                    = errors.New("insufficient valid responses to reach quorum")
-	errQuorumUnobtainable                                = errors.New("quorum unobtainable")
+	_                                 gwhandlers.Handler       = (*handler)(nil)
+	_                                 gwhandlers.ConfigUpdater = (*handler)(nil)
+	errInsufficientResponsesForQuorum                          = errors.New("insufficient valid responses to reach quorum")
+	errQuorumUnobtainable                                      = errors.New("quorum unobtainable")
 )
 
 type metrics struct {
@@ -129,7 +132,8 @@ type aggregator interface {
 type handler struct {
 	services.StateMachine
 	methodConfig      Config
-	donConfig         *config.DONConfig
+	methodConfigMu    sync.Mutex
+	donConfig         atomic.Pointer[config.DONConfig]
 	don               gwhandlers.DON
 	lggr              logger.Logger
 	codec             api.JsonRPCCodec
@@ -138,7 +142,7 @@ type handler struct {
 	requestAuthorizer vaultcap.RequestAuthorizer
 	*vaultcap.RequestValidator
 
-	nodeRateLimiter *ratelimit.RateLimiter
+	nodeRateLimiter atomic.Pointer[ratelimit.RateLimiter]
 	requestTimeout  time.Duration
 
 	activeRequests map[string]*activeRequest
@@ -178,7 +182,7 @@ func NewHandler(methodConfig json.RawMessage, donConfig *config.DONConfig, don g
 	}
 
 	if cfg.RequestTimeoutSec == 0 {
-		cfg.RequestTimeoutSec = 30
+		cfg.RequestTimeoutSec = defaultRequestTimeoutSec
 	}
 
 	nodeRateLimiter, err := ratelimit.NewRateLimiter(cfg.NodeRateLimiter)
@@ -196,13 +200,11 @@ func NewHandler(methodConfig json.RawMessage, donConfig *config.DONConfig, don g
 		return nil, fmt.Errorf("could not create request batch size limiter: %w", err)
 	}
 
-	return &handler{
+	h := &handler{
 		methodConfig:      cfg,
-		donConfig:         donConfig,
 		don:               don,
 		lggr:              logger.Named(lggr, "VaultHandler:"+donConfig.DonId),
 		requestTimeout:    time.Duration(cfg.RequestTimeoutSec) * time.Second,
-		nodeRateLimiter:   nodeRateLimiter,
 		activeRequests:    make(map[string]*activeRequest),
 		mu:                sync.RWMutex{},
 		requestAuthorizer: requestAuthorizer,
@@ -211,7 +213,35 @@ func NewHandler(methodConfig json.RawMessage, donConfig *config.DONConfig, don g
 		aggregator:        &baseAggregator{capabilitiesRegistry: capabilitiesRegistry},
 		clock:             clock,
 		RequestValidator:  vaultcap.NewRequestValidator(limiter),
-	}, nil
+	}
+	h.donConfig.Store(donConfig)
+	h.nodeRateLimiter.Store(nodeRateLimiter)
+	return h, nil
+}
+
+// UpdateConfig applies a new node rate limiter and DON config. Active requests
+// keep the responses they have, and the next ones are sent to the new members.
+func (h *handler) UpdateConfig(methodConfig json.RawMessage, donConfig *config.DONConfig) error {
+	var cfg Config
+	if err := json.Unmarshal(methodConfig, &cfg); err != nil {
+		return fmt.Errorf("failed to unmarshal method config: %w", err)
+	}
+	if cfg.RequestTimeoutSec == 0 {
+		cfg.RequestTimeoutSec = defaultRequestTimeoutSec
+	}
+	h.methodConfigMu.Lock()
+	defer h.methodConfigMu.Unlock()
+	if cfg.RequestTimeoutSec != h.methodConfig.RequestTimeoutSec {
+		return fmt.Errorf("request timeout can not be updated: %w", gwhandlers.ErrRestartRequired)
+	}
+	nodeRateLimiter, err := ratelimit.NewRateLimiter(cfg.NodeRateLimiter)
+	if err != nil {
+		return fmt.Errorf("failed to create node rate limiter: %w", err)
+	}
+	h.methodConfig = cfg
+	h.donConfig.Store(donConfig)
+	h.nodeRateLimiter.Store(nodeRateLimiter)
+	return nil
 }
 
 func (h *handler) Start(_ context.Context) error {
@@ -399,7 +429,7 @@ func (h *handler) HandleNodeMessage(ctx context.Context, resp *jsonrpc.Response[
 	l := logger.With(h.lggr, "method", resp.Method, "requestID", resp.ID, "nodeAddr", nodeAddr)
 	l.Debugw("handling node response")
 
-	if !h.nodeRateLimiter.Allow(nodeAddr) {
+	if !h.nodeRateLimiter.Load().Allow(nodeAddr) {
 		l.Debugw("node is rate limited", "nodeAddr", nodeAddr)
 		return nil
 	}
@@ -410,7 +440,7 @@ func (h *handler) HandleNodeMessage(ctx context.Context, resp *jsonrpc.Response[
 		// This might happen if the response is stale
 		l.Errorw("no pending request found for ID")
 		h.metrics.requestInternalError.Add(ctx, 1, metric.WithAttributes(
-			attribute.String("don_id", h.donConfig.DonId),
+			attribute.String("don_id", h.donConfig.Load().DonId),
 			attribute.String("error", api.StaleNodeResponseError.String()),
 		))
 		return nil
@@ -680,7 +710,8 @@ func (h *handler) handlePublicKeyGet(ctx context.Context, ar *activeRequest) err
 
 func (h *handler) fanOutToVaultNodes(ctx context.Context, l logger.Logger, ar *activeRequest) error {
 	var nodeErrors []error
-	for _, node := range h.donConfig.Members {
+	members := h.donConfig.Load().Members
+	for _, node := range members {
 		err := h.don.SendToNode(ctx, node.Address, &ar.req)
 		if err != nil {
 			nodeErrors = append(nodeErrors, err)
@@ -688,7 +719,7 @@ func (h *handler) fanOutToVaultNodes(ctx context.Context, l logger.Logger, ar *a
 		}
 	}
 
-	if len(nodeErrors) == len(h.donConfig.Members) && len(nodeErrors) > 0 {
+	if len(nodeErrors) == len(members) && len(nodeErrors) > 0 {
 		return h.sendResponse(ctx, ar, h.errorResponse(ar.req, api.FatalError, errors.New("failed to forward user request to nodes"), nil))
 	}
 
@@ -751,7 +782,7 @@ func (h *handler) sendResponse(ctx context.Context, userRequest *activeRequest,
 	case api.RequestTimeoutError:
 	case api.HandlerError:
 		h.metrics.requestInternalError.Add(ctx, 1, metric.WithAttributes(
-			attribute.String("don_id", h.donConfig.DonId),
+			attribute.String("don_id", h.donConfig.Load().DonId),
 			attribute.String("error", resp.ErrorCode.String()),
 		))
 	case api.InvalidParamsError:
@@ -759,11 +790,11 @@ func (h *handler) sendResponse(ctx context.Context, userRequest *activeRequest,
 	case api.UserMessageParseError:
 	case api.UnsupportedDONIdError:
 		h.metrics.requestUserError.Add(ctx, 1, metric.WithAttributes(
-			attribute.String("don_id", h.donConfig.DonId),
+			attribute.String("don_id", h.donConfig.Load().DonId),
 		))
 	case api.NoError:
 		h.metrics.requestSuccess.Add(ctx, 1, metric.WithAttributes(
-			attribute.String("don_id", h.donConfig.DonId),
+			attribute.String("don_id", h.donConfig.Load().DonId),
 		))
 	}
 
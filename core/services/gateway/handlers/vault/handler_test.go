@@ -809,3 +809,32 @@ func TestVaultHandler_PublicKeyGet(t *testing.T) {
 	assert.Equal(t, jsonRequest.ID, publicKeyResponse.ID, "request ID should match")
 	assert.Equal(t, publicKey, publicKeyResponse.Result.PublicKey, "public key should match")
 }
+
+func TestVaultHandler_UpdateConfig(t *testing.T) {
+	hdlr, _, _, _ := setupHandler(t)
+	h := hdlr.(*handler)
+
+	nodeTwo := config.NodeConfig{Name: "node2", Address: "0x5678"}
+	donConfig := &config.DONConfig{
+		DonId:   "test_don_id",
+		Members: []config.NodeConfig{NodeOne, nodeTwo},
+	}
+	methodConfig, err := json.Marshal(Config{
+		RequestTimeoutSec: 30,
+		NodeRateLimiter: ratelimit.RateLimiterConfig{
+			GlobalRPS:      200,
+			GlobalBurst:    200,
+			PerSenderRPS:   20,
+			PerSenderBurst: 20,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, h.UpdateConfig(methodConfig, donConfig))
+	assert.Same(t, donConfig, h.donConfig.Load())
+	assert.Equal(t, 200.0, h.methodConfig.NodeRateLimiter.GlobalRPS)
+
+	methodConfig, err = json.Marshal(Config{RequestTimeoutSec: 60})
+	require.NoError(t, err)
+	require.ErrorIs(t, h.UpdateConfig(methodConfig, donConfig), handlers.ErrRestartRequired)
+	assert.Equal(t, 200.0, h.methodConfig.NodeRateLimiter.GlobalRPS, "rejected update is not applied")
+}
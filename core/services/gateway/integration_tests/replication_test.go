@@ -0,0 +1,133 @@
+package integration_tests
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/settings/limits"
+	"github.com/smartcontractkit/freeport"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/state"
+)
+
+const replicaConfigTemplate = `
+[ReplicaConfig]
+ReplicaId = "%s"
+AdvertiseURL = "http://localhost:%d/forward"
+ForwardingSecret = "0123456789abcdef0123456789abcdef"
+
+[ReplicaConfig.ForwardServerConfig]
+Port = %d
+`
+
+func TestIntegration_Gateway_Replicas_ForwardMessages(t *testing.T) {
+	t.Parallel()
+
+	testWallets := common.NewTestNodes(t, 2)
+	nodeKeys := testWallets[0]
+	userKeys := testWallets[1]
+
+	lggr := logger.Test(t)
+	lf := limits.Factory{Logger: lggr}
+	c, err := network.NewHTTPClient(network.HTTPClientConfig{
+		DefaultTimeout:   5 * time.Second,
+		MaxResponseBytes: 1000,
+	}, lggr)
+	require.NoError(t, err)
+	store := state.NewMemoryStore(clockwork.NewRealClock())
+	newReplica := func(id string) gateway.Gateway {
+		port := freeport.GetOne(t)
+		cfg := fmt.Sprintf(gatewayConfigTemplate, nodeKeys.Address) + fmt.Sprintf(replicaConfigTemplate, id, port, port)
+		gw, err := gateway.NewGatewayFromConfig(parseGatewayConfig(t, cfg), gateway.NewHandlerFactory(nil, nil, c, store, nil, nil, lggr, lf), store, lggr, lf)
+		require.NoError(t, err)
+		servicetest.Run(t, gw)
+		return gw
+	}
+	replicaA := newReplica("a")
+	replicaB := newReplica("b")
+
+	// the node is only connected to replica B
+	nodeURL := fmt.Sprintf("ws://localhost:%d/node", replicaB.GetNodePort())
+	client := &client{privateKey: nodeKeys.PrivateKey}
+	connector, err := connector.NewGatewayConnector(parseConnectorConfig(t, nodeConfigTemplate, nodeKeys.Address, nodeURL), client, clockwork.NewRealClock(), lggr)
+	require.NoError(t, err)
+	require.NoError(t, connector.AddHandler(t.Context(), []string{"test"}, client))
+	client.connector = connector
+	servicetest.Run(t, connector)
+
+	// users of replica A reach the node through replica B
+	userURL := fmt.Sprintf("http://localhost:%d/user", replicaA.GetUserPort())
+	gomega.NewGomegaWithT(t).Eventually(func() bool {
+		req := newLegacyHTTPRequestObject(t, messageID1, userURL, userKeys.PrivateKey)
+		httpClient := &http.Client{}
+		resp, err := httpClient.Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return client.done.Load()
+	}, testutils.WaitTimeout(t), testutils.TestInterval).Should(gomega.Equal(true))
+
+	req := newLegacyHTTPRequestObject(t, messageID2, userURL, userKeys.PrivateKey)
+	httpClient := &http.Client{}
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	rawResp, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	codec := api.JsonRPCCodec{}
+	respMsg, err := codec.DecodeLegacyResponse(rawResp)
+	require.NoError(t, err)
+	require.NoError(t, respMsg.Validate())
+	require.Equal(t, strings.ToLower(nodeKeys.Address), respMsg.Body.Sender)
+	require.Equal(t, messageID2, respMsg.Body.MessageId)
+	require.JSONEq(t, nodeResponsePayload, string(respMsg.Body.Payload))
+}
+
+func TestIntegration_Gateway_Replicas_RejectRequestIDInUse(t *testing.T) {
+	t.Parallel()
+
+	testWallets := common.NewTestNodes(t, 2)
+	nodeKeys := testWallets[0]
+	userKeys := testWallets[1]
+
+	lggr := logger.Test(t)
+	lf := limits.Factory{Logger: lggr}
+	store := state.NewMemoryStore(clockwork.NewRealClock())
+	port := freeport.GetOne(t)
+	cfg := fmt.Sprintf(gatewayConfigTemplate, nodeKeys.Address) + fmt.Sprintf(replicaConfigTemplate, "a", port, port)
+	gw, err := gateway.NewGatewayFromConfig(parseGatewayConfig(t, cfg), gateway.NewHandlerFactory(nil, nil, nil, store, nil, nil, lggr, lf), store, lggr, lf)
+	require.NoError(t, err)
+	servicetest.Run(t, gw)
+
+	// replica B holds a request with the same ID
+	owner, err := store.Claim(t.Context(), "gateway/test_gateway/request/test_don/"+messageID1, "b", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "b", owner)
+
+	userURL := fmt.Sprintf("http://localhost:%d/user", gw.GetUserPort())
+	resp, err := http.DefaultClient.Do(newLegacyHTTPRequestObject(t, messageID1, userURL, userKeys.PrivateKey))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	rawResp, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NotEqual(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, string(rawResp), "in use by replica b")
+}
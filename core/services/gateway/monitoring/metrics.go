@@ -21,8 +21,21 @@ type GatewayMetrics struct {
 	nodeConnectedEvents    metric.Int64Counter
 	keepalivePingsSent     metric.Int64Counter
 	keepalivePongsReceived metric.Int64Counter
+
+	userRequests           metric.Int64Counter
+	userBytes              metric.Int64Counter
+	userConcurrentRequests metric.Int64UpDownCounter
 }
 
+// Results of user requests, as checked against the quota of the user.
+const (
+	UserRequestAdmitted        = "admitted"
+	UserRequestUnauthenticated = "unauthenticated"
+	UserRequestRateLimited     = "rate_limited"
+	UserRequestBytesExceeded   = "bytes_exceeded"
+	UserRequestTooManyInFlight = "too_many_in_flight"
+)
+
 type HTTPServerMetrics struct {
 	requestDuration metric.Int64Histogram
 	requestCount    metric.Int64Counter
@@ -80,6 +93,28 @@ func (m *GatewayMetrics) RecordKeepalivePongsReceived(ctx context.Context, nodeA
 	))
 }
 
+func (m *GatewayMetrics) RecordUserRequest(ctx context.Context, identity string, result string) {
+	m.userRequests.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("identity", identity),
+		attribute.String("result", result),
+	))
+}
+
+// RecordUserBytes records the size of a request from, or a response to, a user,
+// depending on direction ("in" or "out").
+func (m *GatewayMetrics) RecordUserBytes(ctx context.Context, identity string, direction string, bytes int) {
+	m.userBytes.Add(ctx, int64(bytes), metric.WithAttributes(
+		attribute.String("identity", identity),
+		attribute.String("direction", direction),
+	))
+}
+
+func (m *GatewayMetrics) RecordUserConcurrentRequests(ctx context.Context, identity string, delta int64) {
+	m.userConcurrentRequests.Add(ctx, delta, metric.WithAttributes(
+		attribute.String("identity", identity),
+	))
+}
+
 func NewGatewayMetrics() (*GatewayMetrics, error) {
 	nodeMsgHandleDuration, err := beholder.GetMeter().Int64Histogram("platform_gateway_node_msg_handler_duration_ms")
 	if err != nil {
@@ -116,6 +151,21 @@ func NewGatewayMetrics() (*GatewayMetrics, error) {
 		return nil, err
 	}
 
+	userRequests, err := beholder.GetMeter().Int64Counter("platform_gateway_user_requests_total")
+	if err != nil {
+		return nil, err
+	}
+
+	userBytes, err := beholder.GetMeter().Int64Counter("platform_gateway_user_bytes_total")
+	if err != nil {
+		return nil, err
+	}
+
+	userConcurrentRequests, err := beholder.GetMeter().Int64UpDownCounter("platform_gateway_user_concurrent_requests")
+	if err != nil {
+		return nil, err
+	}
+
 	return &GatewayMetrics{
 		nodeMsgHandleDuration:  nodeMsgHandleDuration,
 		nodeMsgHandleCount:     nodeMsgHandleCount,
@@ -124,6 +174,9 @@ func NewGatewayMetrics() (*GatewayMetrics, error) {
 		nodeConnectedEvents:    nodeConnectedEvents,
 		keepalivePingsSent:     keepalivePingsSent,
 		keepalivePongsReceived: keepalivePongsReceived,
+		userRequests:           userRequests,
+		userBytes:              userBytes,
+		userConcurrentRequests: userConcurrentRequests,
 	}, nil
 }
 
@@ -1,12 +1,15 @@
 package gateway
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"maps"
+	"reflect"
 	"slices"
+	"sync"
 
 	jsonrpc "github.com/smartcontractkit/chainlink-common/pkg/jsonrpc2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
@@ -15,12 +18,18 @@ import (
 )
 
 type multiHandler struct {
+	handlerFactory HandlerFactory
+	connMgr        *donConnectionManager
+
+	mu              sync.RWMutex
+	configs         map[string]json.RawMessage
+	donConfig       *config.DONConfig
 	typeToHandler   map[string]handlers.Handler
 	methodToHandler map[string]handlers.Handler
 }
 
-func NewMultiHandler(handlerFactory HandlerFactory, hdlrs []config.Handler, donConfig *config.DONConfig, connMgr *donConnectionManager) (handlers.Handler, error) {
-	methodToHandler := map[string]handlers.Handler{}
+func NewMultiHandler(handlerFactory HandlerFactory, hdlrs []config.Handler, donConfig *config.DONConfig, connMgr *donConnectionManager) (*multiHandler, error) {
+	configs := map[string]json.RawMessage{}
 	typeToHandler := map[string]handlers.Handler{}
 	for _, h := range hdlrs {
 		hdlr, err := handlerFactory.NewHandler(h.Name, h.Config, donConfig, connMgr)
@@ -28,8 +37,28 @@ func NewMultiHandler(handlerFactory HandlerFactory, hdlrs []config.Handler, donC
 			return nil, fmt.Errorf("failed to create handler %s: %w", h.Name, err)
 		}
 
+		configs[h.Name] = h.Config
 		typeToHandler[h.Name] = hdlr
+	}
+
+	methodToHandler, err := methodsToHandlers(typeToHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multiHandler{
+		handlerFactory:  handlerFactory,
+		connMgr:         connMgr,
+		configs:         configs,
+		donConfig:       donConfig,
+		methodToHandler: methodToHandler,
+		typeToHandler:   typeToHandler,
+	}, nil
+}
 
+func methodsToHandlers(typeToHandler map[string]handlers.Handler) (map[string]handlers.Handler, error) {
+	methodToHandler := map[string]handlers.Handler{}
+	for _, hdlr := range typeToHandler {
 		for _, method := range hdlr.Methods() {
 			if _, exists := methodToHandler[method]; exists {
 				return nil, fmt.Errorf("duplicate handler for method %s: methods must be globally unique across handlers", method)
@@ -38,14 +67,80 @@ func NewMultiHandler(handlerFactory HandlerFactory, hdlrs []config.Handler, donC
 			methodToHandler[method] = hdlr
 		}
 	}
+	return methodToHandler, nil
+}
 
-	return &multiHandler{
-		methodToHandler: methodToHandler,
-		typeToHandler:   typeToHandler,
-	}, nil
+// updateHandlers creates handlers which are new in hdlrs, closes those which
+// are no longer in it, and passes the new configs to the others, which keep
+// the requests they are processing. Handlers which do not implement
+// handlers.ConfigUpdater can only be updated by a restart.
+func (m *multiHandler) updateHandlers(ctx context.Context, hdlrs []config.Handler, donConfig *config.DONConfig) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	configs := map[string]json.RawMessage{}
+	for _, h := range hdlrs {
+		configs[h.Name] = h.Config
+	}
+	donChanged := !reflect.DeepEqual(m.donConfig, donConfig)
+	var updated []string
+	for name, hdlr := range m.typeToHandler {
+		cfg, ok := configs[name]
+		if !ok || (!donChanged && bytes.Equal(cfg, m.configs[name])) {
+			continue
+		}
+		if _, ok := hdlr.(handlers.ConfigUpdater); !ok {
+			return fmt.Errorf("handler %s can not update its config: %w", name, handlers.ErrRestartRequired)
+		}
+		updated = append(updated, name)
+	}
+
+	typeToHandler := map[string]handlers.Handler{}
+	var added []handlers.Handler
+	for _, h := range hdlrs {
+		if hdlr, ok := m.typeToHandler[h.Name]; ok {
+			typeToHandler[h.Name] = hdlr
+			continue
+		}
+		hdlr, err := m.handlerFactory.NewHandler(h.Name, h.Config, donConfig, m.connMgr)
+		if err != nil {
+			return fmt.Errorf("failed to create handler %s: %w", h.Name, err)
+		}
+		typeToHandler[h.Name] = hdlr
+		added = append(added, hdlr)
+	}
+	methodToHandler, err := methodsToHandlers(typeToHandler)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range updated {
+		if err := m.typeToHandler[name].(handlers.ConfigUpdater).UpdateConfig(configs[name], donConfig); err != nil {
+			return fmt.Errorf("failed to update handler %s: %w", name, err)
+		}
+	}
+	for _, hdlr := range added {
+		if err := hdlr.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start handler: %w", err)
+		}
+	}
+	var errs []error
+	for name, hdlr := range m.typeToHandler {
+		if _, ok := typeToHandler[name]; !ok {
+			errs = append(errs, hdlr.Close())
+		}
+	}
+
+	m.configs = configs
+	m.donConfig = donConfig
+	m.typeToHandler = typeToHandler
+	m.methodToHandler = methodToHandler
+	return errors.Join(errs...)
 }
 
 func (m *multiHandler) Methods() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return slices.Collect(maps.Keys(m.methodToHandler))
 }
 
@@ -77,6 +172,9 @@ func (m *multiHandler) HandleNodeMessage(ctx context.Context, resp *jsonrpc.Resp
 }
 
 func (m *multiHandler) getHandler(method string) (handlers.Handler, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
 	// If there's only one handler, return it directly.
 	// This preserves backwards compatibility for cases where the method
 	// isn't specified on responses (and for cases where only one handler is registered more generally).
@@ -95,6 +193,8 @@ func (m *multiHandler) getHandler(method string) (handlers.Handler, error) {
 }
 
 func (m *multiHandler) Start(ctx context.Context) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	for name, h := range m.typeToHandler {
 		if err := h.Start(ctx); err != nil {
 			return fmt.Errorf("failed to start handler %s: %w", name, err)
@@ -104,6 +204,8 @@ func (m *multiHandler) Start(ctx context.Context) error {
 }
 
 func (m *multiHandler) Close() error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	for name, h := range m.typeToHandler {
 		if e := h.Close(); e != nil {
 			return fmt.Errorf("failed to close handler %s: %w", name, e)
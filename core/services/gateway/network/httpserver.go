@@ -33,6 +33,18 @@ type HTTPRequestHandler interface {
 	ProcessRequest(ctx context.Context, rawMessage []byte, auth string) (rawResponse []byte, httpStatusCode int)
 }
 
+// StreamingHTTPRequestHandler is implemented by HTTPRequestHandlers which
+// can send events on a request before its response. It serves clients which
+// ask for Server-Sent Events, or open a WebSocket, on servers with streaming
+// enabled.
+type StreamingHTTPRequestHandler interface {
+	HTTPRequestHandler
+	// ProcessStreamingRequest is ProcessRequest, with events sent to stream
+	// while the request is processed. The response is sent to the client as
+	// the last event, of type ResultEventType.
+	ProcessStreamingRequest(ctx context.Context, rawMessage []byte, auth string, stream EventStream) (rawResponse []byte, httpStatusCode int)
+}
+
 type HTTPServerConfig struct {
 	Host                   string
 	Port                   uint16
@@ -48,6 +60,11 @@ type HTTPServerConfig struct {
 	MaxRequestBytesLimiter limits.BoundLimiter[config.Size] // supersedes MaxRequestBytes, if set
 	CORSEnabled            bool
 	CORSAllowedOrigins     []string
+	// Allows clients to stream requests, if the handler supports it.
+	StreamingEnabled bool
+	// Bounds how long a stream stays open, which defaults to
+	// RequestTimeoutMillis, or to 10 minutes if that is not set either.
+	MaxStreamDurationMillis uint32
 }
 
 func (c *HTTPServerConfig) ensureLimiters(lf limits.Factory) (err error) {
@@ -74,8 +91,27 @@ type httpServer struct {
 const (
 	HealthCheckPath     = "/health"
 	HealthCheckResponse = "OK"
+
+	// UserAuthHeader holds the credential of users authenticated by the
+	// gateway itself. It is separate from the Authorization header, which is
+	// passed on to handlers.
+	UserAuthHeader = "X-Gateway-Auth"
 )
 
+type userAuthKey struct{}
+
+// UserAuthFromContext returns the UserAuthHeader of the request processed
+// with ctx.
+func UserAuthFromContext(ctx context.Context) string {
+	userAuth, _ := ctx.Value(userAuthKey{}).(string)
+	return userAuth
+}
+
+// ContextWithUserAuth returns ctx with the UserAuthHeader of a request.
+func ContextWithUserAuth(ctx context.Context, userAuth string) context.Context {
+	return context.WithValue(ctx, userAuthKey{}, userAuth)
+}
+
 func NewHTTPServer(config *HTTPServerConfig, lggr logger.Logger, lf limits.Factory) (HTTPServer, error) {
 	if err := config.ensureLimiters(lf); err != nil {
 		return nil, fmt.Errorf("failed to create limiters: %w", err)
@@ -98,7 +134,7 @@ func NewHTTPServer(config *HTTPServerConfig, lggr logger.Logger, lf limits.Facto
 	if config.RequestTimeoutMillis > 0 {
 		handler = http.TimeoutHandler(handler, time.Duration(config.RequestTimeoutMillis)*time.Millisecond, "Request timed out")
 	}
-	mux.Handle(config.Path, handler)
+	mux.Handle(config.Path, server.withStreaming(handler))
 	mux.Handle(HealthCheckPath, http.HandlerFunc(server.handleHealthCheck))
 	server.server = &http.Server{
 		Addr:              fmt.Sprintf("%s:%d", config.Host, config.Port),
@@ -177,53 +213,70 @@ func (s *httpServer) isAllowedOrigin(origin string) bool {
 	return false
 }
 
-func (s *httpServer) handleRequest(w http.ResponseWriter, r *http.Request) {
-	if s.config.CORSEnabled {
-		origin := r.Header.Get("Origin")
-		if s.isAllowedOrigin(origin) {
-			w.Header().Set("Access-Control-Allow-Origin", origin)
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
-		}
+// handleCORS sets the CORS headers of the response, and returns true if the
+// request was a preflight request, which needs no further handling.
+func (s *httpServer) handleCORS(w http.ResponseWriter, r *http.Request) bool {
+	if !s.config.CORSEnabled {
+		return false
+	}
+	origin := r.Header.Get("Origin")
+	if s.isAllowedOrigin(origin) {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+	}
 
-		// handle preflight requests
-		if r.Method == http.MethodOptions {
-			w.WriteHeader(http.StatusNoContent)
-			return
-		}
+	// handle preflight requests
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return true
 	}
+	return false
+}
 
+// readRequest reads the body of a request, within the size limit.
+func (s *httpServer) readRequest(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
 	maxRequestBytes, err := s.config.MaxRequestBytesLimiter.Limit(r.Context())
 	if err != nil {
 		msg := "Failed to get request size limit"
 		s.lggr.Errorw(msg, "err", err)
 		http.Error(w, msg, http.StatusInternalServerError)
-		return
+		return nil, false
 	}
 	source := http.MaxBytesReader(nil, r.Body, int64(maxRequestBytes))
 	rawMessage, err := io.ReadAll(source)
 	if err != nil {
 		s.lggr.Error("error reading request", err)
 		w.WriteHeader(http.StatusBadRequest)
-		return
+		return nil, false
 	}
+	return rawMessage, true
+}
 
-	// Optionally extract jwt token from authorization header
-	authHeader := r.Header.Get("Authorization")
-	jwtToken := ""
-	if authHeader != "" {
-		jwtToken = strings.TrimPrefix(authHeader, "Bearer ")
+// Optionally extract jwt token from authorization header
+func authToken(r *http.Request) string {
+	return strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+}
+
+func (s *httpServer) handleRequest(w http.ResponseWriter, r *http.Request) {
+	if s.handleCORS(w, r) {
+		return
+	}
+	rawMessage, ok := s.readRequest(w, r)
+	if !ok {
+		return
 	}
+	jwtToken := authToken(r)
 
 	startTime := time.Now()
-	rawResponse, httpStatusCode := s.handler.ProcessRequest(r.Context(), rawMessage, jwtToken)
+	rawResponse, httpStatusCode := s.handler.ProcessRequest(ContextWithUserAuth(r.Context(), r.Header.Get(UserAuthHeader)), rawMessage, jwtToken)
 	duration := time.Since(startTime)
 	s.hMetrics.RecordRequestDuration(r.Context(), httpStatusCode, duration)
 	s.hMetrics.RecordRequestCount(r.Context(), httpStatusCode)
 
 	w.Header().Set("Content-Type", s.config.ContentTypeHeader)
 	w.WriteHeader(httpStatusCode)
-	_, err = w.Write(rawResponse)
+	_, err := w.Write(rawResponse)
 	if err != nil {
 		s.lggr.Error("error when writing response", err)
 	}
@@ -0,0 +1,249 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// ResultEventType is the type of the last event of a stream, whose data is
+	// the response to the request.
+	ResultEventType = "result"
+
+	defaultMaxStreamDuration = 10 * time.Minute
+	streamWriteTimeout       = 10 * time.Second
+	eventStreamContentType   = "text/event-stream"
+)
+
+var errStreamClosed = errors.New("stream closed")
+
+// EventStream sends events on a request to the client which made it, before
+// the response. Thread-safe.
+type EventStream interface {
+	// SendEvent sends an event of the given type. data should be JSON.
+	SendEvent(eventType string, data []byte) error
+}
+
+// withStreaming serves stream requests itself, as next may buffer or time out
+// its responses. Clients stream a request by sending it with an
+// "Accept: text/event-stream" header, or as the first message of a WebSocket.
+func (s *httpServer) withStreaming(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler, ok := s.handler.(StreamingHTTPRequestHandler)
+		switch {
+		case !ok || !s.config.StreamingEnabled:
+			next.ServeHTTP(w, r)
+		case websocket.IsWebSocketUpgrade(r):
+			s.handleWebSocketStream(w, r, handler)
+		case strings.Contains(r.Header.Get("Accept"), eventStreamContentType):
+			s.handleSSEStream(w, r, handler)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// maxStreamDuration is MaxStreamDurationMillis, or else the timeout of other
+// requests.
+func (s *httpServer) maxStreamDuration() time.Duration {
+	switch {
+	case s.config.MaxStreamDurationMillis > 0:
+		return time.Duration(s.config.MaxStreamDurationMillis) * time.Millisecond
+	case s.config.RequestTimeoutMillis > 0:
+		return time.Duration(s.config.RequestTimeoutMillis) * time.Millisecond
+	default:
+		return defaultMaxStreamDuration
+	}
+}
+
+// processStream processes a request and sends the response as the last event.
+func (s *httpServer) processStream(ctx context.Context, rawMessage []byte, auth string, stream EventStream, handler StreamingHTTPRequestHandler) {
+	startTime := time.Now()
+	rawResponse, httpStatusCode := handler.ProcessStreamingRequest(ctx, rawMessage, auth, stream)
+	duration := time.Since(startTime)
+	s.hMetrics.RecordRequestDuration(ctx, httpStatusCode, duration)
+	s.hMetrics.RecordRequestCount(ctx, httpStatusCode)
+
+	if err := stream.SendEvent(ResultEventType, rawResponse); err != nil {
+		s.lggr.Debugw("error when sending stream result", "err", err)
+	}
+}
+
+func (s *httpServer) handleSSEStream(w http.ResponseWriter, r *http.Request, handler StreamingHTTPRequestHandler) {
+	if s.handleCORS(w, r) {
+		return
+	}
+	rawMessage, ok := s.readRequest(w, r)
+	if !ok {
+		return
+	}
+	deadline := time.Now().Add(s.maxStreamDuration())
+	ctx, cancel := context.WithDeadline(ContextWithUserAuth(r.Context(), r.Header.Get(UserAuthHeader)), deadline)
+	defer cancel()
+	rc := http.NewResponseController(w)
+	// the write timeout of the server is meant for single responses
+	if err := rc.SetWriteDeadline(deadline); err != nil {
+		s.lggr.Debugw("failed to extend write deadline of stream", "err", err)
+	}
+
+	w.Header().Set("Content-Type", eventStreamContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		s.lggr.Errorw("failed to open stream", "err", err)
+		return
+	}
+	stream := &sseStream{w: w, rc: rc}
+	defer stream.close()
+	s.processStream(ctx, rawMessage, authToken(r), stream, handler)
+}
+
+func (s *httpServer) handleWebSocketStream(w http.ResponseWriter, r *http.Request, handler StreamingHTTPRequestHandler) {
+	upgrader := websocket.Upgrader{}
+	if s.config.CORSEnabled {
+		upgrader.CheckOrigin = func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			return origin == "" || s.isAllowedOrigin(origin)
+		}
+	}
+	maxRequestBytes, err := s.config.MaxRequestBytesLimiter.Limit(r.Context())
+	if err != nil {
+		msg := "Failed to get request size limit"
+		s.lggr.Errorw(msg, "err", err)
+		http.Error(w, msg, http.StatusInternalServerError)
+		return
+	}
+	// Upgrade responds to the client on failure
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.lggr.Debugw("failed to upgrade stream to WebSocket", "err", err)
+		return
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(s.maxStreamDuration())
+	readDeadline := deadline
+	if s.config.ReadTimeoutMillis > 0 {
+		readDeadline = time.Now().Add(time.Duration(s.config.ReadTimeoutMillis) * time.Millisecond)
+	}
+	conn.SetReadLimit(int64(maxRequestBytes))
+	if err = conn.SetReadDeadline(readDeadline); err != nil {
+		s.lggr.Debugw("failed to set read deadline of stream", "err", err)
+		return
+	}
+	_, rawMessage, err := conn.ReadMessage()
+	if err != nil {
+		s.lggr.Debugw("error reading streamed request", "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithDeadline(ContextWithUserAuth(r.Context(), r.Header.Get(UserAuthHeader)), deadline)
+	defer cancel()
+	// Clients send no more messages, but reading processes control messages and
+	// detects when the client closes the stream.
+	_ = conn.SetReadDeadline(deadline)
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	stream := &wsStream{conn: conn}
+	s.processStream(ctx, rawMessage, authToken(r), stream, handler)
+	stream.close()
+}
+
+type sseStream struct {
+	mu     sync.Mutex
+	w      http.ResponseWriter
+	rc     *http.ResponseController
+	closed bool
+}
+
+var _ EventStream = (*sseStream)(nil)
+
+func (s *sseStream) SendEvent(eventType string, data []byte) error {
+	var buf bytes.Buffer
+	buf.WriteString("event: " + eventType + "\n")
+	// each line of the data needs its own field
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteString("\n")
+	}
+	buf.WriteString("\n")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return errStreamClosed
+	}
+	if _, err := s.w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// close prevents writes to the response once the server handler returns.
+func (s *sseStream) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+}
+
+// wsStreamEvent is a WebSocket message of a stream.
+type wsStreamEvent struct {
+	Event string          `json:"event"`
+	Data  json.RawMessage `json:"data"`
+}
+
+type wsStream struct {
+	mu     sync.Mutex
+	conn   *websocket.Conn
+	closed bool
+}
+
+var _ EventStream = (*wsStream)(nil)
+
+func (s *wsStream) SendEvent(eventType string, data []byte) error {
+	if !json.Valid(data) {
+		var err error
+		if data, err = json.Marshal(string(data)); err != nil {
+			return err
+		}
+	}
+	msg, err := json.Marshal(wsStreamEvent{Event: eventType, Data: data})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return errStreamClosed
+	}
+	if err = s.conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil {
+		return err
+	}
+	return s.conn.WriteMessage(websocket.TextMessage, msg)
+}
+
+// close ends the stream with a close message, which the connection is closed
+// after.
+func (s *wsStream) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(streamWriteTimeout))
+}
@@ -0,0 +1,183 @@
+package network_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/settings/limits"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+)
+
+type streamingHandler struct {
+	auth     atomic.Value
+	deadline atomic.Value
+}
+
+func (h *streamingHandler) ProcessRequest(_ context.Context, rawMessage []byte, _ string) ([]byte, int) {
+	return append([]byte("response to "), rawMessage...), http.StatusOK
+}
+
+func (h *streamingHandler) ProcessStreamingRequest(ctx context.Context, rawMessage []byte, auth string, stream network.EventStream) ([]byte, int) {
+	h.auth.Store(auth)
+	if deadline, ok := ctx.Deadline(); ok {
+		h.deadline.Store(deadline)
+	}
+	if err := stream.SendEvent("progress", []byte(`{"step":1}`)); err != nil {
+		return []byte(err.Error()), http.StatusInternalServerError
+	}
+	if err := stream.SendEvent("progress", []byte(`{"step":2}`)); err != nil {
+		return []byte(err.Error()), http.StatusInternalServerError
+	}
+	return []byte(`{"result":"done"}`), http.StatusOK
+}
+
+func startNewStreamingServer(t *testing.T, streamingEnabled bool) (*streamingHandler, string) {
+	return startStreamingServer(t, &network.HTTPServerConfig{
+		Host:                 HTTPTestHost,
+		Path:                 HTTPTestPath,
+		ContentTypeHeader:    "application/jsonrpc",
+		ReadTimeoutMillis:    10_000,
+		WriteTimeoutMillis:   10_000,
+		RequestTimeoutMillis: 10_000,
+		MaxRequestBytes:      100_000,
+		StreamingEnabled:     streamingEnabled,
+	})
+}
+
+func startStreamingServer(t *testing.T, config *network.HTTPServerConfig) (*streamingHandler, string) {
+	lggr := logger.Test(t)
+	server, err := network.NewHTTPServer(config, lggr, limits.Factory{Logger: lggr})
+	require.NoError(t, err)
+	handler := &streamingHandler{}
+	server.SetHTTPRequestHandler(handler)
+	servicetest.Run(t, server)
+	return handler, fmt.Sprintf("%s:%d%s", HTTPTestHost, server.GetPort(), HTTPTestPath)
+}
+
+func sendSSERequest(t *testing.T, url string) *http.Response {
+	req, err := http.NewRequestWithContext(testutils.Context(t), http.MethodPost, url, bytes.NewBufferString("request"))
+	require.NoError(t, err)
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestHTTPServer_Stream_SSE(t *testing.T) {
+	t.Parallel()
+	handler, url := startNewStreamingServer(t, true)
+
+	resp := sendSSERequest(t, "http://"+url)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	var events []string
+	scanner := bufio.NewScanner(resp.Body)
+	var event []string
+	for scanner.Scan() {
+		if scanner.Text() == "" {
+			events = append(events, strings.Join(event, "\n"))
+			event = nil
+			continue
+		}
+		event = append(event, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []string{
+		"event: progress\ndata: {\"step\":1}",
+		"event: progress\ndata: {\"step\":2}",
+		"event: result\ndata: {\"result\":\"done\"}",
+	}, events)
+	require.Equal(t, "token", handler.auth.Load())
+}
+
+func TestHTTPServer_Stream_WebSocket(t *testing.T) {
+	t.Parallel()
+	handler, url := startNewStreamingServer(t, true)
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer token")
+	conn, resp, err := websocket.DefaultDialer.DialContext(testutils.Context(t), "ws://"+url, header)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	defer conn.Close()
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("request")))
+
+	var messages []string
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			require.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), err)
+			break
+		}
+		messages = append(messages, string(msg))
+	}
+	require.Equal(t, []string{
+		`{"event":"progress","data":{"step":1}}`,
+		`{"event":"progress","data":{"step":2}}`,
+		`{"event":"result","data":{"result":"done"}}`,
+	}, messages)
+	require.Equal(t, "token", handler.auth.Load())
+}
+
+func TestHTTPServer_Stream_Disabled(t *testing.T) {
+	t.Parallel()
+	_, url := startNewStreamingServer(t, false)
+
+	resp := sendSSERequest(t, "http://"+url)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	respBytes, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "response to request", string(respBytes))
+}
+
+func TestHTTPServer_Stream_Duration(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name                    string
+		maxStreamDurationMillis uint32
+		expected                time.Duration
+	}{
+		{"request timeout", 0, 10 * time.Second},
+		{"max stream duration", 60_000, time.Minute},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, url := startStreamingServer(t, &network.HTTPServerConfig{
+				Host:                    HTTPTestHost,
+				Path:                    HTTPTestPath,
+				ContentTypeHeader:       "application/jsonrpc",
+				ReadTimeoutMillis:       10_000,
+				WriteTimeoutMillis:      10_000,
+				RequestTimeoutMillis:    10_000,
+				MaxRequestBytes:         100_000,
+				StreamingEnabled:        true,
+				MaxStreamDurationMillis: tc.maxStreamDurationMillis,
+			})
+			start := time.Now()
+			resp := sendSSERequest(t, "http://"+url)
+			_, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			deadline, ok := handler.deadline.Load().(time.Time)
+			require.True(t, ok)
+			require.WithinDuration(t, start.Add(tc.expected), deadline, 5*time.Second)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package gateway
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/monitoring"
+)
+
+// quotaError is returned for requests exceeding a quota of the user.
+type quotaError struct {
+	// result is the monitoring.UserRequest* result the request is recorded with
+	result string
+	msg    string
+}
+
+func (e *quotaError) Error() string { return e.msg }
+
+var (
+	errRequestRateExceeded = &quotaError{monitoring.UserRequestRateLimited, "requests per minute quota exceeded"}
+	errBytesQuotaExceeded  = &quotaError{monitoring.UserRequestBytesExceeded, "bytes per day quota exceeded"}
+	errTooManyInFlight     = &quotaError{monitoring.UserRequestTooManyInFlight, "concurrent requests quota exceeded"}
+)
+
+// userQuotas tracks the usage of the gateway by each user, and admits their
+// requests while it is within their quota. Usage is not shared between
+// replicas, which would multiply the quotas, so replicated gateways reject
+// them. The usage of idle users is forgotten at the start of every day.
+type userQuotas struct {
+	defaultQuota config.QuotaConfig
+	quotas       map[string]config.QuotaConfig
+	clock        clockwork.Clock
+	mu           sync.Mutex
+	usage        map[string]*userUsage // identity -> usage
+	// day is the start of the day usage was last pruned on
+	day time.Time
+}
+
+type userUsage struct {
+	quota config.QuotaConfig
+	// nil if the request rate is not limited
+	limiter *rate.Limiter
+	// bytes counts the bytes of requests and responses since the start of day
+	day        time.Time
+	bytes      uint64
+	concurrent uint32
+	lastUsed   time.Time
+}
+
+// quotasEnabled returns whether any user is held to a quota.
+func quotasEnabled(cfg *config.UserAuthConfig) bool {
+	if cfg.DefaultQuota != (config.QuotaConfig{}) {
+		return true
+	}
+	for _, quota := range cfg.Quotas {
+		if quota != (config.QuotaConfig{}) {
+			return true
+		}
+	}
+	return false
+}
+
+func newUserQuotas(cfg *config.UserAuthConfig, clock clockwork.Clock) *userQuotas {
+	return &userQuotas{
+		defaultQuota: cfg.DefaultQuota,
+		quotas:       cfg.Quotas,
+		clock:        clock,
+		usage:        make(map[string]*userUsage),
+	}
+}
+
+// prune forgets the usage of users without requests in flight, whose request
+// rate limit has recovered since their last request. It must be called with
+// mu held.
+func (q *userQuotas) prune(now time.Time) {
+	maps.DeleteFunc(q.usage, func(_ string, usage *userUsage) bool {
+		return usage.concurrent == 0 && now.Sub(usage.lastUsed) >= time.Minute
+	})
+}
+
+func (q *userQuotas) usageOf(identity string) *userUsage {
+	usage, ok := q.usage[identity]
+	if ok {
+		return usage
+	}
+	quota, ok := q.quotas[identity]
+	if !ok {
+		quota = q.defaultQuota
+	}
+	usage = &userUsage{quota: quota}
+	if quota.RequestsPerMinute > 0 {
+		// allows a minute worth of requests at once
+		usage.limiter = rate.NewLimiter(rate.Limit(float64(quota.RequestsPerMinute)/60), int(quota.RequestsPerMinute))
+	}
+	q.usage[identity] = usage
+	return usage
+}
+
+// acquire admits a request of the user if it is within their quota. The
+// returned function must be called with the size of the response, once the
+// request is processed.
+func (q *userQuotas) acquire(identity string, requestBytes int) (release func(responseBytes int), err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := q.clock.Now()
+	day := now.UTC().Truncate(24 * time.Hour)
+	if !q.day.Equal(day) {
+		q.day = day
+		q.prune(now)
+	}
+	usage := q.usageOf(identity)
+	usage.lastUsed = now
+	if !usage.day.Equal(day) {
+		usage.day = day
+		usage.bytes = 0
+	}
+
+	if usage.quota.MaxConcurrentRequests > 0 && usage.concurrent >= usage.quota.MaxConcurrentRequests {
+		return nil, errTooManyInFlight
+	}
+	if usage.quota.BytesPerDay > 0 && usage.bytes+uint64(requestBytes) > usage.quota.BytesPerDay {
+		return nil, errBytesQuotaExceeded
+	}
+	// checked last, as it takes a token from the limiter
+	if usage.limiter != nil && !usage.limiter.AllowN(now, 1) {
+		return nil, errRequestRateExceeded
+	}
+
+	usage.bytes += uint64(requestBytes)
+	usage.concurrent++
+	var once sync.Once
+	return func(responseBytes int) {
+		once.Do(func() {
+			q.mu.Lock()
+			defer q.mu.Unlock()
+			usage.concurrent--
+			// responses count against the quota of the day the request was made on
+			if usage.day.Equal(day) {
+				usage.bytes += uint64(responseBytes)
+			}
+		})
+	}, nil
+}
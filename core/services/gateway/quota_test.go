@@ -0,0 +1,106 @@
+package gateway
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+)
+
+func TestUserQuotas_RequestsPerMinute(t *testing.T) {
+	t.Parallel()
+
+	clock := clockwork.NewFakeClockAt(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	quotas := newUserQuotas(&config.UserAuthConfig{DefaultQuota: config.QuotaConfig{RequestsPerMinute: 2}}, clock)
+
+	for range 2 {
+		release, err := quotas.acquire("alice", 0)
+		require.NoError(t, err)
+		release(0)
+	}
+	_, err := quotas.acquire("alice", 0)
+	require.ErrorIs(t, err, errRequestRateExceeded)
+	// quotas are per user
+	_, err = quotas.acquire("bob", 0)
+	require.NoError(t, err)
+
+	clock.Advance(30 * time.Second)
+	_, err = quotas.acquire("alice", 0)
+	require.NoError(t, err)
+}
+
+func TestUserQuotas_BytesPerDay(t *testing.T) {
+	t.Parallel()
+
+	clock := clockwork.NewFakeClockAt(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	quotas := newUserQuotas(&config.UserAuthConfig{
+		Quotas: map[string]config.QuotaConfig{"alice": {BytesPerDay: 100}},
+	}, clock)
+
+	release, err := quotas.acquire("alice", 40)
+	require.NoError(t, err)
+	release(40)
+	_, err = quotas.acquire("alice", 30)
+	require.ErrorIs(t, err, errBytesQuotaExceeded)
+	// users without a quota of their own get the unlimited default quota
+	_, err = quotas.acquire("bob", 1000)
+	require.NoError(t, err)
+
+	clock.Advance(12 * time.Hour)
+	release, err = quotas.acquire("alice", 30)
+	require.NoError(t, err)
+	release(30)
+}
+
+func TestUserQuotas_MaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	quotas := newUserQuotas(&config.UserAuthConfig{DefaultQuota: config.QuotaConfig{MaxConcurrentRequests: 1}}, clockwork.NewFakeClock())
+
+	release, err := quotas.acquire("alice", 0)
+	require.NoError(t, err)
+	_, err = quotas.acquire("alice", 0)
+	require.ErrorIs(t, err, errTooManyInFlight)
+
+	release(0)
+	// releasing twice has no effect
+	release(0)
+	release, err = quotas.acquire("alice", 0)
+	require.NoError(t, err)
+	_, err = quotas.acquire("alice", 0)
+	require.ErrorIs(t, err, errTooManyInFlight)
+	release(0)
+}
+
+func TestUserQuotas_PruneIdleUsers(t *testing.T) {
+	t.Parallel()
+
+	clock := clockwork.NewFakeClockAt(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	quotas := newUserQuotas(&config.UserAuthConfig{DefaultQuota: config.QuotaConfig{RequestsPerMinute: 1}}, clock)
+
+	release, err := quotas.acquire("carol", 0)
+	require.NoError(t, err)
+	release(0)
+	clock.Advance(12*time.Hour - 30*time.Second)
+	release, err = quotas.acquire("alice", 0)
+	require.NoError(t, err)
+	release(0)
+	_, err = quotas.acquire("bob", 0)
+	require.NoError(t, err)
+
+	// the first request of the day forgets carol, while alice is still rate
+	// limited and bob has a request in flight
+	clock.Advance(40 * time.Second)
+	_, err = quotas.acquire("dave", 0)
+	require.NoError(t, err)
+	quotas.mu.Lock()
+	require.ElementsMatch(t, []string{"alice", "bob", "dave"}, slices.Collect(maps.Keys(quotas.usage)))
+	quotas.mu.Unlock()
+	_, err = quotas.acquire("alice", 0)
+	require.ErrorIs(t, err, errRequestRateExceeded)
+}
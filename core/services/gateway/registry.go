@@ -0,0 +1,74 @@
+package gateway
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// Registry tracks the gateways running on this node by the ID of their job,
+// so that admins can inspect them. All methods are thread-safe.
+type Registry struct {
+	mu       sync.RWMutex
+	gateways map[int32]Gateway
+}
+
+func NewRegistry() *Registry {
+	return &Registry{gateways: make(map[int32]Gateway)}
+}
+
+// Get returns the gateway of a job, if it is running.
+func (r *Registry) Get(jobID int32) (Gateway, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	gw, ok := r.gateways[jobID]
+	return gw, ok
+}
+
+// Add tracks the gateway of a job, replacing any other gateway of it.
+func (r *Registry) Add(jobID int32, gw Gateway) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gateways[jobID] = gw
+}
+
+// Remove stops tracking the gateway of a job, unless it was replaced by
+// another gateway already.
+func (r *Registry) Remove(jobID int32, gw Gateway) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.gateways[jobID] == gw {
+		delete(r.gateways, jobID)
+	}
+}
+
+// JobIDs returns the sorted IDs of the jobs with a running gateway.
+func (r *Registry) JobIDs() []int32 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Sorted(maps.Keys(r.gateways))
+}
+
+// registration is a service which adds a gateway to a Registry while the job
+// of the gateway runs. It must be started after the gateway, so that it is
+// closed before it.
+type registration struct {
+	registry *Registry
+	jobID    int32
+	gateway  Gateway
+}
+
+var _ job.ServiceCtx = (*registration)(nil)
+
+func (r *registration) Start(context.Context) error {
+	r.registry.Add(r.jobID, r.gateway)
+	return nil
+}
+
+func (r *registration) Close() error {
+	r.registry.Remove(r.jobID, r.gateway)
+	return nil
+}
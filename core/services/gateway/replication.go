@@ -0,0 +1,399 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	jsonrpc "github.com/smartcontractkit/chainlink-common/pkg/jsonrpc2"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/settings/limits"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/state"
+)
+
+const (
+	defaultStateTTLSec            = 300
+	defaultForwardPath            = "/forward"
+	defaultForwardMaxRequestBytes = 10_000_000
+	forwardTimeout                = 10 * time.Second
+	maxForwardErrorBytes          = 1024
+	// maxOwnerCacheDuration bounds how long the replica of a request is cached,
+	// which is long enough for the responses of all nodes to arrive.
+	maxOwnerCacheDuration = 10 * time.Second
+	// MinForwardingSecretLength is the minimum length of the secret replicas
+	// authenticate to each other with.
+	MinForwardingSecretLength = 32
+
+	// forwardedRequest is a request to a node connected to the receiving replica.
+	forwardedRequest = "request"
+	// forwardedResponse is a message from a node, in response to a request sent
+	// by the receiving replica.
+	forwardedResponse = "response"
+)
+
+// forwardedMessage is the body of a message forwarded between replicas.
+type forwardedMessage struct {
+	Type        string          `json:"type"`
+	DonId       string          `json:"donId"`
+	NodeAddress string          `json:"nodeAddress"`
+	Message     json.RawMessage `json:"message"`
+}
+
+// replicator lets several replicas of a gateway serve the same DONs, while
+// each node is connected to a single replica and each user request is held by
+// the replica which accepted it. Through the shared state store, it records:
+//   - the URL of every replica,
+//   - the replica every node is connected to, and
+//   - the replica which sent every request to the nodes.
+//
+// Requests to nodes connected to another replica are forwarded to it, and so
+// are responses from nodes to requests sent by another replica. Request IDs
+// are chosen by users, so a request is rejected while another replica holds
+// one with the same ID.
+type replicator struct {
+	services.StateMachine
+	id           string
+	advertiseURL string
+	secret       string
+	keyPrefix    string
+	ttl          time.Duration
+	store        state.Store
+	// owners caches the replicas of requests, so that the requests sent to, and
+	// the responses received from, every node of a DON reach the store once
+	owners        map[string]cachedOwner
+	ownersMu      sync.Mutex
+	ownerCacheTTL time.Duration
+	server        gw_net.HTTPServer
+	client        *http.Client
+	// set by the connection manager which owns the replicator
+	connMgr *connectionManager
+	stopCh  services.StopChan
+	wg      sync.WaitGroup
+	lggr    logger.Logger
+}
+
+var _ gw_net.HTTPRequestHandler = (*replicator)(nil)
+
+type cachedOwner struct {
+	replicaID string
+	expiresAt time.Time
+}
+
+func newReplicator(gwConfig *config.GatewayConfig, store state.Store, lggr logger.Logger, lf limits.Factory) (*replicator, error) {
+	cfg := gwConfig.ReplicaConfig
+	if cfg.AdvertiseURL == "" {
+		return nil, errors.New("replica advertise URL is required")
+	}
+	if len(cfg.ForwardingSecret) < MinForwardingSecretLength {
+		return nil, fmt.Errorf("replica forwarding secret must be at least %d characters", MinForwardingSecretLength)
+	}
+	if store == nil {
+		var err error
+		if store, err = state.NewStore(cfg.StateBackend, nil, cfg.StateURL); err != nil {
+			return nil, err
+		}
+	}
+	ttlSec := cfg.StateTTLSec
+	if ttlSec == 0 {
+		ttlSec = defaultStateTTLSec
+	}
+	serverConfig := cfg.ForwardServerConfig
+	if serverConfig.Path == "" {
+		serverConfig.Path = defaultForwardPath
+	}
+	if serverConfig.ContentTypeHeader == "" {
+		serverConfig.ContentTypeHeader = "application/json"
+	}
+	if serverConfig.MaxRequestBytes == 0 {
+		serverConfig.MaxRequestBytes = defaultForwardMaxRequestBytes
+	}
+	server, err := gw_net.NewHTTPServer(&serverConfig, lggr, lf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create forward server: %w", err)
+	}
+	r := &replicator{
+		id:            cfg.ReplicaId,
+		advertiseURL:  cfg.AdvertiseURL,
+		secret:        cfg.ForwardingSecret,
+		keyPrefix:     "gateway/" + gwConfig.ConnectionManagerConfig.AuthGatewayId + "/",
+		ttl:           time.Duration(ttlSec) * time.Second,
+		store:         store,
+		owners:        make(map[string]cachedOwner),
+		ownerCacheTTL: min(maxOwnerCacheDuration, time.Duration(ttlSec)*time.Second/2),
+		server:        server,
+		client:        &http.Client{Timeout: forwardTimeout},
+		stopCh:        make(services.StopChan),
+		lggr:          logger.With(logger.Named(lggr, "Replicator"), "replicaID", cfg.ReplicaId),
+	}
+	server.SetHTTPRequestHandler(r)
+	return r, nil
+}
+
+func (r *replicator) Start(ctx context.Context) error {
+	return r.StartOnce("Replicator", func() error {
+		if err := r.server.Start(ctx); err != nil {
+			return err
+		}
+		if err := r.register(ctx); err != nil {
+			return errors.Join(err, r.server.Close())
+		}
+		r.wg.Add(1)
+		go r.heartbeatLoop()
+		return nil
+	})
+}
+
+func (r *replicator) Close() error {
+	return r.StopOnce("Replicator", func() error {
+		close(r.stopCh)
+		r.wg.Wait()
+		return r.server.Close()
+	})
+}
+
+func (r *replicator) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
+
+func (r *replicator) Name() string { return r.lggr.Name() }
+
+func (r *replicator) replicaKey(replicaID string) string {
+	return r.keyPrefix + "replica/" + replicaID
+}
+
+func (r *replicator) nodeKey(donID string, nodeAddress string) string {
+	return r.keyPrefix + "node/" + donID + "/" + nodeAddress
+}
+
+func (r *replicator) requestKey(donID string, requestID string) string {
+	return r.keyPrefix + "request/" + donID + "/" + requestID
+}
+
+func (r *replicator) register(ctx context.Context) error {
+	return r.store.Set(ctx, r.replicaKey(r.id), r.advertiseURL, r.ttl)
+}
+
+// heartbeatLoop keeps the URL of the replica, and the nodes connected to it,
+// from expiring.
+func (r *replicator) heartbeatLoop() {
+	defer r.wg.Done()
+	ctx, cancel := r.stopCh.NewCtx()
+	defer cancel()
+	ticker := time.NewTicker(r.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.C:
+			if err := r.register(ctx); err != nil {
+				r.lggr.Errorw("failed to register replica", "err", err)
+			}
+			r.connMgr.donsMu.RLock()
+			dons := slices.Collect(maps.Values(r.connMgr.dons))
+			r.connMgr.donsMu.RUnlock()
+			for _, donConnMgr := range dons {
+				r.refreshNodes(ctx, donConnMgr)
+			}
+			r.pruneOwners()
+			deleted, err := r.store.DeleteExpired(ctx)
+			if err != nil {
+				r.lggr.Errorw("failed to delete expired state", "err", err)
+			} else if deleted > 0 {
+				r.lggr.Debugw("deleted expired state", "count", deleted)
+			}
+		}
+	}
+}
+
+// refreshNodes records the nodes of the DON which are connected to this
+// replica, as shown by a ping.
+func (r *replicator) refreshNodes(ctx context.Context, donConnMgr *donConnectionManager) {
+	donConnMgr.nodesMu.RLock()
+	nodes := maps.Clone(donConnMgr.nodes)
+	donConnMgr.nodesMu.RUnlock()
+	for nodeAddress, nodeState := range nodes {
+		if nodeState.conn.Write(ctx, websocket.PingMessage, []byte{}) != nil {
+			continue
+		}
+		if err := r.recordNode(ctx, donConnMgr.donConfig.DonId, nodeAddress); err != nil {
+			r.lggr.Errorw("failed to record node", "donID", donConnMgr.donConfig.DonId, "nodeAddress", nodeAddress, "err", err)
+		}
+	}
+}
+
+// recordNode records that a node is connected to this replica.
+func (r *replicator) recordNode(ctx context.Context, donID string, nodeAddress string) error {
+	return r.store.Set(ctx, r.nodeKey(donID, nodeAddress), r.id, r.ttl)
+}
+
+// recordRequest records that this replica sent a request to the nodes, so that
+// responses arriving on other replicas are forwarded to it. It fails if
+// another replica holds a request with the same ID.
+func (r *replicator) recordRequest(ctx context.Context, donID string, requestID string) error {
+	key := r.requestKey(donID, requestID)
+	replicaID, ok := r.cachedOwner(key)
+	if !ok {
+		var err error
+		if replicaID, err = r.store.Claim(ctx, key, r.id, r.ttl); err != nil {
+			return err
+		}
+		r.cacheOwner(key, replicaID)
+	}
+	if replicaID != r.id {
+		return fmt.Errorf("request ID %s is in use by replica %s", requestID, replicaID)
+	}
+	return nil
+}
+
+// requestOwner returns the replica which sent a request, or an empty string if
+// it is this replica or the request is not recorded.
+func (r *replicator) requestOwner(ctx context.Context, donID string, requestID string) (string, error) {
+	key := r.requestKey(donID, requestID)
+	replicaID, ok := r.cachedOwner(key)
+	if !ok {
+		var err error
+		replicaID, err = r.store.Get(ctx, key)
+		if errors.Is(err, state.ErrNotFound) {
+			return "", nil
+		} else if err != nil {
+			return "", err
+		}
+		r.cacheOwner(key, replicaID)
+	}
+	if replicaID == r.id {
+		return "", nil
+	}
+	return replicaID, nil
+}
+
+func (r *replicator) cachedOwner(key string) (string, bool) {
+	r.ownersMu.Lock()
+	defer r.ownersMu.Unlock()
+	owner, ok := r.owners[key]
+	if !ok || !time.Now().Before(owner.expiresAt) {
+		return "", false
+	}
+	return owner.replicaID, true
+}
+
+func (r *replicator) cacheOwner(key string, replicaID string) {
+	r.ownersMu.Lock()
+	defer r.ownersMu.Unlock()
+	r.owners[key] = cachedOwner{replicaID: replicaID, expiresAt: time.Now().Add(r.ownerCacheTTL)}
+}
+
+func (r *replicator) pruneOwners() {
+	r.ownersMu.Lock()
+	defer r.ownersMu.Unlock()
+	now := time.Now()
+	maps.DeleteFunc(r.owners, func(_ string, owner cachedOwner) bool {
+		return !now.Before(owner.expiresAt)
+	})
+}
+
+// nodeOwner returns the replica a node is connected to, or an empty string if
+// it is this replica or none is.
+func (r *replicator) nodeOwner(ctx context.Context, donID string, nodeAddress string) (string, error) {
+	key := r.nodeKey(donID, nodeAddress)
+	replicaID, err := r.store.Get(ctx, key)
+	if errors.Is(err, state.ErrNotFound) || replicaID == r.id {
+		return "", nil
+	}
+	return replicaID, err
+}
+
+// forwardRequest forwards a request to a node connected to another replica. It
+// returns false if the node is not connected to another replica.
+func (r *replicator) forwardRequest(ctx context.Context, donID string, nodeAddress string, data []byte) (bool, error) {
+	replicaID, err := r.nodeOwner(ctx, donID, nodeAddress)
+	if err != nil || replicaID == "" {
+		return false, err
+	}
+	return true, r.forward(ctx, replicaID, forwardedMessage{Type: forwardedRequest, DonId: donID, NodeAddress: nodeAddress, Message: data})
+}
+
+// forwardResponse forwards a message from a node to the replica which sent
+// the request it responds to. It returns false if that is this replica, or if
+// the message is not a response to a recorded request.
+func (r *replicator) forwardResponse(ctx context.Context, donID string, nodeAddress string, requestID string, data []byte) (bool, error) {
+	replicaID, err := r.requestOwner(ctx, donID, requestID)
+	if err != nil || replicaID == "" {
+		return false, err
+	}
+	return true, r.forward(ctx, replicaID, forwardedMessage{Type: forwardedResponse, DonId: donID, NodeAddress: nodeAddress, Message: data})
+}
+
+func (r *replicator) forward(ctx context.Context, replicaID string, msg forwardedMessage) error {
+	url, err := r.store.Get(ctx, r.replicaKey(replicaID))
+	if err != nil {
+		return fmt.Errorf("failed to look up replica %s: %w", replicaID, err)
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+r.secret)
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to forward %s to replica %s: %w", msg.Type, replicaID, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxForwardErrorBytes))
+		return fmt.Errorf("replica %s rejected forwarded %s: %s: %s", replicaID, msg.Type, resp.Status, respBody)
+	}
+	return nil
+}
+
+// ProcessRequest handles messages forwarded by other replicas.
+func (r *replicator) ProcessRequest(ctx context.Context, rawRequest []byte, auth string) ([]byte, int) {
+	if subtle.ConstantTimeCompare([]byte(auth), []byte(r.secret)) != 1 {
+		return []byte("invalid forwarding secret"), http.StatusUnauthorized
+	}
+	var msg forwardedMessage
+	if err := json.Unmarshal(rawRequest, &msg); err != nil {
+		return []byte("invalid forwarded message: " + err.Error()), http.StatusBadRequest
+	}
+	donConnMgr := r.connMgr.DONConnectionManager(msg.DonId)
+	if donConnMgr == nil {
+		return []byte("DON not found: " + msg.DonId), http.StatusNotFound
+	}
+	var err error
+	switch msg.Type {
+	case forwardedRequest:
+		err = donConnMgr.writeToNode(ctx, msg.NodeAddress, msg.Message)
+	case forwardedResponse:
+		var resp jsonrpc.Response[json.RawMessage]
+		if err = json.Unmarshal(msg.Message, &resp); err != nil {
+			return []byte("invalid forwarded response: " + err.Error()), http.StatusBadRequest
+		}
+		err = donConnMgr.handleNodeMessage(ctx, &resp, msg.NodeAddress)
+	default:
+		return []byte("unsupported forwarded message type: " + msg.Type), http.StatusBadRequest
+	}
+	if err != nil {
+		return []byte(err.Error()), http.StatusBadGateway
+	}
+	return []byte("{}"), http.StatusOK
+}
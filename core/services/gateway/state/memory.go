@@ -0,0 +1,68 @@
+package state
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+type memoryEntry struct {
+	value     string
+	expiresAt time.Time
+}
+
+type memoryStore struct {
+	clock   clockwork.Clock
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+}
+
+var _ Store = (*memoryStore)(nil)
+
+func NewMemoryStore(clock clockwork.Clock) Store {
+	return &memoryStore{clock: clock, entries: make(map[string]memoryEntry)}
+}
+
+func (s *memoryStore) Set(_ context.Context, key string, value string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = memoryEntry{value: value, expiresAt: s.clock.Now().Add(ttl)}
+	return nil
+}
+
+func (s *memoryStore) Claim(_ context.Context, key string, value string, ttl time.Duration) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	if entry, ok := s.entries[key]; ok && entry.value != value && now.Before(entry.expiresAt) {
+		return entry.value, nil
+	}
+	s.entries[key] = memoryEntry{value: value, expiresAt: now.Add(ttl)}
+	return value, nil
+}
+
+func (s *memoryStore) Get(_ context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.entries[key]
+	if !ok || !s.clock.Now().Before(entry.expiresAt) {
+		return "", ErrNotFound
+	}
+	return entry.value, nil
+}
+
+func (s *memoryStore) DeleteExpired(_ context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	var deleted int64
+	for key, entry := range s.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
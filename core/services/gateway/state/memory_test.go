@@ -0,0 +1,73 @@
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/state"
+)
+
+func TestMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	clock := clockwork.NewFakeClock()
+	store := state.NewMemoryStore(clock)
+
+	_, err := store.Get(ctx, "a")
+	require.ErrorIs(t, err, state.ErrNotFound)
+
+	require.NoError(t, store.Set(ctx, "a", "1", time.Minute))
+	require.NoError(t, store.Set(ctx, "b", "2", 2*time.Minute))
+	value, err := store.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "1", value)
+
+	require.NoError(t, store.Set(ctx, "a", "3", time.Minute))
+	value, err = store.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "3", value)
+
+	clock.Advance(time.Minute)
+	_, err = store.Get(ctx, "a")
+	require.ErrorIs(t, err, state.ErrNotFound)
+	deleted, err := store.DeleteExpired(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	value, err = store.Get(ctx, "b")
+	require.NoError(t, err)
+	assert.Equal(t, "2", value)
+
+	owner, err := store.Claim(ctx, "b", "4", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "2", owner, "b is held by another value")
+	owner, err = store.Claim(ctx, "b", "2", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "2", owner)
+	clock.Advance(time.Minute)
+	owner, err = store.Claim(ctx, "b", "4", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "4", owner, "the claim of 2 was extended until it expired")
+}
+
+func TestNewStore(t *testing.T) {
+	t.Parallel()
+
+	_, err := state.NewStore(state.BackendMemory, nil, "")
+	require.NoError(t, err)
+	_, err = state.NewStore("", nil, "")
+	require.ErrorContains(t, err, "requires a database")
+	_, err = state.NewStore(state.BackendPostgres, nil, "")
+	require.ErrorContains(t, err, "requires a database")
+	_, err = state.NewStore(state.BackendRedis, nil, "")
+	require.ErrorContains(t, err, "requires a URL")
+	_, err = state.NewStore(state.BackendRedis, nil, "redis://localhost:6379/0")
+	require.NoError(t, err)
+	_, err = state.NewStore("etcd", nil, "")
+	require.ErrorContains(t, err, `unsupported state backend "etcd"`)
+}
@@ -0,0 +1,69 @@
+package state
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+type postgresStore struct {
+	ds sqlutil.DataSource
+}
+
+var _ Store = (*postgresStore)(nil)
+
+func NewPostgresStore(ds sqlutil.DataSource) Store {
+	return &postgresStore{ds: ds}
+}
+
+func (s *postgresStore) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	_, err := s.ds.ExecContext(ctx, `
+		INSERT INTO gateway_state (key, value, expires_at)
+		VALUES ($1, $2, now() + $3 * interval '1 millisecond')
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = EXCLUDED.expires_at;
+	`, key, value, ttl.Milliseconds())
+	return err
+}
+
+func (s *postgresStore) Claim(ctx context.Context, key string, value string, ttl time.Duration) (string, error) {
+	// the entry which blocked the claim may expire before it is read
+	for range maxClaimAttempts {
+		var claimed string
+		err := s.ds.GetContext(ctx, &claimed, `
+			INSERT INTO gateway_state (key, value, expires_at)
+			VALUES ($1, $2, now() + $3 * interval '1 millisecond')
+			ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = EXCLUDED.expires_at
+			WHERE gateway_state.value = EXCLUDED.value OR gateway_state.expires_at <= now()
+			RETURNING value;
+		`, key, value, ttl.Milliseconds())
+		if !errors.Is(err, sql.ErrNoRows) {
+			return claimed, err
+		}
+		held, err := s.Get(ctx, key)
+		if !errors.Is(err, ErrNotFound) {
+			return held, err
+		}
+	}
+	return "", fmt.Errorf("failed to claim %s", key)
+}
+
+func (s *postgresStore) Get(ctx context.Context, key string) (string, error) {
+	var value string
+	err := s.ds.GetContext(ctx, &value, `SELECT value FROM gateway_state WHERE key = $1 AND expires_at > now();`, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	return value, err
+}
+
+func (s *postgresStore) DeleteExpired(ctx context.Context) (int64, error) {
+	result, err := s.ds.ExecContext(ctx, `DELETE FROM gateway_state WHERE expires_at <= now();`)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,47 @@
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/state"
+)
+
+func TestPostgresStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	store := state.NewPostgresStore(pgtest.NewSqlxDB(t))
+
+	_, err := store.Get(ctx, "a")
+	require.ErrorIs(t, err, state.ErrNotFound)
+
+	require.NoError(t, store.Set(ctx, "a", "1", time.Hour))
+	require.NoError(t, store.Set(ctx, "a", "2", time.Hour))
+	value, err := store.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "2", value)
+
+	owner, err := store.Claim(ctx, "a", "3", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "2", owner)
+	owner, err = store.Claim(ctx, "c", "3", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "3", owner)
+
+	require.NoError(t, store.Set(ctx, "b", "3", -time.Second))
+	owner, err = store.Claim(ctx, "b", "4", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "4", owner, "expired entries are claimed")
+	require.NoError(t, store.Set(ctx, "b", "3", -time.Second))
+	_, err = store.Get(ctx, "b")
+	require.ErrorIs(t, err, state.ErrNotFound)
+	deleted, err := store.DeleteExpired(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
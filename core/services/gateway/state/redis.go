@@ -0,0 +1,74 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisStore keeps the state in a Redis-compatible server, which expires
+// entries itself.
+type redisStore struct {
+	client *redis.Client
+}
+
+var _ Store = (*redisStore)(nil)
+
+// NewRedisStore returns a Store kept by the server at rawURL, which is
+// redis://[[user]:password@]host[:port][/db], or rediss:// to use TLS.
+func NewRedisStore(rawURL string) (Store, error) {
+	opts, err := redis.ParseURL(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis URL: %w", err)
+	}
+	return &redisStore{client: redis.NewClient(opts)}, nil
+}
+
+func (s *redisStore) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	ttl = ttl.Truncate(time.Millisecond)
+	if ttl <= 0 {
+		// the server rejects expiry times which are not positive
+		return s.client.Del(ctx, key).Err()
+	}
+	return s.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (s *redisStore) Claim(ctx context.Context, key string, value string, ttl time.Duration) (string, error) {
+	ttl = max(ttl.Truncate(time.Millisecond), time.Millisecond)
+	// the entry which blocked the claim may expire before it is read
+	for range maxClaimAttempts {
+		claimed, err := s.client.SetNX(ctx, key, value, ttl).Result()
+		if err != nil {
+			return "", err
+		}
+		if claimed {
+			return value, nil
+		}
+		held, err := s.Get(ctx, key)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil || held != value {
+			return held, err
+		}
+		// claimed before, so only the expiry is extended
+		return value, s.client.Set(ctx, key, value, ttl).Err()
+	}
+	return "", fmt.Errorf("failed to claim %s", key)
+}
+
+func (s *redisStore) Get(ctx context.Context, key string) (string, error) {
+	value, err := s.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ErrNotFound
+	}
+	return value, err
+}
+
+// DeleteExpired deletes nothing, as the server expires entries itself.
+func (s *redisStore) DeleteExpired(context.Context) (int64, error) {
+	return 0, nil
+}
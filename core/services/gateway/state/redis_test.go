@@ -0,0 +1,198 @@
+package state_test
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/state"
+)
+
+// fakeRedis serves the commands used by the redis store.
+type fakeRedis struct {
+	password string
+	mu       sync.Mutex
+	values   map[string]string
+	expiry   map[string]time.Time
+}
+
+func newFakeRedis(t *testing.T, password string) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	f := &fakeRedis{password: password, values: map[string]string{}, expiry: map[string]time.Time{}}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+	return ln.Addr().String()
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	authenticated := f.password == ""
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		var reply string
+		switch {
+		case args[0] == "AUTH":
+			authenticated = args[len(args)-1] == f.password
+			reply = "+OK\r\n"
+			if !authenticated {
+				reply = "-WRONGPASS invalid password\r\n"
+			}
+		case !authenticated:
+			reply = "-NOAUTH Authentication required.\r\n"
+		default:
+			reply = f.handle(args)
+		}
+		if _, err = io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+func (f *fakeRedis) handle(args []string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(args) < 2 {
+		return "-ERR wrong number of arguments\r\n"
+	}
+	if expiry, ok := f.expiry[args[1]]; ok && !time.Now().Before(expiry) {
+		delete(f.values, args[1])
+		delete(f.expiry, args[1])
+	}
+	switch args[0] {
+	case "SELECT":
+		return "+OK\r\n"
+	case "GET":
+		value, ok := f.values[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+	case "DEL":
+		delete(f.values, args[1])
+		return ":1\r\n"
+	case "SET":
+		var ttl time.Duration
+		var nx bool
+		for i := 3; i < len(args); i++ {
+			switch strings.ToUpper(args[i]) {
+			case "NX":
+				nx = true
+			case "EX", "PX":
+				i++
+				n, err := strconv.Atoi(args[i])
+				if err != nil || n <= 0 {
+					return "-ERR invalid expire time\r\n"
+				}
+				ttl = time.Duration(n) * time.Millisecond
+				if strings.EqualFold(args[i-1], "EX") {
+					ttl = time.Duration(n) * time.Second
+				}
+			}
+		}
+		if _, ok := f.values[args[1]]; ok && nx {
+			return "$-1\r\n"
+		}
+		f.values[args[1]] = args[2]
+		f.expiry[args[1]] = time.Now().Add(ttl)
+		return "+OK\r\n"
+	default:
+		return "-ERR unknown command\r\n"
+	}
+}
+
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		if _, err = r.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		if args[i], err = r.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		args[i] = strings.TrimSuffix(args[i], "\r\n")
+	}
+	if n > 0 {
+		args[0] = strings.ToUpper(args[0])
+	}
+	return args, nil
+}
+
+func TestRedisStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	addr := newFakeRedis(t, "secret")
+	store, err := state.NewRedisStore("redis://:secret@" + addr + "/1")
+	require.NoError(t, err)
+
+	_, err = store.Get(ctx, "a")
+	require.ErrorIs(t, err, state.ErrNotFound)
+
+	require.NoError(t, store.Set(ctx, "a", "1", time.Hour))
+	require.NoError(t, store.Set(ctx, "a", "2", time.Hour))
+	value, err := store.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "2", value)
+
+	owner, err := store.Claim(ctx, "a", "3", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "2", owner)
+	owner, err = store.Claim(ctx, "a", "2", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "2", owner)
+	owner, err = store.Claim(ctx, "c", "3", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "3", owner)
+
+	require.NoError(t, store.Set(ctx, "b", "3", -time.Second))
+	_, err = store.Get(ctx, "b")
+	require.ErrorIs(t, err, state.ErrNotFound)
+	deleted, err := store.DeleteExpired(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted, "the server expires entries")
+
+	wrongPassword, err := state.NewRedisStore("redis://:wrong@" + addr)
+	require.NoError(t, err)
+	_, err = wrongPassword.Get(ctx, "a")
+	require.ErrorContains(t, err, "WRONGPASS")
+}
+
+func TestNewRedisStore_InvalidURL(t *testing.T) {
+	t.Parallel()
+
+	for _, url := range []string{"http://localhost", "redis://localhost/db", "redis://localhost?unknown=1"} {
+		_, err := state.NewRedisStore(url)
+		require.ErrorContains(t, err, "invalid redis URL", url)
+	}
+}
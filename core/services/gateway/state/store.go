@@ -0,0 +1,68 @@
+// Package state holds the state which gateway replicas share to route
+// messages between them.
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+const (
+	// BackendMemory keeps the state in process memory, so it is only shared by
+	// gateways running in the same process.
+	BackendMemory = "memory"
+	// BackendPostgres keeps the state in the database of the node.
+	BackendPostgres = "postgres"
+	// BackendRedis keeps the state in a Redis-compatible server.
+	BackendRedis = "redis"
+)
+
+// maxClaimAttempts bounds the retries of backends whose claims race with the
+// expiry of the entry they conflict with.
+const maxClaimAttempts = 3
+
+// ErrNotFound is returned for keys which are not set, or have expired.
+var ErrNotFound = errors.New("key not found")
+
+// Store is a key value store whose entries expire. All methods are
+// thread-safe.
+type Store interface {
+	// Set sets the value of key, which expires after ttl.
+	Set(ctx context.Context, key string, value string, ttl time.Duration) error
+	// Claim sets the value of key, which expires after ttl, unless key holds
+	// another value. It returns the value key holds afterwards, so the claim
+	// succeeded if that is value.
+	Claim(ctx context.Context, key string, value string, ttl time.Duration) (string, error)
+	// Get returns the value of key, or ErrNotFound.
+	Get(ctx context.Context, key string) (string, error)
+	// DeleteExpired deletes expired entries and returns how many it deleted.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// NewStore returns a Store of the given backend, which defaults to
+// BackendPostgres. ds is only used by BackendPostgres, and url only by
+// BackendRedis.
+func NewStore(backend string, ds sqlutil.DataSource, url string) (Store, error) {
+	switch backend {
+	case BackendMemory:
+		return NewMemoryStore(clockwork.NewRealClock()), nil
+	case "", BackendPostgres:
+		if ds == nil {
+			return nil, errors.New("the postgres state backend requires a database")
+		}
+		return NewPostgresStore(ds), nil
+	case BackendRedis:
+		if url == "" {
+			return nil, errors.New("the redis state backend requires a URL")
+		}
+		return NewRedisStore(url)
+	default:
+		return nil, fmt.Errorf("unsupported state backend %q", backend)
+	}
+}
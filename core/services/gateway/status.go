@@ -0,0 +1,92 @@
+package gateway
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+)
+
+// Status is a snapshot of the DON connections and pending requests of a
+// gateway replica, which admins inspect to troubleshoot it.
+type Status struct {
+	// ReplicaID is empty unless replication is enabled.
+	ReplicaID string
+	DONs      []DONStatus
+}
+
+// DONStatus is the state of the connections and handlers of a DON.
+type DONStatus struct {
+	DonID string
+	Nodes []NodeStatus
+	// PendingHandshakes is the number of handshakes which nodes of the DON
+	// started, but have not finished yet.
+	PendingHandshakes int
+	Handlers          []HandlerStatus
+}
+
+// HandlerStatus is the state of a handler of a DON.
+type HandlerStatus struct {
+	Name string
+	// Status is nil if the handler does not implement handlers.StatusReporter.
+	Status *handlers.Status
+}
+
+func (g *gateway) Status() Status {
+	var status Status
+	g.handlersMu.RLock()
+	donHandlers := maps.Clone(g.handlers)
+	if g.config != nil {
+		status.ReplicaID = g.config.ReplicaConfig.ReplicaId
+	}
+	g.handlersMu.RUnlock()
+
+	for _, donID := range slices.Sorted(maps.Keys(donHandlers)) {
+		donStatus := DONStatus{
+			DonID:             donID,
+			PendingHandshakes: g.connMgr.PendingHandshakes(donID),
+			Handlers:          handlerStatuses(donHandlers[donID]),
+		}
+		if donConnMgr := g.connMgr.DONConnectionManager(donID); donConnMgr != nil {
+			donStatus.Nodes = donConnMgr.NodeStatuses()
+		}
+		status.DONs = append(status.DONs, donStatus)
+	}
+	return status
+}
+
+func (g *gateway) DisconnectNode(donID string, nodeAddress string) error {
+	return g.connMgr.DisconnectNode(donID, nodeAddress)
+}
+
+// handlerStatuses returns the status of each handler of a multiHandler, or of
+// handler itself, which is unnamed.
+func handlerStatuses(handler handlers.Handler) []HandlerStatus {
+	if m, ok := handler.(*multiHandler); ok {
+		return m.statuses()
+	}
+	return []HandlerStatus{handlerStatus("", handler)}
+}
+
+func handlerStatus(name string, handler handlers.Handler) HandlerStatus {
+	status := HandlerStatus{Name: name}
+	if reporter, ok := handler.(handlers.StatusReporter); ok {
+		handlerStatus := reporter.Status()
+		status.Status = &handlerStatus
+	}
+	return status
+}
+
+func (m *multiHandler) statuses() []HandlerStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	statuses := make([]HandlerStatus, 0, len(m.typeToHandler))
+	for name, handler := range m.typeToHandler {
+		statuses = append(statuses, handlerStatus(name, handler))
+	}
+	slices.SortFunc(statuses, func(a, b HandlerStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return statuses
+}
@@ -0,0 +1,274 @@
+package gateway
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+)
+
+const (
+	defaultJWKSRefreshIntervalSec = 300
+	jwksFetchTimeout              = 10 * time.Second
+	maxJWKSBytes                  = 1_000_000
+
+	apiKeyAuthScheme = "ApiKey "
+	jwtAuthScheme    = "Bearer "
+)
+
+var errUnauthenticated = errors.New("missing or unsupported credential")
+
+// userAuthenticator identifies the users of the gateway by the credential in
+// their network.UserAuthHeader: an API key from the config, or a JWT signed by
+// a key which JWKSURL serves. Users are identified by the name of their API
+// key, or by the subject of their JWTs.
+type userAuthenticator struct {
+	services.StateMachine
+	apiKeys         map[[sha256.Size]byte]string // key hash -> identity
+	jwksURL         string
+	refreshInterval time.Duration
+	parser          *jwt.Parser
+	client          *http.Client
+	keysMu          sync.RWMutex
+	keys            map[string]any // key ID -> public key
+	stopCh          services.StopChan
+	wg              sync.WaitGroup
+	lggr            logger.Logger
+}
+
+// userAuthEnabled returns true if the gateway authenticates its users.
+func userAuthEnabled(cfg *config.UserAuthConfig) bool {
+	return len(cfg.APIKeys) > 0 || cfg.JWKSURL != ""
+}
+
+func newUserAuthenticator(cfg *config.UserAuthConfig, lggr logger.Logger) (*userAuthenticator, error) {
+	apiKeys := make(map[[sha256.Size]byte]string, len(cfg.APIKeys))
+	for _, key := range cfg.APIKeys {
+		if key.Identity == "" {
+			return nil, errors.New("API key identity is required")
+		}
+		hash, err := hex.DecodeString(strings.TrimPrefix(key.KeyHash, "0x"))
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("API key hash of %s must be a hex-encoded SHA-256 hash", key.Identity)
+		}
+		if _, ok := apiKeys[[sha256.Size]byte(hash)]; ok {
+			return nil, fmt.Errorf("duplicate API key hash of %s", key.Identity)
+		}
+		apiKeys[[sha256.Size]byte(hash)] = key.Identity
+	}
+	refreshIntervalSec := cfg.JWKSRefreshIntervalSec
+	if refreshIntervalSec == 0 {
+		refreshIntervalSec = defaultJWKSRefreshIntervalSec
+	}
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithExpirationRequired(),
+	}
+	if cfg.JWTIssuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(cfg.JWTIssuer))
+	}
+	if cfg.JWTAudience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(cfg.JWTAudience))
+	}
+	return &userAuthenticator{
+		apiKeys:         apiKeys,
+		jwksURL:         cfg.JWKSURL,
+		refreshInterval: time.Duration(refreshIntervalSec) * time.Second,
+		parser:          jwt.NewParser(parserOpts...),
+		client:          &http.Client{Timeout: jwksFetchTimeout},
+		keys:            make(map[string]any),
+		stopCh:          make(services.StopChan),
+		lggr:            logger.Named(lggr, "UserAuthenticator"),
+	}, nil
+}
+
+func (a *userAuthenticator) Start(ctx context.Context) error {
+	return a.StartOnce("UserAuthenticator", func() error {
+		if a.jwksURL == "" {
+			return nil
+		}
+		// JWTs are rejected until the keys are fetched, which is retried
+		// periodically, rather than failing the gateway.
+		if err := a.refreshKeys(ctx); err != nil {
+			a.lggr.Errorw("failed to fetch JWKS", "url", a.jwksURL, "err", err)
+		}
+		a.wg.Add(1)
+		go a.refreshLoop()
+		return nil
+	})
+}
+
+func (a *userAuthenticator) Close() error {
+	return a.StopOnce("UserAuthenticator", func() error {
+		close(a.stopCh)
+		a.wg.Wait()
+		return nil
+	})
+}
+
+func (a *userAuthenticator) refreshLoop() {
+	defer a.wg.Done()
+	ctx, cancel := a.stopCh.NewCtx()
+	defer cancel()
+	ticker := time.NewTicker(a.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.stopCh:
+			return
+		case <-ticker.C:
+			if err := a.refreshKeys(ctx); err != nil {
+				a.lggr.Errorw("failed to refresh JWKS, keeping the previous keys", "url", a.jwksURL, "err", err)
+			}
+		}
+	}
+}
+
+// authenticate returns the identity of the user whose credential is given.
+func (a *userAuthenticator) authenticate(credential string) (string, error) {
+	switch {
+	case strings.HasPrefix(credential, apiKeyAuthScheme):
+		identity, ok := a.apiKeys[sha256.Sum256([]byte(strings.TrimPrefix(credential, apiKeyAuthScheme)))]
+		if !ok {
+			return "", errors.New("invalid API key")
+		}
+		return identity, nil
+	case strings.HasPrefix(credential, jwtAuthScheme) && a.jwksURL != "":
+		token, err := a.parser.Parse(strings.TrimPrefix(credential, jwtAuthScheme), a.verificationKey)
+		if err != nil {
+			return "", fmt.Errorf("invalid JWT: %w", err)
+		}
+		subject, err := token.Claims.GetSubject()
+		if err != nil || subject == "" {
+			return "", errors.New("invalid JWT: subject is required")
+		}
+		return subject, nil
+	default:
+		return "", errUnauthenticated
+	}
+}
+
+func (a *userAuthenticator) verificationKey(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	a.keysMu.RLock()
+	defer a.keysMu.RUnlock()
+	key, ok := a.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key ID %q", kid)
+	}
+	return key, nil
+}
+
+// jsonWebKey is a public key of a JSON Web Key Set, see RFC 7517.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// RSA keys
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC keys
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (a *userAuthenticator) refreshKeys(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.jwksURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, maxJWKSBytes)).Decode(&jwks); err != nil {
+		return fmt.Errorf("invalid JWKS: %w", err)
+	}
+	keys := make(map[string]any, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// other keys of the set remain usable
+			a.lggr.Warnw("skipping invalid key of JWKS", "kid", jwk.Kid, "err", err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	a.keysMu.Lock()
+	a.keys = keys
+	a.keysMu.Unlock()
+	return nil
+}
+
+func (k *jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) != size || len(y) != size {
+			return nil, errors.New("invalid coordinate length")
+		}
+		// validates that the point is on the curve
+		return ecdsa.ParseUncompressedPublicKey(curve, append(append([]byte{4}, x...), y...))
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
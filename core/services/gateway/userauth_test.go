@@ -0,0 +1,137 @@
+package gateway
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+)
+
+func TestUserAuthenticator_APIKeys(t *testing.T) {
+	t.Parallel()
+
+	hash := sha256.Sum256([]byte("alice-key"))
+	auth, err := newUserAuthenticator(&config.UserAuthConfig{
+		APIKeys: []config.APIKeyConfig{{Identity: "alice", KeyHash: hex.EncodeToString(hash[:])}},
+	}, logger.Test(t))
+	require.NoError(t, err)
+
+	identity, err := auth.authenticate("ApiKey alice-key")
+	require.NoError(t, err)
+	require.Equal(t, "alice", identity)
+
+	_, err = auth.authenticate("ApiKey bob-key")
+	require.ErrorContains(t, err, "invalid API key")
+	_, err = auth.authenticate("alice-key")
+	require.ErrorIs(t, err, errUnauthenticated)
+	// JWTs are rejected without a JWKS
+	_, err = auth.authenticate("Bearer token")
+	require.ErrorIs(t, err, errUnauthenticated)
+}
+
+func TestUserAuthenticator_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	hash := sha256.Sum256([]byte("key"))
+	for name, keys := range map[string][]config.APIKeyConfig{
+		"missing identity": {{KeyHash: hex.EncodeToString(hash[:])}},
+		"invalid hash":     {{Identity: "alice", KeyHash: "abcd"}},
+		"duplicate hash": {
+			{Identity: "alice", KeyHash: hex.EncodeToString(hash[:])},
+			{Identity: "bob", KeyHash: hex.EncodeToString(hash[:])},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := newUserAuthenticator(&config.UserAuthConfig{APIKeys: keys}, logger.Test(t))
+			require.Error(t, err)
+		})
+	}
+}
+
+func encodeJWKInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func TestUserAuthenticator_JWT(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwks := map[string][]jsonWebKey{"keys": {
+		{Kty: "RSA", Kid: "rsa", Use: "sig", N: encodeJWKInt(rsaKey.N), E: encodeJWKInt(big.NewInt(int64(rsaKey.E)))},
+		{Kty: "EC", Kid: "ec", Crv: "P-256", X: base64.RawURLEncoding.EncodeToString(ecKey.X.FillBytes(make([]byte, 32))), Y: base64.RawURLEncoding.EncodeToString(ecKey.Y.FillBytes(make([]byte, 32)))},
+		{Kty: "oct", Kid: "unsupported"},
+	}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(jwks)
+	}))
+	defer server.Close()
+
+	auth, err := newUserAuthenticator(&config.UserAuthConfig{
+		JWKSURL:     server.URL,
+		JWTIssuer:   "issuer",
+		JWTAudience: "gateway",
+	}, logger.Test(t))
+	require.NoError(t, err)
+	servicetest.Run(t, auth)
+
+	sign := func(method jwt.SigningMethod, kid string, key any, claims jwt.RegisteredClaims) string {
+		token := jwt.NewWithClaims(method, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return "Bearer " + signed
+	}
+	claims := jwt.RegisteredClaims{
+		Subject:   "alice",
+		Issuer:    "issuer",
+		Audience:  jwt.ClaimStrings{"gateway"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+
+	identity, err := auth.authenticate(sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims))
+	require.NoError(t, err)
+	require.Equal(t, "alice", identity)
+	identity, err = auth.authenticate(sign(jwt.SigningMethodES256, "ec", ecKey, claims))
+	require.NoError(t, err)
+	require.Equal(t, "alice", identity)
+
+	_, err = auth.authenticate(sign(jwt.SigningMethodRS256, "unknown", rsaKey, claims))
+	require.ErrorContains(t, err, "unknown key ID")
+	_, err = auth.authenticate(sign(jwt.SigningMethodES256, "rsa", ecKey, claims))
+	require.ErrorContains(t, err, "invalid JWT")
+
+	wrongIssuer := claims
+	wrongIssuer.Issuer = "other"
+	_, err = auth.authenticate(sign(jwt.SigningMethodRS256, "rsa", rsaKey, wrongIssuer))
+	require.ErrorContains(t, err, "invalid JWT")
+
+	expired := claims
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	_, err = auth.authenticate(sign(jwt.SigningMethodRS256, "rsa", rsaKey, expired))
+	require.ErrorContains(t, err, "token is expired")
+
+	noSubject := claims
+	noSubject.Subject = ""
+	_, err = auth.authenticate(sign(jwt.SigningMethodRS256, "rsa", rsaKey, noSubject))
+	require.ErrorContains(t, err, "subject is required")
+}
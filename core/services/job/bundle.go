@@ -0,0 +1,358 @@
+package job
+
+import (
+	"archive/tar"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+const bundleVersion = 1
+
+// Bundle is a set of job specs together with the bridges and external
+// initiators they reference, so that the jobs can be moved to another node.
+// Bridges and external initiators carry their credentials, and bridges their
+// security settings, so that existing adapters and initiators keep working
+// against the new node.
+type Bundle struct {
+	Specs              []string
+	Bridges            []bridges.BridgeType
+	ExternalInitiators []bridges.ExternalInitiator
+}
+
+// BundleJobError is returned when a job of a bundle can't be validated or created.
+type BundleJobError struct {
+	Index int
+	Err   error
+}
+
+func (e *BundleJobError) Error() string {
+	return fmt.Sprintf("job %d of bundle: %v", e.Index+1, e.Err)
+}
+
+// UnrecordedJobsError is returned when jobs created before their specs were
+// recorded are bundled. Their specs can't be reproduced, so they have to be
+// recreated, or left out of the bundle.
+type UnrecordedJobsError struct {
+	IDs []int32
+}
+
+func (e *UnrecordedJobsError) Error() string {
+	ids := make([]string, len(e.IDs))
+	for i, id := range e.IDs {
+		ids[i] = fmt.Sprint(id)
+	}
+	return fmt.Sprintf("jobs %s were created before their specs were recorded and can't be bundled; recreate them or leave them out", strings.Join(ids, ", "))
+}
+
+func (e *BundleJobError) Unwrap() error { return e.Err }
+
+type bundleManifest struct {
+	Version int      `json:"version"`
+	Jobs    []string `json:"jobs"`
+}
+
+type bundleBridge struct {
+	Name                   bridges.BridgeName `json:"name"`
+	URL                    models.WebURL      `json:"url"`
+	Confirmations          uint32             `json:"confirmations"`
+	IncomingTokenHash      string             `json:"incomingTokenHash"`
+	Salt                   string             `json:"salt"`
+	OutgoingToken          string             `json:"outgoingToken"`
+	MinimumContractPayment *assets.Link       `json:"minimumContractPayment"`
+	HealthCheckURL         *models.WebURL     `json:"healthCheckURL,omitempty"`
+	bridges.BridgeSecurity
+}
+
+type bundleExternalInitiator struct {
+	Name           string         `json:"name"`
+	URL            *models.WebURL `json:"url"`
+	AccessKey      string         `json:"accessKey"`
+	Salt           string         `json:"salt"`
+	HashedSecret   string         `json:"hashedSecret"`
+	OutgoingSecret string         `json:"outgoingSecret"`
+	OutgoingToken  string         `json:"outgoingToken"`
+}
+
+// NewBundle bundles the latest recorded spec of each job, along with the
+// bridges and external initiators the specs reference. If any job has no
+// recorded spec, having been created before revisions were recorded, an
+// *UnrecordedJobsError naming them is returned.
+func NewBundle(ctx context.Context, orm ORM, bridgeORM bridges.ORM, jobs []Job) (bundle Bundle, err error) {
+	jobs = append([]Job(nil), jobs...)
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+
+	bridgeNames := map[bridges.BridgeName]struct{}{}
+	eiNames := map[string]struct{}{}
+	var unrecorded []int32
+	for _, jb := range jobs {
+		revisions, err := orm.FindJobRevisions(ctx, jb.ExternalJobID)
+		if err != nil {
+			return bundle, err
+		}
+		if len(revisions) == 0 {
+			unrecorded = append(unrecorded, jb.ID)
+			continue
+		}
+		spec := revisions[len(revisions)-1].TOML
+		tree, err := toml.Load(spec)
+		if err != nil {
+			return bundle, errors.Wrapf(err, "job %d", jb.ID)
+		}
+		names, initiators, err := bundleReferences(tree)
+		if err != nil {
+			return bundle, errors.Wrapf(err, "job %d", jb.ID)
+		}
+		// Pin the external job ID, which on-chain requests and the Job
+		// Distributor refer to the job by.
+		if !tree.Has("externalJobID") {
+			spec = fmt.Sprintf("externalJobID = %q\n", jb.ExternalJobID) + spec
+		}
+		for _, name := range names {
+			bridgeNames[name] = struct{}{}
+		}
+		for _, name := range initiators {
+			eiNames[strings.ToLower(name)] = struct{}{}
+		}
+		bundle.Specs = append(bundle.Specs, spec)
+	}
+	if len(unrecorded) > 0 {
+		return Bundle{}, &UnrecordedJobsError{IDs: unrecorded}
+	}
+
+	for name := range bridgeNames {
+		bt, err := bridgeORM.FindBridge(ctx, name)
+		if err != nil {
+			return bundle, errors.Wrapf(err, "failed to find bridge %s", name)
+		}
+		bundle.Bridges = append(bundle.Bridges, bt)
+	}
+	sort.Slice(bundle.Bridges, func(i, j int) bool { return bundle.Bridges[i].Name < bundle.Bridges[j].Name })
+
+	for name := range eiNames {
+		ei, err := bridgeORM.FindExternalInitiatorByName(ctx, name)
+		if err != nil {
+			return bundle, errors.Wrapf(err, "failed to find external initiator %s", name)
+		}
+		bundle.ExternalInitiators = append(bundle.ExternalInitiators, ei)
+	}
+	sort.Slice(bundle.ExternalInitiators, func(i, j int) bool {
+		return bundle.ExternalInitiators[i].Name < bundle.ExternalInitiators[j].Name
+	})
+	return bundle, nil
+}
+
+// bundleReferences returns the bridges and external initiators referenced by a spec.
+func bundleReferences(tree *toml.Tree) (bridgeNames []bridges.BridgeName, eiNames []string, err error) {
+	var jb Job
+	if err = tree.Unmarshal(&jb); err != nil {
+		return nil, nil, err
+	}
+	for _, task := range jb.Pipeline.Tasks {
+		if bt, ok := task.(*pipeline.BridgeTask); ok {
+			name, err := bridges.ParseBridgeName(bt.Name)
+			if err != nil {
+				return nil, nil, err
+			}
+			bridgeNames = append(bridgeNames, name)
+		}
+	}
+	var webhook struct {
+		ExternalInitiators []struct {
+			Name string `toml:"name"`
+		} `toml:"externalInitiators"`
+	}
+	if err = tree.Unmarshal(&webhook); err != nil {
+		return nil, nil, err
+	}
+	for _, ei := range webhook.ExternalInitiators {
+		eiNames = append(eiNames, ei.Name)
+	}
+	return bridgeNames, eiNames, nil
+}
+
+// CreateDependencies creates the bridges and external initiators of the
+// bundle that don't exist yet. Existing ones are left unchanged.
+func (b Bundle) CreateDependencies(ctx context.Context, orm bridges.ORM) error {
+	for _, bt := range b.Bridges {
+		_, err := orm.FindBridge(ctx, bt.Name)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrapf(err, "failed to find bridge %s", bt.Name)
+		}
+		if err = orm.CreateBridgeType(ctx, &bt); err != nil {
+			return errors.Wrapf(err, "failed to create bridge %s", bt.Name)
+		}
+	}
+	for _, ei := range b.ExternalInitiators {
+		_, err := orm.FindExternalInitiatorByName(ctx, ei.Name)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrapf(err, "failed to find external initiator %s", ei.Name)
+		}
+		if err = orm.CreateExternalInitiator(ctx, &ei); err != nil {
+			return errors.Wrapf(err, "failed to create external initiator %s", ei.Name)
+		}
+	}
+	return nil
+}
+
+// Write writes the bundle as a tar archive.
+func (b Bundle) Write(w io.Writer) error {
+	tw := tar.NewWriter(w)
+	manifest := bundleManifest{Version: bundleVersion, Jobs: make([]string, len(b.Specs))}
+	for i := range b.Specs {
+		manifest.Jobs[i] = fmt.Sprintf("jobs/%04d.toml", i+1)
+	}
+	if err := writeBundleJSON(tw, "manifest.json", manifest); err != nil {
+		return err
+	}
+	for i, spec := range b.Specs {
+		if err := writeBundleFile(tw, manifest.Jobs[i], []byte(spec)); err != nil {
+			return err
+		}
+	}
+	for _, bt := range b.Bridges {
+		if err := writeBundleJSON(tw, "bridges/"+bt.Name.String()+".json", bundleBridge{
+			Name:                   bt.Name,
+			URL:                    bt.URL,
+			Confirmations:          bt.Confirmations,
+			IncomingTokenHash:      bt.IncomingTokenHash,
+			Salt:                   bt.Salt,
+			OutgoingToken:          bt.OutgoingToken,
+			MinimumContractPayment: bt.MinimumContractPayment,
+			HealthCheckURL:         bt.HealthCheckURL,
+			BridgeSecurity:         bt.BridgeSecurity,
+		}); err != nil {
+			return err
+		}
+	}
+	for _, ei := range b.ExternalInitiators {
+		if err := writeBundleJSON(tw, "external_initiators/"+strings.ToLower(ei.Name)+".json", bundleExternalInitiator{
+			Name:           ei.Name,
+			URL:            ei.URL,
+			AccessKey:      ei.AccessKey,
+			Salt:           ei.Salt,
+			HashedSecret:   ei.HashedSecret,
+			OutgoingSecret: ei.OutgoingSecret,
+			OutgoingToken:  ei.OutgoingToken,
+		}); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+func writeBundleJSON(tw *tar.Writer, name string, v any) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeBundleFile(tw, name, b)
+}
+
+func writeBundleFile(tw *tar.Writer, name string, b []byte) error {
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(b)), Typeflag: tar.TypeReg}); err != nil {
+		return err
+	}
+	_, err := tw.Write(b)
+	return err
+}
+
+// ReadBundle reads a bundle written by Bundle.Write.
+func ReadBundle(r io.Reader) (bundle Bundle, err error) {
+	files := map[string][]byte{}
+	var manifest *bundleManifest
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return bundle, errors.Wrap(err, "invalid bundle")
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return bundle, errors.Wrap(err, "invalid bundle")
+		}
+		name := path.Clean(hdr.Name)
+		switch dir := path.Dir(name); {
+		case name == "manifest.json":
+			manifest = new(bundleManifest)
+			if err = json.Unmarshal(b, manifest); err != nil {
+				return bundle, errors.Wrap(err, "invalid bundle manifest")
+			}
+		case dir == "jobs":
+			files[name] = b
+		case dir == "bridges":
+			var bt bundleBridge
+			if err = json.Unmarshal(b, &bt); err != nil {
+				return bundle, errors.Wrapf(err, "invalid bridge %s", name)
+			}
+			bundle.Bridges = append(bundle.Bridges, bridges.BridgeType{
+				Name:                   bt.Name,
+				URL:                    bt.URL,
+				Confirmations:          bt.Confirmations,
+				IncomingTokenHash:      bt.IncomingTokenHash,
+				Salt:                   bt.Salt,
+				OutgoingToken:          bt.OutgoingToken,
+				MinimumContractPayment: bt.MinimumContractPayment,
+				HealthCheckURL:         bt.HealthCheckURL,
+				BridgeSecurity:         bt.BridgeSecurity,
+			})
+		case dir == "external_initiators":
+			var ei bundleExternalInitiator
+			if err = json.Unmarshal(b, &ei); err != nil {
+				return bundle, errors.Wrapf(err, "invalid external initiator %s", name)
+			}
+			bundle.ExternalInitiators = append(bundle.ExternalInitiators, bridges.ExternalInitiator{
+				Name:           ei.Name,
+				URL:            ei.URL,
+				AccessKey:      ei.AccessKey,
+				Salt:           ei.Salt,
+				HashedSecret:   ei.HashedSecret,
+				OutgoingSecret: ei.OutgoingSecret,
+				OutgoingToken:  ei.OutgoingToken,
+			})
+		default:
+			return bundle, errors.Errorf("invalid bundle: unexpected file %s", hdr.Name)
+		}
+	}
+
+	if manifest == nil {
+		return bundle, errors.New("invalid bundle: missing manifest.json")
+	}
+	if manifest.Version != bundleVersion {
+		return bundle, errors.Errorf("unsupported bundle version %d, expected %d", manifest.Version, bundleVersion)
+	}
+	for _, name := range manifest.Jobs {
+		spec, ok := files[path.Clean(name)]
+		if !ok {
+			return bundle, errors.Errorf("invalid bundle: missing %s", name)
+		}
+		bundle.Specs = append(bundle.Specs, string(spec))
+	}
+	return bundle, nil
+}
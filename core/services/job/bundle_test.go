@@ -0,0 +1,123 @@
+package job_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	bridgemocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
+)
+
+func TestBundle(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	const spec = `
+type               = "webhook"
+schemaVersion      = 1
+externalInitiators = [{ name = "Chain-Watcher", spec = "{}" }]
+observationSource  = """
+fetch [type=bridge name="price-feed"];
+"""
+`
+	recorded := job.Job{ID: 2, ExternalJobID: uuid.New()}
+	unrecorded := job.Job{ID: 1, ExternalJobID: uuid.New()}
+	bridge := bridges.BridgeType{
+		Name:              "price-feed",
+		URL:               cltest.WebURL(t, "https://bridge.example.com"),
+		Confirmations:     2,
+		IncomingTokenHash: "hash",
+		Salt:              "salt",
+		OutgoingToken:     "outgoing",
+		HealthCheckURL:    cltest.MustWebURL(t, "https://bridge.example.com/health"),
+		BridgeSecurity: bridges.BridgeSecurity{
+			ClientCertificate:    "certificate",
+			ClientKey:            "key",
+			RequestSigningSecret: "0123456789abcdef0123456789abcdef",
+		},
+	}
+	ei := bridges.ExternalInitiator{
+		ID:             7,
+		Name:           "chain-watcher",
+		URL:            cltest.MustWebURL(t, "https://ei.example.com"),
+		AccessKey:      "access",
+		Salt:           "salt",
+		HashedSecret:   "hashed",
+		OutgoingSecret: "secret",
+		OutgoingToken:  "token",
+	}
+
+	orm := mocks.NewORM(t)
+	orm.On("FindJobRevisions", mock.Anything, recorded.ExternalJobID).Return([]job.JobRevision{{TOML: "outdated"}, {TOML: spec}}, nil)
+	orm.On("FindJobRevisions", mock.Anything, unrecorded.ExternalJobID).Return(nil, nil)
+	bridgeORM := bridgemocks.NewORM(t)
+	bridgeORM.On("FindBridge", mock.Anything, bridge.Name).Return(bridge, nil).Once()
+	bridgeORM.On("FindExternalInitiatorByName", mock.Anything, "chain-watcher").Return(ei, nil).Once()
+
+	_, err := job.NewBundle(ctx, orm, bridgeORM, []job.Job{recorded, unrecorded, {ID: 3, ExternalJobID: unrecorded.ExternalJobID}})
+	var unrecordedErr *job.UnrecordedJobsError
+	require.ErrorAs(t, err, &unrecordedErr)
+	assert.Equal(t, []int32{1, 3}, unrecordedErr.IDs)
+	assert.EqualError(t, err, "jobs 1, 3 were created before their specs were recorded and can't be bundled; recreate them or leave them out")
+
+	bundle, err := job.NewBundle(ctx, orm, bridgeORM, []job.Job{recorded})
+	require.NoError(t, err)
+	assert.Equal(t, []string{fmt.Sprintf("externalJobID = %q\n", recorded.ExternalJobID) + spec}, bundle.Specs)
+
+	var buf bytes.Buffer
+	require.NoError(t, bundle.Write(&buf))
+	read, err := job.ReadBundle(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, bundle.Specs, read.Specs)
+	assert.Equal(t, []bridges.BridgeType{bridge}, read.Bridges)
+	ei.ID = 0
+	assert.Equal(t, []bridges.ExternalInitiator{ei}, read.ExternalInitiators)
+
+	t.Run("creates missing dependencies only", func(t *testing.T) {
+		bridgeORM := bridgemocks.NewORM(t)
+		bridgeORM.On("FindBridge", mock.Anything, bridge.Name).Return(bridges.BridgeType{}, sql.ErrNoRows).Once()
+		bridgeORM.On("CreateBridgeType", mock.Anything, mock.MatchedBy(func(bt *bridges.BridgeType) bool {
+			return bt.Name == bridge.Name && bt.IncomingTokenHash == "hash" && bt.OutgoingToken == "outgoing"
+		})).Return(nil).Once()
+		bridgeORM.On("FindExternalInitiatorByName", mock.Anything, "chain-watcher").Return(ei, nil).Once()
+
+		require.NoError(t, read.CreateDependencies(ctx, bridgeORM))
+	})
+
+	t.Run("invalid bundles", func(t *testing.T) {
+		archive := func(files map[string]string) *bytes.Buffer {
+			var buf bytes.Buffer
+			tw := tar.NewWriter(&buf)
+			for name, content := range files {
+				require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+				_, err := tw.Write([]byte(content))
+				require.NoError(t, err)
+			}
+			require.NoError(t, tw.Close())
+			return &buf
+		}
+
+		_, err := job.ReadBundle(bytes.NewReader([]byte("not a tar archive")))
+		require.ErrorContains(t, err, "invalid bundle")
+		_, err = job.ReadBundle(archive(nil))
+		require.EqualError(t, err, "invalid bundle: missing manifest.json")
+		_, err = job.ReadBundle(archive(map[string]string{"manifest.json": `{"version": 2}`}))
+		require.EqualError(t, err, "unsupported bundle version 2, expected 1")
+		_, err = job.ReadBundle(archive(map[string]string{"manifest.json": `{"version": 1, "jobs": ["jobs/0001.toml"]}`}))
+		require.EqualError(t, err, "invalid bundle: missing jobs/0001.toml")
+		_, err = job.ReadBundle(archive(map[string]string{"manifest.json": `{"version": 1}`, "keys/eth.json": "{}"}))
+		require.EqualError(t, err, "invalid bundle: unexpected file keys/eth.json")
+	})
+}
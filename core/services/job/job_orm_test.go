@@ -725,6 +725,60 @@ func TestORM_CreateJob_OCRBootstrap(t *testing.T) {
 	cltest.AssertCount(t, db, "jobs", 0)
 }
 
+func TestORM_CreateJob_RecordsRevisions(t *testing.T) {
+	ctx := testutils.Context(t)
+	config := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	require.NoError(t, keyStore.OCR().Add(ctx, cltest.DefaultOCRKey))
+
+	lggr := logger.TestLogger(t)
+	pipelineORM := pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns())
+	bridgesORM := bridges.NewORM(db)
+	jobORM := NewTestORM(t, db, pipelineORM, bridgesORM, keyStore)
+
+	spec := testspecs.GetOCRBootstrapSpec()
+	jb, err := ocrbootstrap.ValidatedBootstrapSpecToml(spec)
+	require.NoError(t, err)
+	require.Empty(t, jb.TOML)
+
+	// jobs created without their spec have no revisions
+	require.NoError(t, jobORM.CreateJob(ctx, &jb))
+	cltest.AssertCount(t, db, "job_revisions", 0)
+	require.NoError(t, jobORM.DeleteJob(ctx, jb.ID, jb.Type))
+
+	externalJobID := jb.ExternalJobID
+	for i := range 2 {
+		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(spec)
+		require.NoError(t, err)
+		jb.ExternalJobID = externalJobID
+		jb.TOML = fmt.Sprintf("%s\n# revision %d", spec, i+1)
+		require.NoError(t, jobORM.CreateJob(ctx, &jb))
+		require.NoError(t, jobORM.DeleteJob(ctx, jb.ID, jb.Type))
+	}
+
+	// revisions outlive the job
+	revisions, err := jobORM.FindJobRevisions(ctx, externalJobID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	for i, revision := range revisions {
+		assert.Equal(t, int32(i+1), revision.Revision)
+		assert.Equal(t, externalJobID, revision.ExternalJobID)
+		assert.Equal(t, fmt.Sprintf("%s\n# revision %d", spec, i+1), revision.TOML)
+	}
+
+	revision, err := jobORM.FindJobRevision(ctx, externalJobID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, revisions[1], revision)
+
+	_, err = jobORM.FindJobRevision(ctx, externalJobID, 3)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	diff, err := job.DiffJobRevisions(revisions[0], revisions[1])
+	require.NoError(t, err)
+	assert.Contains(t, diff, "-# revision 1\n+# revision 2\n")
+}
+
 func TestORM_CreateJob_EVMChainID_Validation(t *testing.T) {
 	config := configtest.NewGeneralConfig(t, nil)
 	db := pgtest.NewSqlxDB(t)
@@ -164,7 +164,7 @@ func TestPipelineORM_Integration(t *testing.T) {
 			DB:             db,
 			KeyStore:       keyStore.Eth(),
 		})
-		runner := pipeline.NewRunner(orm, btORM, config.JobPipeline(), config.WebServer(), legacyChains, nil, nil, lggr, nil, nil)
+		runner := pipeline.NewRunner(orm, btORM, config.JobPipeline(), config.WebServer(), nil, legacyChains, nil, nil, lggr, nil, nil)
 
 		jobORM := NewTestORM(t, db, orm, btORM, keyStore)
 
@@ -831,6 +831,123 @@ func (_c *ORM_FindJobIDsWithBridge_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// FindJobRevision provides a mock function with given fields: ctx, externalJobID, revision
+func (_m *ORM) FindJobRevision(ctx context.Context, externalJobID uuid.UUID, revision int32) (job.JobRevision, error) {
+	ret := _m.Called(ctx, externalJobID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobRevision")
+	}
+
+	var r0 job.JobRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) (job.JobRevision, error)); ok {
+		return rf(ctx, externalJobID, revision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) job.JobRevision); ok {
+		r0 = rf(ctx, externalJobID, revision)
+	} else {
+		r0 = ret.Get(0).(job.JobRevision)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32) error); ok {
+		r1 = rf(ctx, externalJobID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindJobRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobRevision'
+type ORM_FindJobRevision_Call struct {
+	*mock.Call
+}
+
+// FindJobRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalJobID uuid.UUID
+//   - revision int32
+func (_e *ORM_Expecter) FindJobRevision(ctx interface{}, externalJobID interface{}, revision interface{}) *ORM_FindJobRevision_Call {
+	return &ORM_FindJobRevision_Call{Call: _e.mock.On("FindJobRevision", ctx, externalJobID, revision)}
+}
+
+func (_c *ORM_FindJobRevision_Call) Run(run func(ctx context.Context, externalJobID uuid.UUID, revision int32)) *ORM_FindJobRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_FindJobRevision_Call) Return(_a0 job.JobRevision, _a1 error) *ORM_FindJobRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindJobRevision_Call) RunAndReturn(run func(context.Context, uuid.UUID, int32) (job.JobRevision, error)) *ORM_FindJobRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindJobRevisions provides a mock function with given fields: ctx, externalJobID
+func (_m *ORM) FindJobRevisions(ctx context.Context, externalJobID uuid.UUID) ([]job.JobRevision, error) {
+	ret := _m.Called(ctx, externalJobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobRevisions")
+	}
+
+	var r0 []job.JobRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]job.JobRevision, error)); ok {
+		return rf(ctx, externalJobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []job.JobRevision); ok {
+		r0 = rf(ctx, externalJobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.JobRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, externalJobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindJobRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobRevisions'
+type ORM_FindJobRevisions_Call struct {
+	*mock.Call
+}
+
+// FindJobRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalJobID uuid.UUID
+func (_e *ORM_Expecter) FindJobRevisions(ctx interface{}, externalJobID interface{}) *ORM_FindJobRevisions_Call {
+	return &ORM_FindJobRevisions_Call{Call: _e.mock.On("FindJobRevisions", ctx, externalJobID)}
+}
+
+func (_c *ORM_FindJobRevisions_Call) Run(run func(ctx context.Context, externalJobID uuid.UUID)) *ORM_FindJobRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ORM_FindJobRevisions_Call) Return(_a0 []job.JobRevision, _a1 error) *ORM_FindJobRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindJobRevisions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]job.JobRevision, error)) *ORM_FindJobRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindJobWithoutSpecErrors provides a mock function with given fields: ctx, id
 func (_m *ORM) FindJobWithoutSpecErrors(ctx context.Context, id int32) (job.Job, error) {
 	ret := _m.Called(ctx, id)
@@ -1694,6 +1811,54 @@ func (_c *ORM_RecordError_Call) RunAndReturn(run func(context.Context, int32, st
 	return _c
 }
 
+// SetJobPaused provides a mock function with given fields: ctx, id, paused
+func (_m *ORM) SetJobPaused(ctx context.Context, id int32, paused bool) error {
+	ret := _m.Called(ctx, id, paused)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobPaused")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, bool) error); ok {
+		r0 = rf(ctx, id, paused)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_SetJobPaused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobPaused'
+type ORM_SetJobPaused_Call struct {
+	*mock.Call
+}
+
+// SetJobPaused is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+//   - paused bool
+func (_e *ORM_Expecter) SetJobPaused(ctx interface{}, id interface{}, paused interface{}) *ORM_SetJobPaused_Call {
+	return &ORM_SetJobPaused_Call{Call: _e.mock.On("SetJobPaused", ctx, id, paused)}
+}
+
+func (_c *ORM_SetJobPaused_Call) Run(run func(ctx context.Context, id int32, paused bool)) *ORM_SetJobPaused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ORM_SetJobPaused_Call) Return(_a0 error) *ORM_SetJobPaused_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_SetJobPaused_Call) RunAndReturn(run func(context.Context, int32, bool) error) *ORM_SetJobPaused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TryRecordError provides a mock function with given fields: ctx, jobID, description
 func (_m *ORM) TryRecordError(ctx context.Context, jobID int32, description string) {
 	_m.Called(ctx, jobID, description)
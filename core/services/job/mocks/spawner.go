@@ -304,6 +304,53 @@ func (_c *Spawner_Name_Call) RunAndReturn(run func() string) *Spawner_Name_Call
 	return _c
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type Spawner_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Spawner_Expecter) PauseJob(ctx interface{}, jobID interface{}) *Spawner_PauseJob_Call {
+	return &Spawner_PauseJob_Call{Call: _e.mock.On("PauseJob", ctx, jobID)}
+}
+
+func (_c *Spawner_PauseJob_Call) Run(run func(ctx context.Context, jobID int32)) *Spawner_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_PauseJob_Call) Return(_a0 error) *Spawner_PauseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_PauseJob_Call) RunAndReturn(run func(context.Context, int32) error) *Spawner_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Ready provides a mock function with no fields
 func (_m *Spawner) Ready() error {
 	ret := _m.Called()
@@ -349,6 +396,53 @@ func (_c *Spawner_Ready_Call) RunAndReturn(run func() error) *Spawner_Ready_Call
 	return _c
 }
 
+// ResumeJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) ResumeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type Spawner_ResumeJob_Call struct {
+	*mock.Call
+}
+
+// ResumeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Spawner_Expecter) ResumeJob(ctx interface{}, jobID interface{}) *Spawner_ResumeJob_Call {
+	return &Spawner_ResumeJob_Call{Call: _e.mock.On("ResumeJob", ctx, jobID)}
+}
+
+func (_c *Spawner_ResumeJob_Call) Run(run func(ctx context.Context, jobID int32)) *Spawner_ResumeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_ResumeJob_Call) Return(_a0 error) *Spawner_ResumeJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_ResumeJob_Call) RunAndReturn(run func(context.Context, int32) error) *Spawner_ResumeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Spawner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -395,6 +489,53 @@ func (_c *Spawner_Start_Call) RunAndReturn(run func(context.Context) error) *Spa
 	return _c
 }
 
+// StartCreatedJob provides a mock function with given fields: ctx, jb
+func (_m *Spawner) StartCreatedJob(ctx context.Context, jb job.Job) error {
+	ret := _m.Called(ctx, jb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartCreatedJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job) error); ok {
+		r0 = rf(ctx, jb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_StartCreatedJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartCreatedJob'
+type Spawner_StartCreatedJob_Call struct {
+	*mock.Call
+}
+
+// StartCreatedJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb job.Job
+func (_e *Spawner_Expecter) StartCreatedJob(ctx interface{}, jb interface{}) *Spawner_StartCreatedJob_Call {
+	return &Spawner_StartCreatedJob_Call{Call: _e.mock.On("StartCreatedJob", ctx, jb)}
+}
+
+func (_c *Spawner_StartCreatedJob_Call) Run(run func(ctx context.Context, jb job.Job)) *Spawner_StartCreatedJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.Job))
+	})
+	return _c
+}
+
+func (_c *Spawner_StartCreatedJob_Call) Return(_a0 error) *Spawner_StartCreatedJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_StartCreatedJob_Call) RunAndReturn(run func(context.Context, job.Job) error) *Spawner_StartCreatedJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartService provides a mock function with given fields: ctx, spec
 func (_m *Spawner) StartService(ctx context.Context, spec job.Job) error {
 	ret := _m.Called(ctx, spec)
@@ -442,6 +583,54 @@ func (_c *Spawner_StartService_Call) RunAndReturn(run func(context.Context, job.
 	return _c
 }
 
+// UpdateJob provides a mock function with given fields: ctx, jobID, jb
+func (_m *Spawner) UpdateJob(ctx context.Context, jobID int32, jb *job.Job) error {
+	ret := _m.Called(ctx, jobID, jb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, *job.Job) error); ok {
+		r0 = rf(ctx, jobID, jb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_UpdateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJob'
+type Spawner_UpdateJob_Call struct {
+	*mock.Call
+}
+
+// UpdateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - jb *job.Job
+func (_e *Spawner_Expecter) UpdateJob(ctx interface{}, jobID interface{}, jb interface{}) *Spawner_UpdateJob_Call {
+	return &Spawner_UpdateJob_Call{Call: _e.mock.On("UpdateJob", ctx, jobID, jb)}
+}
+
+func (_c *Spawner_UpdateJob_Call) Run(run func(ctx context.Context, jobID int32, jb *job.Job)) *Spawner_UpdateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(*job.Job))
+	})
+	return _c
+}
+
+func (_c *Spawner_UpdateJob_Call) Return(_a0 error) *Spawner_UpdateJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_UpdateJob_Call) RunAndReturn(run func(context.Context, int32, *job.Job) error) *Spawner_UpdateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSpawner creates a new instance of Spawner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSpawner(t interface {
@@ -212,6 +212,10 @@ type Job struct {
 	MaxTaskDuration               sqlutil.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt                     time.Time
+	// Paused jobs keep their spec and history but are not started by the spawner until resumed.
+	Paused bool `toml:"-"`
+	// TOML is the spec the job was created from. If set, creating the job records it as a new revision.
+	TOML string `toml:"-" db:"-"`
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -52,6 +52,8 @@ type ORM interface {
 	FindOCR2JobIDByAddress(ctx context.Context, relay string, chainID int64, contractID string, feedID *common.Hash) (int32, error)
 	FindJobIDsWithBridge(ctx context.Context, name string) ([]int32, error)
 	DeleteJob(ctx context.Context, id int32, jobType Type) error
+	// SetJobPaused records whether a job is paused. It returns sql.ErrNoRows if the job does not exist.
+	SetJobPaused(ctx context.Context, id int32, paused bool) error
 	RecordError(ctx context.Context, jobID int32, description string) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
 	TryRecordError(ctx context.Context, jobID int32, description string)
@@ -83,6 +85,10 @@ type ORM interface {
 	FindGatewayJobID(ctx context.Context, spec GatewaySpec) (int32, error)
 
 	FindJobIDByStreamID(ctx context.Context, streamID uint32) (int32, error)
+
+	// FindJobRevisions returns the revisions of a job, oldest first.
+	FindJobRevisions(ctx context.Context, externalJobID uuid.UUID) ([]JobRevision, error)
+	FindJobRevision(ctx context.Context, externalJobID uuid.UUID, revision int32) (JobRevision, error)
 }
 
 type ORMConfig interface {
@@ -544,7 +550,14 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 
 		err = tx.InsertJob(ctx, jb)
 		jobID = jb.ID
-		return errors.Wrap(err, "failed to insert job")
+		if err != nil {
+			return errors.Wrap(err, "failed to insert job")
+		}
+
+		if jb.TOML == "" {
+			return nil
+		}
+		return tx.insertJobRevision(ctx, jb)
 	})
 	if err != nil {
 		return errors.Wrap(err, "CreateJobFailed")
@@ -768,18 +781,18 @@ func (o *orm) InsertJob(ctx context.Context, job *Job) error {
 		if job.ID == 0 {
 			query = `INSERT INTO jobs (name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cre_settings_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, ccv_committee_verifier_spec_id, ccv_executor_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, ccv_committee_verifier_spec_id, ccv_executor_spec_id, external_job_id, gas_limit, forwarding_allowed, paused, created_at)
 		VALUES (:name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cre_settings_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :ccv_committee_verifier_spec_id, :ccv_executor_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :ccv_committee_verifier_spec_id, :ccv_executor_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :paused, NOW())
 		RETURNING *;`
 		} else {
 			query = `INSERT INTO jobs (id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 			keeper_spec_id, cre_settings_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, ccv_committee_verifier_spec_id, ccv_executor_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, ccv_committee_verifier_spec_id, ccv_executor_spec_id, external_job_id, gas_limit, forwarding_allowed, paused, created_at)
 		VALUES (:id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cre_settings_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :ccv_committee_verifier_spec_id, :ccv_executor_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :ccv_committee_verifier_spec_id, :ccv_executor_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :paused, NOW())
 		RETURNING *;`
 		}
 		query, args, err := tx.ds.BindNamed(query, job)
@@ -798,6 +811,26 @@ func (o *orm) InsertJob(ctx context.Context, job *Job) error {
 	})
 }
 
+// insertJobRevision records the spec of a newly created job as the next revision of its external job ID.
+func (o *orm) insertJobRevision(ctx context.Context, jb *Job) error {
+	sql := `INSERT INTO job_revisions (external_job_id, revision, job_id, toml, created_at)
+		SELECT $1, COALESCE(MAX(revision), 0) + 1, $2, $3, NOW() FROM job_revisions WHERE external_job_id = $1;`
+	_, err := o.ds.ExecContext(ctx, sql, jb.ExternalJobID, jb.ID, jb.TOML)
+	return errors.Wrap(err, "failed to insert job revision")
+}
+
+func (o *orm) FindJobRevisions(ctx context.Context, externalJobID uuid.UUID) (revisions []JobRevision, err error) {
+	sql := `SELECT * FROM job_revisions WHERE external_job_id = $1 ORDER BY revision ASC;`
+	err = o.ds.SelectContext(ctx, &revisions, sql, externalJobID)
+	return revisions, errors.Wrap(err, "FindJobRevisions failed")
+}
+
+func (o *orm) FindJobRevision(ctx context.Context, externalJobID uuid.UUID, revision int32) (jr JobRevision, err error) {
+	sql := `SELECT * FROM job_revisions WHERE external_job_id = $1 AND revision = $2;`
+	err = o.ds.GetContext(ctx, &jr, sql, externalJobID, revision)
+	return jr, errors.Wrap(err, "FindJobRevision failed")
+}
+
 // DeleteJob removes a job
 func (o *orm) DeleteJob(ctx context.Context, id int32, jobType Type) error {
 	o.lggr.Debugw("Deleting job", "jobID", id)
@@ -880,6 +913,21 @@ func (o *orm) DeleteJob(ctx context.Context, id int32, jobType Type) error {
 	return nil
 }
 
+func (o *orm) SetJobPaused(ctx context.Context, id int32, paused bool) error {
+	res, err := o.ds.ExecContext(ctx, `UPDATE jobs SET paused = $2 WHERE id = $1`, id, paused)
+	if err != nil {
+		return errors.Wrap(err, "SetJobPaused failed")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "SetJobPaused failed")
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 func (o *orm) RecordError(ctx context.Context, jobID int32, description string) error {
 	sql := `INSERT INTO job_spec_errors (job_id, description, occurrences, created_at, updated_at)
 	VALUES ($1, $2, 1, $3, $3)
@@ -0,0 +1,32 @@
+package job
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// JobRevision is an immutable record of the spec a job was created or updated
+// with. Revisions are numbered from 1 per external job ID.
+type JobRevision struct {
+	ID            int64
+	ExternalJobID uuid.UUID
+	Revision      int32
+	JobID         int32
+	TOML          string `db:"toml"`
+	CreatedAt     time.Time
+}
+
+// DiffJobRevisions returns the unified diff between the specs of two
+// revisions, or an empty string if they are identical.
+func DiffJobRevisions(from, to JobRevision) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.TOML),
+		B:        difflib.SplitLines(to.TOML),
+		FromFile: fmt.Sprintf("revision %d", from.Revision),
+		ToFile:   fmt.Sprintf("revision %d", to.Revision),
+		Context:  3,
+	})
+}
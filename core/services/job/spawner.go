@@ -26,10 +26,22 @@ type (
 		// CreateJob creates a new job and starts services.
 		// All services must start without errors for the job to be active.
 		CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (err error)
+		// StartCreatedJob starts a job that was inserted with ORM.CreateJob as
+		// part of a larger transaction, once that transaction has committed.
+		StartCreatedJob(ctx context.Context, jb Job) error
 		// DeleteJob deletes a job and stops any active services.
 		DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
+		// UpdateJob replaces the job with ID jobID by jb, like DeleteJob followed
+		// by CreateJob. If the delegate of the job is a SpecUpdater, the running
+		// services of the job are given the new spec instead of being restarted.
+		UpdateJob(ctx context.Context, jobID int32, jb *Job) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
+		// PauseJob stops the services of a job without deleting it. The job stays
+		// paused across restarts until ResumeJob is called.
+		PauseJob(ctx context.Context, jobID int32) error
+		// ResumeJob starts the services of a paused job.
+		ResumeJob(ctx context.Context, jobID int32) error
 
 		// StartService starts services for the given job spec.
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
@@ -76,6 +88,15 @@ type (
 		OnDeleteJob(ctx context.Context, jb Job) error
 	}
 
+	// SpecUpdater is implemented by delegates whose services can apply an
+	// updated spec while they run, e.g. without dropping their connections.
+	SpecUpdater interface {
+		// UpdateServices applies spec to the services created for an earlier
+		// spec of the same job. If it returns an error, the services are
+		// restarted with spec instead.
+		UpdateServices(ctx context.Context, spec Job, services []ServiceCtx) error
+	}
+
 	activeJob struct {
 		delegate Delegate
 		spec     Job
@@ -134,6 +155,20 @@ func (js *spawner) startAllServices(ctx context.Context) {
 		return
 	}
 
+	var pausedIDs []int32
+	active := jbs[:0]
+	for _, jb := range jbs {
+		if jb.Paused {
+			pausedIDs = append(pausedIDs, jb.ID)
+			continue
+		}
+		active = append(active, jb)
+	}
+	jbs = active
+	if len(pausedIDs) > 0 {
+		js.lggr.Infow("Not starting paused jobs", "jobIDs", pausedIDs)
+	}
+
 	jobIDs := make([]int32, len(jbs))
 	for i, jb := range jbs {
 		jobIDs[i] = jb.ID
@@ -268,15 +303,29 @@ func (js *spawner) CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job
 	}
 	js.lggr.Infow("Created job", "type", jb.Type, "jobID", jb.ID)
 
-	delegate.BeforeJobCreated(*jb)
-	err = js.StartService(ctx, *jb)
-	if err != nil {
+	return js.startCreatedJob(ctx, delegate, *jb)
+}
+
+// Should not get called before Start()
+func (js *spawner) StartCreatedJob(ctx context.Context, jb Job) error {
+	delegate, exists := js.jobTypeDelegates[jb.Type]
+	if !exists {
+		return pkgerrors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
+	}
+	return js.startCreatedJob(ctx, delegate, jb)
+}
+
+func (js *spawner) startCreatedJob(ctx context.Context, delegate Delegate, jb Job) (err error) {
+	delegate.BeforeJobCreated(jb)
+	if jb.Paused {
+		js.lggr.Infow("Not starting services of paused job", "type", jb.Type, "jobID", jb.ID)
+	} else if err = js.StartService(ctx, jb); err != nil {
 		js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "err", err)
 	} else {
 		js.lggr.Infow("Started job services", "type", jb.Type, "jobID", jb.ID)
 	}
 
-	delegate.AfterJobCreated(*jb)
+	delegate.AfterJobCreated(jb)
 
 	return err
 }
@@ -346,6 +395,96 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 	return err
 }
 
+// Should not get called before Start()
+func (js *spawner) UpdateJob(ctx context.Context, jobID int32, jb *Job) error {
+	delegate, exists := js.jobTypeDelegates[jb.Type]
+	if !exists {
+		return pkgerrors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
+	}
+	js.activeJobsMu.RLock()
+	aj, active := js.activeJobs[jobID]
+	js.activeJobsMu.RUnlock()
+	updater, ok := delegate.(SpecUpdater)
+	if !ok || !active || len(aj.services) == 0 || aj.spec.Type != jb.Type || jb.Paused {
+		if err := js.DeleteJob(ctx, nil, jobID); err != nil {
+			return err
+		}
+		return js.CreateJob(ctx, nil, jb)
+	}
+
+	lggr := js.lggr.With("jobID", jobID)
+	err := sqlutil.Transact(ctx, js.orm.WithDataSource, js.orm.DataSource(), nil, func(tx ORM) error {
+		if err := tx.DeleteJob(ctx, jobID, aj.spec.Type); err != nil {
+			return err
+		}
+		if err := aj.delegate.OnDeleteJob(ctx, aj.spec); err != nil {
+			return err
+		}
+		return tx.CreateJob(ctx, jb)
+	})
+	if err != nil {
+		lggr.Errorw("Error updating job", "err", err)
+		return err
+	}
+	// The old spec is only let go of once the update is committed, as the
+	// services keep running it if the update fails.
+	aj.delegate.BeforeJobDeleted(aj.spec)
+	delegate.BeforeJobCreated(*jb)
+	defer delegate.AfterJobCreated(*jb)
+
+	if err = updater.UpdateServices(ctx, *jb, aj.services); err != nil {
+		lggr.Warnw("Restarting job services, which could not apply the updated spec", "newJobID", jb.ID, "err", err)
+		js.stopService(jobID)
+		if err = js.StartService(ctx, *jb); err != nil {
+			lggr.Errorw("Error starting job services", "type", jb.Type, "newJobID", jb.ID, "err", err)
+		}
+		return err
+	}
+
+	js.activeJobsMu.Lock()
+	delete(js.activeJobs, jobID)
+	js.activeJobs[jb.ID] = activeJob{delegate: delegate, spec: *jb, services: aj.services}
+	js.activeJobsMu.Unlock()
+	lggr.Infow("Updated job services in place", "type", jb.Type, "newJobID", jb.ID)
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) PauseJob(ctx context.Context, jobID int32) error {
+	if err := js.orm.SetJobPaused(ctx, jobID, true); err != nil {
+		return pkgerrors.Wrapf(err, "failed to pause job %d", jobID)
+	}
+	// Recorded before stopping, so the job stays stopped across restarts even if
+	// closing one of its services fails.
+	js.stopService(jobID)
+	js.lggr.Infow("Paused job", "jobID", jobID)
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) ResumeJob(ctx context.Context, jobID int32) error {
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "job %d not found", jobID)
+	}
+	if _, active := js.ActiveJobs()[jobID]; active {
+		return nil
+	}
+	// The job stays paused unless its services started, so that a failed resume
+	// is not retried on every restart.
+	if err = js.StartService(ctx, jb); err != nil {
+		js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "err", err)
+		js.stopService(jobID)
+		return err
+	}
+	if err = js.orm.SetJobPaused(ctx, jobID, false); err != nil {
+		js.stopService(jobID)
+		return pkgerrors.Wrapf(err, "failed to resume job %d", jobID)
+	}
+	js.lggr.Infow("Resumed job", "jobID", jobID)
+	return nil
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
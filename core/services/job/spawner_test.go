@@ -2,6 +2,8 @@ package job_test
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"testing"
 	"time"
 
@@ -60,6 +62,25 @@ func (d delegate) ServicesForSpec(ctx context.Context, js job.Job) ([]job.Servic
 	return d.services, nil
 }
 
+// updatingDelegate applies updated specs to running services, or fails to if
+// err is set.
+type updatingDelegate struct {
+	*delegate
+	updates int
+	deleted int
+	err     error
+}
+
+func (d *updatingDelegate) BeforeJobDeleted(spec job.Job) {
+	d.deleted++
+	d.delegate.BeforeJobDeleted(spec)
+}
+
+func (d *updatingDelegate) UpdateServices(_ context.Context, _ job.Job, _ []job.ServiceCtx) error {
+	d.updates++
+	return d.err
+}
+
 func clearDB(t *testing.T, db *sqlx.DB) {
 	cltest.ClearDBTables(t, db, "jobs", "pipeline_runs", "pipeline_specs", "pipeline_task_runs")
 }
@@ -280,6 +301,121 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		clearDB(t, db)
 	})
 
+	t.Run("pauses and resumes job services, and keeps paused jobs stopped on restart", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Twice()
+		serviceA1.On("Close").Return(nil).Twice()
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1}, 0, nil, d}
+		newSpawner := func() job.Spawner {
+			return job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+				jobA.Type: delegateA,
+			}, lggr, nil)
+		}
+
+		ctx := testutils.Context(t)
+		require.NoError(t, orm.CreateJob(ctx, jobA))
+		delegateA.jobID = jobA.ID
+
+		spawner := newSpawner()
+		require.NoError(t, spawner.Start(ctx))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		require.NoError(t, spawner.PauseJob(ctx, jobA.ID))
+		assert.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err := orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.Paused)
+		require.ErrorIs(t, spawner.PauseJob(ctx, jobA.ID+1000), sql.ErrNoRows)
+		require.NoError(t, spawner.Close())
+
+		// a job whose services fail to start stays paused
+		failingService := mocks.NewServiceCtx(t)
+		failingService.On("Start", mock.Anything).Return(errors.New("failed to start")).Once()
+		delegateA.services = []job.ServiceCtx{failingService}
+		spawner = newSpawner()
+		require.NoError(t, spawner.Start(ctx))
+		require.Error(t, spawner.ResumeJob(ctx, jobA.ID))
+		assert.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err = orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.Paused)
+		require.NoError(t, spawner.Close())
+		delegateA.services = []job.ServiceCtx{serviceA1}
+
+		spawner = newSpawner()
+		require.NoError(t, spawner.Start(ctx))
+		assert.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+
+		require.NoError(t, spawner.ResumeJob(ctx, jobA.ID))
+		assert.Contains(t, spawner.ActiveJobs(), jobA.ID)
+		// resuming a job that is already running does not start it twice
+		require.NoError(t, spawner.ResumeJob(ctx, jobA.ID))
+		jb, err = orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.False(t, jb.Paused)
+		require.NoError(t, spawner.Close())
+
+		clearDB(t, db)
+	})
+
+	t.Run("updates job services in place, and restarts them if that fails", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &updatingDelegate{delegate: &delegate{jobA.Type, []job.ServiceCtx{serviceA1}, 0, nil, d}}
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, lggr, nil)
+
+		ctx := testutils.Context(t)
+		require.NoError(t, orm.CreateJob(ctx, jobA))
+		delegateA.jobID = jobA.ID
+		require.NoError(t, spawner.Start(ctx))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		updated := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		require.NoError(t, spawner.UpdateJob(ctx, jobA.ID, updated))
+		assert.Equal(t, 1, delegateA.updates)
+		assert.Equal(t, 1, delegateA.deleted)
+		assert.Equal(t, jobA.ID, updated.ID)
+		assert.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		// an update which fails to be saved leaves the running job alone
+		invalid := makeOCRJobSpec(t, address, bridge.Name.String(), "nonexistent")
+		require.Error(t, spawner.UpdateJob(ctx, jobA.ID, invalid))
+		assert.Equal(t, 1, delegateA.updates)
+		assert.Equal(t, 1, delegateA.deleted)
+		assert.Contains(t, spawner.ActiveJobs(), jobA.ID)
+		_, err := orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+
+		delegateA.err = errors.New("restart required")
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		updated = makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		require.Error(t, spawner.UpdateJob(ctx, jobA.ID, updated))
+		assert.Equal(t, 2, delegateA.updates)
+		assert.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		serviceA1.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.Close())
+
+		clearDB(t, db)
+	})
+
 	t.Run("Unregisters filters on 'DeleteJob()'", func(t *testing.T) {
 		config = configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 			c.Feature.LogPoller = func(b bool) *bool { return &b }(true)
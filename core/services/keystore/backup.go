@@ -0,0 +1,264 @@
+package keystore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"time"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const backupVersion = 1
+
+var (
+	ErrBackupManifestMismatch   = errors.New("backup contents do not match its manifest")
+	ErrBackupPassphraseMismatch = errors.New("backup passphrase did not match")
+)
+
+// Backup is an encrypted copy of the whole key ring and the eth key states.
+// The manifest is kept in the clear, so a bundle can be inspected without
+// its passphrase.
+type Backup struct {
+	Version   int
+	CreatedAt time.Time
+	Manifest  BackupManifest
+	Crypto    gethkeystore.CryptoJSON
+}
+
+// BackupManifest lists the contents of a Backup.
+type BackupManifest struct {
+	// Keys holds the key IDs by key ring field, as returned by GetFieldNameForKey.
+	// Keys held by an external signer are listed under External.
+	Keys         map[string][]string
+	EthKeyStates int
+	// SHA256 is the hex encoded digest of the decrypted payload.
+	SHA256 string
+}
+
+type backupPayload struct {
+	Keys         rawKeyRing
+	EthKeyStates []ethkey.State
+}
+
+// Backup returns the key ring and eth key states encrypted under passphrase.
+// Legacy keys which are no longer supported by the node are not included.
+func (km *keyManager) Backup(passphrase string) ([]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	if km.isLocked() {
+		return nil, ErrLocked
+	}
+	if err := utils.VerifyPasswordComplexity(passphrase); err != nil {
+		return nil, errors.Wrap(err, "backup passphrase is too weak")
+	}
+
+	rawKeys, err := km.keyRing.raw()
+	if err != nil {
+		return nil, err
+	}
+	payload := backupPayload{Keys: rawKeys}
+	for _, state := range km.keyStates.All {
+		payload.EthKeyStates = append(payload.EthKeyStates, *state)
+	}
+	decrypted, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	kr, err := payload.Keys.keys()
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(decrypted)
+
+	crypto, err := gethkeystore.EncryptDataV3(decrypted, []byte(adulteratedBackupPassphrase(passphrase)), km.scryptParams.N, km.scryptParams.P)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt backup")
+	}
+	return json.Marshal(Backup{
+		Version:   backupVersion,
+		CreatedAt: time.Now().UTC(),
+		Manifest: BackupManifest{
+			Keys:         kr.ids(),
+			EthKeyStates: len(payload.EthKeyStates),
+			SHA256:       hex.EncodeToString(digest[:]),
+		},
+		Crypto: crypto,
+	})
+}
+
+// Restore adds the keys and eth key states in backup which are missing from
+// the key ring. Keys already in the key ring are left untouched. It returns a
+// manifest of what was added, carrying the digest of the backup.
+func (km *keyManager) Restore(ctx context.Context, backup []byte, passphrase string) (BackupManifest, error) {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return BackupManifest{}, ErrLocked
+	}
+	payload, digest, err := decryptBackup(backup, passphrase)
+	if err != nil {
+		return BackupManifest{}, err
+	}
+	restored, err := payload.Keys.keys()
+	if err != nil {
+		return BackupManifest{}, err
+	}
+	if err = km.loadExternalKeys(ctx, restored); err != nil {
+		return BackupManifest{}, errors.Wrap(err, "unable to load external keys")
+	}
+
+	added := newKeyRing()
+	keyRing := reflect.Indirect(reflect.ValueOf(km.keyRing))
+	from := reflect.Indirect(reflect.ValueOf(restored))
+	to := reflect.Indirect(reflect.ValueOf(added))
+	for i := 0; i < from.NumField(); i++ {
+		field := from.Type().Field(i).Name
+		if from.Field(i).Kind() != reflect.Map || field == "External" {
+			continue
+		}
+		iter := from.Field(i).MapRange()
+		for iter.Next() {
+			if keyRing.Field(i).MapIndex(iter.Key()).IsValid() {
+				continue
+			}
+			to.Field(i).SetMapIndex(iter.Key(), iter.Value())
+			if ext, ok := restored.External[iter.Key().String()]; ok {
+				added.External[ext.ID] = ext
+			}
+		}
+	}
+
+	var states []ethkey.State
+	for _, state := range payload.EthKeyStates {
+		_, restoredKey := added.Eth[state.KeyID()]
+		_, existingKey := km.keyRing.Eth[state.KeyID()]
+		if (restoredKey || existingKey) && km.keyStates.get(state.Address.Address(), state.EVMChainID.ToInt()) == nil {
+			states = append(states, state)
+		}
+	}
+
+	km.keyRing.merge(added)
+	var callbacks []func(sqlutil.DataSource) error
+	var inserted []*ethkey.State
+	if len(states) > 0 {
+		callbacks = append(callbacks, func(tx sqlutil.DataSource) error {
+			for _, state := range states {
+				s := new(ethkey.State)
+				sql := `INSERT INTO evm.key_states (address, disabled, evm_chain_id, created_at, updated_at)
+					VALUES ($1, $2, $3, NOW(), NOW())
+					RETURNING *;`
+				if err := tx.GetContext(ctx, s, sql, state.Address, state.Disabled, state.EVMChainID.String()); err != nil {
+					return errors.Wrap(err, "failed to insert key_state")
+				}
+				inserted = append(inserted, s)
+			}
+			return nil
+		})
+	}
+	if err = km.save(ctx, callbacks...); err != nil {
+		km.keyRing.unmerge(added)
+		return BackupManifest{}, err
+	}
+	for _, state := range inserted {
+		km.keyStates.add(state)
+	}
+	return BackupManifest{Keys: added.ids(), EthKeyStates: len(inserted), SHA256: digest}, nil
+}
+
+func decryptBackup(backup []byte, passphrase string) (payload backupPayload, digest string, err error) {
+	var b Backup
+	if err = json.Unmarshal(backup, &b); err != nil {
+		return payload, digest, errors.Wrap(err, "invalid backup")
+	}
+	if b.Version != backupVersion {
+		return payload, digest, errors.Errorf("unsupported backup version %d", b.Version)
+	}
+	decrypted, err := gethkeystore.DecryptDataV3(b.Crypto, adulteratedBackupPassphrase(passphrase))
+	if errors.Is(err, gethkeystore.ErrDecrypt) {
+		return payload, digest, errors.Wrap(ErrBackupPassphraseMismatch, "could not decrypt backup")
+	} else if err != nil {
+		return payload, digest, errors.Wrap(err, "could not decrypt backup")
+	}
+	sum := sha256.Sum256(decrypted)
+	if digest = hex.EncodeToString(sum[:]); digest != b.Manifest.SHA256 {
+		return payload, digest, errors.Wrap(ErrBackupManifestMismatch, "digest")
+	}
+	if err = json.Unmarshal(decrypted, &payload); err != nil {
+		return payload, digest, errors.Wrap(err, "invalid backup payload")
+	}
+	kr, err := payload.Keys.keys()
+	if err != nil {
+		return payload, digest, err
+	}
+	if !reflect.DeepEqual(kr.ids(), b.Manifest.Keys) {
+		return payload, digest, errors.Wrap(ErrBackupManifestMismatch, "keys")
+	}
+	if len(payload.EthKeyStates) != b.Manifest.EthKeyStates {
+		return payload, digest, errors.Wrap(ErrBackupManifestMismatch, "eth key states")
+	}
+	return payload, digest, nil
+}
+
+// ids returns the sorted key IDs in the key ring, by key ring field. Empty
+// fields are left out.
+// warning: not thread-safe! caller must sync
+func (kr *keyRing) ids() map[string][]string {
+	ids := make(map[string][]string)
+	keyRing := reflect.Indirect(reflect.ValueOf(kr))
+	for i := 0; i < keyRing.NumField(); i++ {
+		if keyRing.Field(i).Kind() != reflect.Map || keyRing.Field(i).Len() == 0 {
+			continue
+		}
+		field := keyRing.Type().Field(i).Name
+		for _, id := range keyRing.Field(i).MapKeys() {
+			ids[field] = append(ids[field], id.String())
+		}
+		slices.Sort(ids[field])
+	}
+	return ids
+}
+
+// merge adds the keys in other to the key ring.
+// warning: not thread-safe! caller must sync
+func (kr *keyRing) merge(other *keyRing) {
+	kr.forEachKey(other, func(keyMap, id, key reflect.Value) {
+		keyMap.SetMapIndex(id, key)
+	})
+}
+
+// unmerge removes the keys in other from the key ring.
+// warning: not thread-safe! caller must sync
+func (kr *keyRing) unmerge(other *keyRing) {
+	kr.forEachKey(other, func(keyMap, id, _ reflect.Value) {
+		keyMap.SetMapIndex(id, reflect.Value{})
+	})
+}
+
+func (kr *keyRing) forEachKey(other *keyRing, fn func(keyMap, id, key reflect.Value)) {
+	keyRing := reflect.Indirect(reflect.ValueOf(kr))
+	from := reflect.Indirect(reflect.ValueOf(other))
+	for i := 0; i < from.NumField(); i++ {
+		if from.Field(i).Kind() != reflect.Map {
+			continue
+		}
+		iter := from.Field(i).MapRange()
+		for iter.Next() {
+			fn(keyRing.Field(i), iter.Key(), iter.Value())
+		}
+	}
+}
+
+// adulteration keeps a backup passphrase from decrypting the key ring, and
+// the keystore password from decrypting a backup
+func adulteratedBackupPassphrase(passphrase string) string {
+	return "backup-passphrase-" + passphrase
+}
@@ -0,0 +1,111 @@
+package keystore
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer/signertest"
+)
+
+func TestKeystore_BackupRestore(t *testing.T) {
+	ctx := testutils.Context(t)
+	s := signertest.New()
+	s.NewEd25519("csa")
+
+	const passphrase = "backup passphrase"
+
+	src := newExternalTestKeystore(t, newInMemoryORM(nil), s)
+	p2pKey, err := src.P2P().Create(ctx)
+	require.NoError(t, err)
+	bundle, err := src.OCR2().Create(ctx, chaintype.EVM)
+	require.NoError(t, err)
+	csaKey, err := src.CSA().AddExternal(ctx, "csa")
+	require.NoError(t, err)
+	vrfKey, err := vrfkey.NewV2()
+	require.NoError(t, err)
+	src.lock.Lock()
+	require.NoError(t, src.safeAddKey(ctx, vrfKey))
+	src.lock.Unlock()
+
+	backup, err := src.Backup(passphrase)
+	require.NoError(t, err)
+
+	var b Backup
+	require.NoError(t, json.Unmarshal(backup, &b))
+	assert.Equal(t, map[string][]string{
+		"P2P":      {p2pKey.ID()},
+		"OCR2":     {bundle.ID()},
+		"VRF":      {vrfKey.ID()},
+		"External": {csaKey.ID()},
+	}, b.Manifest.Keys)
+
+	t.Run("weak passphrase", func(t *testing.T) {
+		for _, weak := range []string{"", "backup", " backup passphrase"} {
+			_, err := src.Backup(weak)
+			require.ErrorContains(t, err, "backup passphrase is too weak", weak)
+		}
+	})
+
+	t.Run("wrong passphrase", func(t *testing.T) {
+		dst := newExternalTestKeystore(t, newInMemoryORM(nil), s)
+		_, err := dst.Restore(ctx, backup, password)
+		require.ErrorIs(t, err, ErrBackupPassphraseMismatch)
+	})
+
+	t.Run("tampered manifest", func(t *testing.T) {
+		var tampered Backup
+		require.NoError(t, json.Unmarshal(backup, &tampered))
+		tampered.Manifest.Keys["P2P"] = []string{"other"}
+		raw, err := json.Marshal(tampered)
+		require.NoError(t, err)
+
+		dst := newExternalTestKeystore(t, newInMemoryORM(nil), s)
+		_, err = dst.Restore(ctx, raw, passphrase)
+		require.ErrorIs(t, err, ErrBackupManifestMismatch)
+	})
+
+	t.Run("no external signer", func(t *testing.T) {
+		dst := newExternalTestKeystore(t, newInMemoryORM(nil), nil)
+		_, err := dst.Restore(ctx, backup, passphrase)
+		require.ErrorIs(t, err, ErrNoExternalSigner)
+		ids := dst.keyRing.ids()
+		assert.Empty(t, ids)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		orm := newInMemoryORM(nil)
+		dst := newExternalTestKeystore(t, orm, s)
+		existing, err := dst.P2P().Create(ctx)
+		require.NoError(t, err)
+
+		added, err := dst.Restore(ctx, backup, passphrase)
+		require.NoError(t, err)
+		for field, ids := range b.Manifest.Keys {
+			assert.Equal(t, ids, added.Keys[field], field)
+		}
+		// the external key is loaded from the signer
+		assert.Equal(t, []string{csaKey.ID()}, added.Keys["CSA"])
+
+		_, err = dst.P2P().Get(existing.PeerID())
+		require.NoError(t, err)
+		_, err = dst.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		_, err = dst.CSA().Export(csaKey.ID(), password)
+		require.ErrorIs(t, err, ErrExternalKey)
+
+		// restored keys are saved
+		reloaded := newExternalTestKeystore(t, orm, s)
+		assert.Equal(t, dst.keyRing.ids(), reloaded.keyRing.ids())
+
+		// restoring again adds nothing
+		added, err = dst.Restore(ctx, backup, passphrase)
+		require.NoError(t, err)
+		assert.Empty(t, added.Keys)
+	})
+}
@@ -20,6 +20,7 @@ type CSA interface {
 	GetAll() ([]csakey.KeyV2, error)
 	Create(ctx context.Context) (csakey.KeyV2, error)
 	Add(ctx context.Context, key csakey.KeyV2) error
+	AddExternal(ctx context.Context, handle string) (csakey.KeyV2, error)
 	Delete(ctx context.Context, id string) (csakey.KeyV2, error)
 	Import(ctx context.Context, keyJSON []byte, password string) (csakey.KeyV2, error)
 	Export(id string, password string) ([]byte, error)
@@ -120,6 +121,29 @@ func (ks *csa) Add(ctx context.Context, key csakey.KeyV2) error {
 	return ks.safeAddKey(ctx, key)
 }
 
+func (ks *csa) AddExternal(ctx context.Context, handle string) (csakey.KeyV2, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return csakey.KeyV2{}, ErrLocked
+	}
+	if len(ks.keyRing.CSA) > 0 {
+		return csakey.KeyV2{}, ErrCSAKeyExists
+	}
+	key, err := ks.newExternalKey(ctx, externalKey{Type: "CSA", Handle: handle})
+	if err != nil {
+		return csakey.KeyV2{}, err
+	}
+	csaKey := key.(csakey.KeyV2)
+	err = ks.safeAddExternalKey(externalKey{ID: key.ID(), Type: "CSA", Handle: handle}, func() error {
+		return ks.safeAddKey(ctx, csaKey)
+	})
+	if err != nil {
+		return csakey.KeyV2{}, err
+	}
+	return csaKey, nil
+}
+
 func (ks *csa) Delete(ctx context.Context, id string) (csakey.KeyV2, error) {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
@@ -162,6 +186,9 @@ func (ks *csa) Export(id string, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if ks.keyRing.isExternal(key.ID()) {
+		return nil, ErrExternalKey
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
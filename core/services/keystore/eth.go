@@ -24,6 +24,7 @@ type Eth interface {
 	Get(ctx context.Context, id string) (ethkey.KeyV2, error)
 	GetAll(ctx context.Context) ([]ethkey.KeyV2, error)
 	Create(ctx context.Context, chainIDs ...*big.Int) (ethkey.KeyV2, error)
+	AddExternal(ctx context.Context, handle string, chainIDs ...*big.Int) (ethkey.KeyV2, error)
 	Delete(ctx context.Context, id string) (ethkey.KeyV2, error)
 	Import(ctx context.Context, keyJSON []byte, password string, chainIDs ...*big.Int) (ethkey.KeyV2, error)
 	Export(ctx context.Context, id string, password string) ([]byte, error)
@@ -212,6 +213,29 @@ func (ks *eth) Import(ctx context.Context, keyJSON []byte, password string, chai
 	return key, nil
 }
 
+func (ks *eth) AddExternal(ctx context.Context, handle string, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, ErrLocked
+	}
+	key, err := ks.newExternalKey(ctx, externalKey{Type: "Eth", Handle: handle})
+	if err != nil {
+		return ethkey.KeyV2{}, err
+	}
+	ethKey := key.(ethkey.KeyV2)
+	if _, found := ks.keyRing.Eth[ethKey.ID()]; found {
+		return ethkey.KeyV2{}, ErrKeyExists
+	}
+	err = ks.safeAddExternalKey(externalKey{ID: key.ID(), Type: "Eth", Handle: handle}, func() error {
+		return ks.add(ctx, ethKey, chainIDs...)
+	})
+	if err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "unable to add eth key")
+	}
+	return ethKey, nil
+}
+
 func (ks *eth) Export(ctx context.Context, id string, password string) ([]byte, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -222,6 +246,9 @@ func (ks *eth) Export(ctx context.Context, id string, password string) ([]byte,
 	if err != nil {
 		return nil, err
 	}
+	if ks.keyRing.isExternal(key.ID()) {
+		return nil, ErrExternalKey
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
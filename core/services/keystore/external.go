@@ -0,0 +1,112 @@
+package keystore
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+)
+
+var (
+	ErrExternalKey        = errors.New("key is held by an external signer and can't be exported")
+	ErrNoExternalSigner   = errors.New("no external signer is configured")
+	errUnknownExternalKey = errors.New("unknown external key type")
+)
+
+// externalKey records a key held by the external signer. The key ring only
+// keeps its handle.
+type externalKey struct {
+	ID     string
+	Type   string // key ring field, as returned by GetFieldNameForKey
+	Handle string
+
+	// OCR2 key bundles have separate onchain and offchain signing keys, and
+	// keep their config encryption key in the key ring.
+	ChainType      chaintype.ChainType `json:",omitempty"`
+	OffchainHandle string              `json:",omitempty"`
+	EncryptionKey  []byte              `json:",omitempty"`
+}
+
+// key looks up the key in the external signer.
+func (ext externalKey) key(ctx context.Context, s signer.Signer) (Key, error) {
+	k, err := signer.NewKey(ctx, s, ext.Handle)
+	if err != nil {
+		return nil, err
+	}
+	switch ext.Type {
+	case "CSA":
+		key, err := csakey.FromSigner(k)
+		return key, err
+	case "Eth":
+		key, err := ethkey.FromSigner(k)
+		return key, err
+	case "P2P":
+		key, err := p2pkey.FromSigner(k)
+		return key, err
+	case "OCR2":
+		offchain, err := signer.NewKey(ctx, s, ext.OffchainHandle)
+		if err != nil {
+			return nil, err
+		}
+		if len(ext.EncryptionKey) != curve25519.ScalarSize {
+			return nil, errors.Errorf("invalid config encryption key length %d", len(ext.EncryptionKey))
+		}
+		return ocr2key.FromSigners(ext.ChainType, k, offchain, [curve25519.ScalarSize]byte(ext.EncryptionKey))
+	}
+	return nil, errors.Wrap(errUnknownExternalKey, ext.Type)
+}
+
+// caller must hold lock!
+func (km *keyManager) newExternalKey(ctx context.Context, ext externalKey) (Key, error) {
+	if km.signer == nil {
+		return nil, ErrNoExternalSigner
+	}
+	return ext.key(ctx, km.signer)
+}
+
+// caller must hold lock!
+// safeAddExternalKey adds a key held by the external signer, with add adding it to the key ring.
+func (km *keyManager) safeAddExternalKey(ext externalKey, add func() error) error {
+	km.keyRing.External[ext.ID] = ext
+	if err := add(); err != nil {
+		delete(km.keyRing.External, ext.ID)
+		return err
+	}
+	return nil
+}
+
+// warning: not thread-safe! caller must sync
+func (kr *keyRing) isExternal(id string) bool {
+	_, ok := kr.External[id]
+	return ok
+}
+
+// loadExternalKeys adds the keys held by the external signer to the key ring.
+func (km *keyManager) loadExternalKeys(ctx context.Context, kr *keyRing) error {
+	if len(kr.External) == 0 {
+		return nil
+	}
+	if km.signer == nil {
+		return errors.Wrapf(ErrNoExternalSigner, "key ring has %d keys held by an external signer", len(kr.External))
+	}
+	keyRing := reflect.Indirect(reflect.ValueOf(kr))
+	for id, ext := range kr.External {
+		key, err := ext.key(ctx, km.signer)
+		if err != nil {
+			return errors.Wrapf(err, "unable to load %s key %s", ext.Type, id)
+		}
+		if key.ID() != id {
+			return errors.Errorf("external signer returned %s key %s for handle %s, expected %s", ext.Type, key.ID(), ext.Handle, id)
+		}
+		keyRing.FieldByName(ext.Type).SetMapIndex(reflect.ValueOf(id), reflect.ValueOf(key))
+	}
+	return nil
+}
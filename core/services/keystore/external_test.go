@@ -0,0 +1,149 @@
+package keystore
+
+import (
+	"context"
+	"crypto/ed25519"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer/signertest"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+type emptyKeystateORM struct{}
+
+func (emptyKeystateORM) loadKeyStates(context.Context) (*keyStates, error) {
+	return newKeyStates(), nil
+}
+
+// newExternalTestKeystore returns an unlocked keystore which keeps its key
+// ring in orm and needs no database.
+func newExternalTestKeystore(t *testing.T, orm *memoryORM, s signer.Signer) *master {
+	km := &keyManager{
+		orm:             orm,
+		keystateORM:     emptyKeystateORM{},
+		scryptParams:    utils.FastScryptParams,
+		minScryptParams: utils.FastScryptParams,
+		lock:            &sync.RWMutex{},
+		announce:        func(Key) {},
+		signer:          s,
+	}
+	require.NoError(t, km.Unlock(testutils.Context(t), password))
+	return &master{
+		keyManager: km,
+		csa:        newCSAKeyStore(km),
+		ocr2:       newOCR2KeyStore(km),
+		p2p:        newP2PKeyStore(km),
+	}
+}
+
+func TestKeystore_ExternalKeys(t *testing.T) {
+	ctx := testutils.Context(t)
+	s := signertest.New()
+	csaPriv := s.NewEd25519("csa")
+	p2pPriv := s.NewEd25519("p2p")
+	s.NewSecp256k1("ocr2-onchain")
+	ocr2OffchainPriv := s.NewEd25519("ocr2-offchain")
+
+	orm := newInMemoryORM(nil)
+	ks := newExternalTestKeystore(t, orm, s)
+
+	csaKey, err := ks.CSA().AddExternal(ctx, "csa")
+	require.NoError(t, err)
+	assert.Equal(t, csaPriv.Public(), csaKey.Public())
+	_, err = ks.CSA().AddExternal(ctx, "csa")
+	require.ErrorIs(t, err, ErrCSAKeyExists)
+
+	p2pKey, err := ks.P2P().AddExternal(ctx, "p2p")
+	require.NoError(t, err)
+	assert.Equal(t, p2pPriv.Public(), p2pKey.Public())
+	_, err = ks.P2P().AddExternal(ctx, "p2p")
+	require.ErrorIs(t, err, ErrKeyExists)
+
+	bundle, err := ks.OCR2().AddExternal(ctx, chaintype.EVM, "ocr2-onchain", "ocr2-offchain")
+	require.NoError(t, err)
+	offchainPublicKey := bundle.OffchainPublicKey()
+	assert.Equal(t, ocr2OffchainPriv.Public(), ed25519.PublicKey(offchainPublicKey[:]))
+
+	_, err = ks.CSA().AddExternal(ctx, "missing")
+	require.Error(t, err)
+
+	t.Run("keys are held by the signer", func(t *testing.T) {
+		msg := []byte("message")
+		sig, err := csaKey.Sign(nil, msg, nil)
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(csaPriv.Public().(ed25519.PublicKey), msg, sig))
+
+		sig, err = bundle.OffchainSign(msg)
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(ocr2OffchainPriv.Public().(ed25519.PublicKey), msg, sig))
+	})
+
+	t.Run("keys can't be exported", func(t *testing.T) {
+		_, err := ks.CSA().Export(csaKey.ID(), password)
+		require.ErrorIs(t, err, ErrExternalKey)
+		_, err = ks.P2P().Export(p2pKey.PeerID(), password)
+		require.ErrorIs(t, err, ErrExternalKey)
+		_, err = ks.OCR2().Export(bundle.ID(), password)
+		require.ErrorIs(t, err, ErrExternalKey)
+	})
+
+	t.Run("keys are reloaded on unlock", func(t *testing.T) {
+		ks2 := newExternalTestKeystore(t, orm, s)
+		csaKeys, err := ks2.CSA().GetAll()
+		require.NoError(t, err)
+		require.Len(t, csaKeys, 1)
+		assert.Equal(t, csaKey.ID(), csaKeys[0].ID())
+
+		reloaded, err := ks2.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		assert.Equal(t, p2pKey.ID(), reloaded.ID())
+
+		reloadedBundle, err := ks2.OCR2().Get(bundle.ID())
+		require.NoError(t, err)
+		assert.Equal(t, bundle.OnChainPublicKey(), reloadedBundle.OnChainPublicKey())
+		assert.Equal(t, bundle.ConfigEncryptionPublicKey(), reloadedBundle.ConfigEncryptionPublicKey())
+	})
+
+	t.Run("unlock fails without the signer", func(t *testing.T) {
+		km := &keyManager{
+			orm:          orm,
+			keystateORM:  emptyKeystateORM{},
+			scryptParams: utils.FastScryptParams,
+			lock:         &sync.RWMutex{},
+			announce:     func(Key) {},
+		}
+		require.ErrorIs(t, km.Unlock(ctx, password), ErrNoExternalSigner)
+	})
+
+	t.Run("deleted keys are forgotten", func(t *testing.T) {
+		require.NoError(t, ks.OCR2().Delete(ctx, bundle.ID()))
+
+		ks2 := newExternalTestKeystore(t, orm, s)
+		_, err := ks2.OCR2().Get(bundle.ID())
+		require.Error(t, err)
+		assert.Len(t, ks2.keyRing.External, 2)
+	})
+}
+
+func TestKeystore_ExternalKeys_NoSigner(t *testing.T) {
+	ctx := testutils.Context(t)
+	ks := newExternalTestKeystore(t, newInMemoryORM(nil), nil)
+
+	_, err := ks.CSA().AddExternal(ctx, "csa")
+	require.ErrorIs(t, err, ErrNoExternalSigner)
+	_, err = ks.P2P().AddExternal(ctx, "p2p")
+	require.ErrorIs(t, err, ErrNoExternalSigner)
+
+	// keys in the key ring are unaffected
+	key, err := ks.P2P().Create(ctx)
+	require.NoError(t, err)
+	_, err = ks.P2P().Export(key.PeerID(), password)
+	require.NoError(t, err)
+}
@@ -19,7 +19,7 @@ func mustNewEthKey(t *testing.T) *ethkey.KeyV2 {
 }
 
 func ExposedNewMaster(t *testing.T, ds sqlutil.DataSource) *master {
-	return newMaster(ds, utils.FastScryptParams, logger.Test(t).Infof)
+	return newMaster(ds, utils.FastScryptParams, nil, logger.Test(t).Infof)
 }
 
 func (m *master) ExportedSave(ctx context.Context) error {
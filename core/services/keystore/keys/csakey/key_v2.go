@@ -52,6 +52,19 @@ func NewV2() (KeyV2, error) {
 	}, nil
 }
 
+// FromSigner returns a key whose private key is held by an external signer.
+func FromSigner(s crypto.Signer) (KeyV2, error) {
+	pubKey, ok := s.Public().(ed25519.PublicKey)
+	if !ok {
+		return KeyV2{}, fmt.Errorf("expected an ed25519 key, got %T", s.Public())
+	}
+	return KeyV2{
+		signer:    s,
+		PublicKey: pubKey,
+		Version:   2,
+	}, nil
+}
+
 func MustNewV2XXXTestingOnly(k *big.Int) KeyV2 {
 	seed := make([]byte, ed25519.SeedSize)
 	copy(seed, k.Bytes())
@@ -1,7 +1,11 @@
 package csakey
 
 import (
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
 	"encoding/hex"
 	"testing"
 
@@ -30,3 +34,24 @@ func TestCSAKeyV2_NewV2(t *testing.T) {
 	assert.NotNil(t, keyV2.PublicKey)
 	assert.NotNil(t, keyV2.raw)
 }
+
+func TestCSAKeyV2_FromSigner(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	keyV2, err := FromSigner(privKey)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, keyV2.Version)
+	assert.Equal(t, pubKey, keyV2.PublicKey)
+	assert.Empty(t, internal.Bytes(keyV2.raw))
+
+	sig, err := keyV2.Sign(nil, []byte("message"), crypto.Hash(0))
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(pubKey, []byte("message"), sig))
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, err = FromSigner(ecdsaKey)
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package ethkey
 
 import (
 	"bytes"
+	gocrypto "crypto"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"fmt"
@@ -15,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/internal"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
 )
 
 var curve = crypto.S256()
@@ -33,6 +35,7 @@ func KeyFor(raw internal.Raw) KeyV2 {
 type KeyV2 struct {
 	raw          internal.Raw
 	getPK        func() *ecdsa.PrivateKey
+	signer       gocrypto.Signer
 	Address      common.Address
 	EIP55Address types.EIP55Address
 }
@@ -61,13 +64,32 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	return
 }
 
+// FromSigner returns a key whose private key is held by an external signer.
+func FromSigner(s gocrypto.Signer) (KeyV2, error) {
+	pub, ok := s.Public().(*ecdsa.PublicKey)
+	if !ok || pub.Curve != curve {
+		return KeyV2{}, fmt.Errorf("expected a secp256k1 key, got %T", s.Public())
+	}
+	address := crypto.PubkeyToAddress(*pub)
+	return KeyV2{
+		signer:       s,
+		Address:      address,
+		EIP55Address: types.EIP55AddressFromAddress(address),
+	}, nil
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
 
 func (key KeyV2) Raw() internal.Raw { return key.raw }
 
-func (key KeyV2) Sign(data []byte) ([]byte, error) { return crypto.Sign(data, key.getPK()) }
+func (key KeyV2) Sign(data []byte) ([]byte, error) {
+	if key.signer != nil {
+		return signer.SignEthereum(key.signer, data)
+	}
+	return crypto.Sign(data, key.getPK())
+}
 
 // Cmp uses byte-order address comparison to give a stable comparison between two keys
 func (key KeyV2) Cmp(key2 KeyV2) int {
@@ -2,6 +2,7 @@ package ethkey
 
 import (
 	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/internal"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestEthKeyV2_ToKey(t *testing.T) {
@@ -34,3 +36,28 @@ func TestEthKeyV2_NewV2(t *testing.T) {
 	assert.NotNil(t, keyV2.getPK())
 	assert.Equal(t, keyV2.Address.Hex(), keyV2.ID())
 }
+
+func TestEthKeyV2_FromSigner(t *testing.T) {
+	privateKeyECDSA, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	k, err := FromSigner(privateKeyECDSA)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(privateKeyECDSA.PublicKey), k.Address)
+	assert.Equal(t, k.Address.Hex(), k.ID())
+
+	digest := crypto.Keccak256([]byte("message"))
+	sig, err := k.Sign(digest)
+	require.NoError(t, err)
+	pub, err := crypto.SigToPub(digest, sig)
+	require.NoError(t, err)
+	assert.Equal(t, k.Address, crypto.PubkeyToAddress(*pub))
+
+	_, err = k.ToEncryptedJSON("password", utils.FastScryptParams)
+	require.Error(t, err)
+
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, err = FromSigner(p256Key)
+	require.Error(t, err)
+}
@@ -0,0 +1,100 @@
+package ocr2key
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+)
+
+var _ KeyBundle = &keyBundle[*externalEVMKeyring]{}
+
+// FromSigners returns an EVM key bundle whose onchain and offchain signing
+// keys are held by external signers. The config encryption key is used for
+// Diffie-Hellman rather than signing, so it is passed in and stays with the
+// caller. The bundle can't be marshalled, and so Raw panics.
+func FromSigners(chainType chaintype.ChainType, onchain, offchain crypto.Signer, encryptionKey [curve25519.ScalarSize]byte) (KeyBundle, error) {
+	if chainType != chaintype.EVM {
+		return nil, fmt.Errorf("external signers are only supported for %s key bundles, got %s", chaintype.EVM, chainType)
+	}
+	pub, ok := onchain.Public().(*ecdsa.PublicKey)
+	if !ok || pub.Curve != curve {
+		return nil, fmt.Errorf("expected a secp256k1 onchain key, got %T", onchain.Public())
+	}
+	offchainPub, ok := offchain.Public().(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("expected an ed25519 offchain key, got %T", offchain.Public())
+	}
+	k := keyBundle[*externalEVMKeyring]{
+		keyBundleBase: keyBundleBase{
+			chainType: chainType,
+			offchainKeyring: offchainKeyring{
+				signer:        offchain,
+				encryptionKey: func() *[curve25519.ScalarSize]byte { return &encryptionKey },
+			},
+		},
+		keyring: &externalEVMKeyring{signer: onchain, address: gethcrypto.PubkeyToAddress(*pub)},
+	}
+	cpk, err := k.configEncryptionPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	// The ID is derived from the public keys, since the private keys are unknown.
+	b, err := json.Marshal(struct {
+		ChainType                 chaintype.ChainType
+		OnchainPublicKey          []byte
+		OffchainPublicKey         []byte
+		ConfigEncryptionPublicKey []byte
+	}{chainType, k.keyring.address.Bytes(), offchainPub, cpk[:]})
+	if err != nil {
+		return nil, err
+	}
+	k.id = sha256.Sum256(b)
+	return &k, nil
+}
+
+var _ ocrtypes.OnchainKeyring = &externalEVMKeyring{}
+
+// externalEVMKeyring is an evmKeyring whose private key is held by an external signer.
+type externalEVMKeyring struct {
+	evmKeyring
+	signer  crypto.Signer
+	address common.Address
+}
+
+func (ekr *externalEVMKeyring) PublicKey() ocrtypes.OnchainPublicKey {
+	return ekr.address[:]
+}
+
+func (ekr *externalEVMKeyring) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
+	return ekr.SignBlob(ReportToSigData(reportCtx, report))
+}
+
+func (ekr *externalEVMKeyring) Sign3(digest types.ConfigDigest, seqNr uint64, r ocrtypes.Report) (signature []byte, err error) {
+	return ekr.SignBlob(ReportToSigData3(digest, seqNr, r))
+}
+
+func (ekr *externalEVMKeyring) SignBlob(b []byte) (sig []byte, err error) {
+	return signer.SignEthereum(ekr.signer, b)
+}
+
+func (ekr *externalEVMKeyring) Marshal() ([]byte, error) {
+	return nil, errors.New("onchain signing key is held by an external signer")
+}
+
+func (ekr *externalEVMKeyring) Unmarshal(in []byte) error {
+	return errors.New("onchain signing key is held by an external signer")
+}
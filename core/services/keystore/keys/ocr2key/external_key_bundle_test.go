@@ -0,0 +1,73 @@
+package ocr2key_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+)
+
+func TestOCR2Keys_FromSigners(t *testing.T) {
+	t.Parallel()
+	onchain, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	offchainPub, offchain, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	var encryptionKey [32]byte
+	_, err = rand.Read(encryptionKey[:])
+	require.NoError(t, err)
+
+	kb, err := ocr2key.FromSigners(chaintype.EVM, onchain, offchain, encryptionKey)
+	require.NoError(t, err)
+	assert.Equal(t, chaintype.EVM, kb.ChainType())
+	assert.Equal(t, crypto.PubkeyToAddress(onchain.PublicKey).Bytes(), []byte(kb.PublicKey()))
+	offchainPublicKey := kb.OffchainPublicKey()
+	assert.Equal(t, offchainPub, ed25519.PublicKey(offchainPublicKey[:]))
+
+	// the ID only depends on the public keys
+	kb2, err := ocr2key.FromSigners(chaintype.EVM, onchain, offchain, encryptionKey)
+	require.NoError(t, err)
+	assert.Equal(t, kb.ID(), kb2.ID())
+
+	t.Run("signs reports", func(t *testing.T) {
+		reportCtx := ocrtypes.ReportContext{}
+		report := ocrtypes.Report("report")
+		sig, err := kb.Sign(reportCtx, report)
+		require.NoError(t, err)
+		assert.True(t, kb.Verify(kb.PublicKey(), reportCtx, report, sig))
+
+		sig, err = kb.Sign3(ocrtypes.ConfigDigest{}, 1, report)
+		require.NoError(t, err)
+		assert.True(t, kb.Verify3(kb.PublicKey(), ocrtypes.ConfigDigest{}, 1, report, sig))
+	})
+
+	t.Run("signs offchain", func(t *testing.T) {
+		sig, err := kb.OffchainSign([]byte("msg"))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(offchainPub, []byte("msg"), sig))
+	})
+
+	t.Run("can't be marshalled", func(t *testing.T) {
+		_, err := kb.Marshal()
+		require.Error(t, err)
+	})
+
+	t.Run("rejects other chain types", func(t *testing.T) {
+		_, err := ocr2key.FromSigners(chaintype.Solana, onchain, offchain, encryptionKey)
+		require.Error(t, err)
+	})
+
+	t.Run("rejects wrong key types", func(t *testing.T) {
+		_, err := ocr2key.FromSigners(chaintype.EVM, offchain, onchain, encryptionKey)
+		require.Error(t, err)
+	})
+}
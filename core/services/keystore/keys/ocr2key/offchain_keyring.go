@@ -2,7 +2,9 @@ package ocr2key
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/ed25519"
+	"crypto/rand"
 	"encoding/binary"
 	"errors"
 	"io"
@@ -20,10 +22,12 @@ var _ ocrtypes.OffchainKeyring = &offchainKeyring{}
 // and perform aggregation.
 //
 // This is currently an ed25519 signing key and a separate encryption key.
+// The signing key may be held by an external signer instead.
 //
 // All its functions should be thread-safe.
 type offchainKeyring struct {
 	signingKey    func() ed25519.PrivateKey
+	signer        crypto.Signer
 	encryptionKey func() *[curve25519.ScalarSize]byte
 }
 
@@ -68,6 +72,9 @@ func (ok *offchainKeyring) NaclBoxOpenAnonymous(ciphertext []byte) (plaintext []
 
 // OffchainSign signs message using private key
 func (ok *offchainKeyring) OffchainSign(msg []byte) (signature []byte, err error) {
+	if ok.signer != nil {
+		return ok.signer.Sign(rand.Reader, msg, crypto.Hash(0))
+	}
 	return ed25519.Sign(ok.signingKey(), msg), nil
 }
 
@@ -86,6 +93,10 @@ func (ok *offchainKeyring) ConfigDiffieHellman(point [curve25519.PointSize]byte)
 // OffchainPublicKey returns the public component of this offchain keyring.
 func (ok *offchainKeyring) OffchainPublicKey() ocrtypes.OffchainPublicKey {
 	var offchainPubKey [ed25519.PublicKeySize]byte
+	if ok.signer != nil {
+		copy(offchainPubKey[:], ok.signer.Public().(ed25519.PublicKey))
+		return offchainPubKey
+	}
 	copy(offchainPubKey[:], ok.signingKey().Public().(ed25519.PublicKey)[:])
 	return offchainPubKey
 }
@@ -107,6 +118,9 @@ func (ok *offchainKeyring) configEncryptionPublicKey() (ocrtypes.ConfigEncryptio
 }
 
 func (ok *offchainKeyring) marshal() ([]byte, error) {
+	if ok.signer != nil {
+		return nil, errors.New("offchain signing key is held by an external signer")
+	}
 	buffer := new(bytes.Buffer)
 	err := binary.Write(buffer, binary.LittleEndian, ok.signingKey())
 	if err != nil {
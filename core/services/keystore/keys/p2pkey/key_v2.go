@@ -7,6 +7,7 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"io"
 	"math/big"
 
@@ -77,6 +78,22 @@ func NewV2() (KeyV2, error) {
 	return key, nil
 }
 
+// FromSigner returns a key whose private key is held by an external signer.
+func FromSigner(s crypto.Signer) (KeyV2, error) {
+	pubKey, ok := s.Public().(ed25519.PublicKey)
+	if !ok {
+		return KeyV2{}, fmt.Errorf("expected an ed25519 key, got %T", s.Public())
+	}
+	peerID, err := types.PeerIDFromPublicKey(pubKey)
+	if err != nil {
+		return KeyV2{}, err
+	}
+	return KeyV2{
+		signer: s,
+		peerID: PeerID(peerID),
+	}, nil
+}
+
 func MustNewV2XXXTestingOnly(k *big.Int) KeyV2 {
 	seed := make([]byte, ed25519.SeedSize)
 	copy(seed, k.Bytes())
@@ -1,6 +1,7 @@
 package p2pkey
 
 import (
+	"crypto"
 	"crypto/ed25519"
 	"encoding/hex"
 	"testing"
@@ -20,3 +21,20 @@ func TestP2PKeys_KeyV2(t *testing.T) {
 	assert.Equal(t, ragep2ptypes.PeerID(kv2.PeerID()).String(), kv2.ID())
 	assert.Equal(t, hex.EncodeToString(pkv2), kv2.PublicKeyHex())
 }
+
+func TestP2PKeys_FromSigner(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	kv2, err := FromSigner(priv)
+	require.NoError(t, err)
+
+	peerID, err := ragep2ptypes.PeerIDFromPublicKey(pub)
+	require.NoError(t, err)
+	assert.Equal(t, peerID.String(), kv2.ID())
+	assert.Equal(t, hex.EncodeToString(pub), kv2.PublicKeyHex())
+
+	sig, err := kv2.Sign(nil, []byte("message"), crypto.Hash(0))
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(pub, []byte("message"), sig))
+}
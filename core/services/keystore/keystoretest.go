@@ -55,11 +55,12 @@ func NewInMemory(ds sqlutil.DataSource, scryptParams utils.ScryptParams, logf Lo
 	memoryORM := newInMemoryORM(ds)
 
 	km := &keyManager{
-		orm:          memoryORM,
-		keystateORM:  dbORM,
-		scryptParams: scryptParams,
-		lock:         &sync.RWMutex{},
-		announce:     announcer(logf),
+		orm:             memoryORM,
+		keystateORM:     dbORM,
+		scryptParams:    scryptParams,
+		minScryptParams: scryptParams,
+		lock:            &sync.RWMutex{},
+		announce:        announcer(logf),
 	}
 
 	return &master{
@@ -41,7 +41,11 @@ func (k *LegacyKeyStorage) StoreUnsupported(allRawKeysJson []byte, keyRing *keyR
 	if keyRing == nil {
 		return errors.New("keyring is nil")
 	}
-	supportedKeyRingJson, err := json.Marshal(keyRing.raw())
+	supportedRawKeys, err := keyRing.raw()
+	if err != nil {
+		return err
+	}
+	supportedKeyRingJson, err := json.Marshal(supportedRawKeys)
 	if err != nil {
 		return err
 	}
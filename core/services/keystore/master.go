@@ -26,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/tronkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -56,6 +57,9 @@ type Master interface {
 	Workflow() Workflow
 	DKGRecipient() DKGRecipient
 	Unlock(ctx context.Context, password string) error
+	RotatePassword(ctx context.Context, oldPassword, newPassword string, scryptParams utils.ScryptParams) error
+	Backup(passphrase string) ([]byte, error)
+	Restore(ctx context.Context, backup []byte, passphrase string) (BackupManifest, error)
 	IsEmpty(ctx context.Context) (bool, error)
 }
 type master struct {
@@ -80,17 +84,25 @@ type master struct {
 type Logf func(string, ...any)
 
 func New(ds sqlutil.DataSource, scryptParams utils.ScryptParams, announce Logf) Master {
-	return newMaster(ds, scryptParams, announce)
+	return newMaster(ds, scryptParams, nil, announce)
 }
 
-func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, announce Logf) *master {
+// NewWithSigner returns a keystore which can also hold keys in the external
+// signer s. See the AddExternal methods of CSA, Eth, OCR2 and P2P.
+func NewWithSigner(ds sqlutil.DataSource, scryptParams utils.ScryptParams, s signer.Signer, announce Logf) Master {
+	return newMaster(ds, scryptParams, s, announce)
+}
+
+func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, s signer.Signer, announce Logf) *master {
 	orm := NewORM(ds)
 	km := &keyManager{
-		orm:          orm,
-		keystateORM:  orm,
-		scryptParams: scryptParams,
-		lock:         &sync.RWMutex{},
-		announce:     announcer(announce),
+		orm:             orm,
+		keystateORM:     orm,
+		scryptParams:    scryptParams,
+		minScryptParams: scryptParams,
+		lock:            &sync.RWMutex{},
+		announce:        announcer(announce),
+		signer:          s,
 	}
 
 	return &master{
@@ -187,11 +199,15 @@ type keyManager struct {
 	orm          ORM
 	keystateORM  keystateORM
 	scryptParams utils.ScryptParams
-	keyRing      *keyRing
-	keyStates    *keyStates
-	lock         *sync.RWMutex
-	password     string
-	announce     func(Key)
+	// minScryptParams are the configured scrypt params, below which the key
+	// ring can't be re-encrypted
+	minScryptParams utils.ScryptParams
+	keyRing         *keyRing
+	keyStates       *keyStates
+	lock            *sync.RWMutex
+	password        string
+	announce        func(Key)
+	signer          signer.Signer
 }
 
 func (km *keyManager) IsEmpty(ctx context.Context) (bool, error) {
@@ -216,6 +232,9 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to decrypt encrypted key ring")
 	}
+	if err = km.loadExternalKeys(ctx, kr); err != nil {
+		return errors.Wrap(err, "unable to load external keys")
+	}
 	km.keyRing = kr
 
 	ks, err := km.keystateORM.loadKeyStates(ctx)
@@ -270,11 +289,16 @@ func (km *keyManager) safeRemoveKey(ctx context.Context, unknownKey Key, callbac
 	keyRing := reflect.Indirect(reflect.ValueOf(km.keyRing))
 	keyMap := keyRing.FieldByName(fieldName)
 	keyMap.SetMapIndex(id, reflect.Value{})
+	ext, external := km.keyRing.External[unknownKey.ID()]
+	delete(km.keyRing.External, unknownKey.ID())
 	// save keyring to DB
 	err = km.save(ctx, callbacks...)
 	// if save fails, add key back to keyRing
 	if err != nil {
 		keyMap.SetMapIndex(id, key)
+		if external {
+			km.keyRing.External[ext.ID] = ext
+		}
 		return err
 	}
 	return nil
@@ -70,6 +70,63 @@ func (_c *CSA_Add_Call) RunAndReturn(run func(context.Context, csakey.KeyV2) err
 	return _c
 }
 
+// AddExternal provides a mock function with given fields: ctx, handle
+func (_m *CSA) AddExternal(ctx context.Context, handle string) (csakey.KeyV2, error) {
+	ret := _m.Called(ctx, handle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddExternal")
+	}
+
+	var r0 csakey.KeyV2
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (csakey.KeyV2, error)); ok {
+		return rf(ctx, handle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) csakey.KeyV2); ok {
+		r0 = rf(ctx, handle)
+	} else {
+		r0 = ret.Get(0).(csakey.KeyV2)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, handle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CSA_AddExternal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddExternal'
+type CSA_AddExternal_Call struct {
+	*mock.Call
+}
+
+// AddExternal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - handle string
+func (_e *CSA_Expecter) AddExternal(ctx interface{}, handle interface{}) *CSA_AddExternal_Call {
+	return &CSA_AddExternal_Call{Call: _e.mock.On("AddExternal", ctx, handle)}
+}
+
+func (_c *CSA_AddExternal_Call) Run(run func(ctx context.Context, handle string)) *CSA_AddExternal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CSA_AddExternal_Call) Return(_a0 csakey.KeyV2, _a1 error) *CSA_AddExternal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CSA_AddExternal_Call) RunAndReturn(run func(context.Context, string) (csakey.KeyV2, error)) *CSA_AddExternal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx
 func (_m *CSA) Create(ctx context.Context) (csakey.KeyV2, error) {
 	ret := _m.Called(ctx)
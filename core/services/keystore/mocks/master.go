@@ -7,6 +7,8 @@ import (
 
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // Master is an autogenerated mock type for the Master type
@@ -69,6 +71,64 @@ func (_c *Master_Aptos_Call) RunAndReturn(run func() keystore.Aptos) *Master_Apt
 	return _c
 }
 
+// Backup provides a mock function with given fields: passphrase
+func (_m *Master) Backup(passphrase string) ([]byte, error) {
+	ret := _m.Called(passphrase)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backup")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]byte, error)); ok {
+		return rf(passphrase)
+	}
+	if rf, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = rf(passphrase)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(passphrase)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Master_Backup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backup'
+type Master_Backup_Call struct {
+	*mock.Call
+}
+
+// Backup is a helper method to define mock.On call
+//   - passphrase string
+func (_e *Master_Expecter) Backup(passphrase interface{}) *Master_Backup_Call {
+	return &Master_Backup_Call{Call: _e.mock.On("Backup", passphrase)}
+}
+
+func (_c *Master_Backup_Call) Run(run func(passphrase string)) *Master_Backup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Master_Backup_Call) Return(_a0 []byte, _a1 error) *Master_Backup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Master_Backup_Call) RunAndReturn(run func(string) ([]byte, error)) *Master_Backup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CSA provides a mock function with no fields
 func (_m *Master) CSA() keystore.CSA {
 	ret := _m.Called()
@@ -454,6 +514,113 @@ func (_c *Master_P2P_Call) RunAndReturn(run func() keystore.P2P) *Master_P2P_Cal
 	return _c
 }
 
+// Restore provides a mock function with given fields: ctx, backup, passphrase
+func (_m *Master) Restore(ctx context.Context, backup []byte, passphrase string) (keystore.BackupManifest, error) {
+	ret := _m.Called(ctx, backup, passphrase)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 keystore.BackupManifest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string) (keystore.BackupManifest, error)); ok {
+		return rf(ctx, backup, passphrase)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string) keystore.BackupManifest); ok {
+		r0 = rf(ctx, backup, passphrase)
+	} else {
+		r0 = ret.Get(0).(keystore.BackupManifest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, string) error); ok {
+		r1 = rf(ctx, backup, passphrase)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Master_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type Master_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - backup []byte
+//   - passphrase string
+func (_e *Master_Expecter) Restore(ctx interface{}, backup interface{}, passphrase interface{}) *Master_Restore_Call {
+	return &Master_Restore_Call{Call: _e.mock.On("Restore", ctx, backup, passphrase)}
+}
+
+func (_c *Master_Restore_Call) Run(run func(ctx context.Context, backup []byte, passphrase string)) *Master_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Master_Restore_Call) Return(_a0 keystore.BackupManifest, _a1 error) *Master_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Master_Restore_Call) RunAndReturn(run func(context.Context, []byte, string) (keystore.BackupManifest, error)) *Master_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotatePassword provides a mock function with given fields: ctx, oldPassword, newPassword, scryptParams
+func (_m *Master) RotatePassword(ctx context.Context, oldPassword string, newPassword string, scryptParams utils.ScryptParams) error {
+	ret := _m.Called(ctx, oldPassword, newPassword, scryptParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, utils.ScryptParams) error); ok {
+		r0 = rf(ctx, oldPassword, newPassword, scryptParams)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Master_RotatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotatePassword'
+type Master_RotatePassword_Call struct {
+	*mock.Call
+}
+
+// RotatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldPassword string
+//   - newPassword string
+//   - scryptParams utils.ScryptParams
+func (_e *Master_Expecter) RotatePassword(ctx interface{}, oldPassword interface{}, newPassword interface{}, scryptParams interface{}) *Master_RotatePassword_Call {
+	return &Master_RotatePassword_Call{Call: _e.mock.On("RotatePassword", ctx, oldPassword, newPassword, scryptParams)}
+}
+
+func (_c *Master_RotatePassword_Call) Run(run func(ctx context.Context, oldPassword string, newPassword string, scryptParams utils.ScryptParams)) *Master_RotatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(utils.ScryptParams))
+	})
+	return _c
+}
+
+func (_c *Master_RotatePassword_Call) Return(_a0 error) *Master_RotatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_RotatePassword_Call) RunAndReturn(run func(context.Context, string, string, utils.ScryptParams) error) *Master_RotatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Solana provides a mock function with no fields
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
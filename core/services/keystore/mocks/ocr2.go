@@ -72,6 +72,67 @@ func (_c *OCR2_Add_Call) RunAndReturn(run func(context.Context, ocr2key.KeyBundl
 	return _c
 }
 
+// AddExternal provides a mock function with given fields: ctx, chainType, onchainHandle, offchainHandle
+func (_m *OCR2) AddExternal(ctx context.Context, chainType chaintype.ChainType, onchainHandle string, offchainHandle string) (ocr2key.KeyBundle, error) {
+	ret := _m.Called(ctx, chainType, onchainHandle, offchainHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddExternal")
+	}
+
+	var r0 ocr2key.KeyBundle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, chaintype.ChainType, string, string) (ocr2key.KeyBundle, error)); ok {
+		return rf(ctx, chainType, onchainHandle, offchainHandle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, chaintype.ChainType, string, string) ocr2key.KeyBundle); ok {
+		r0 = rf(ctx, chainType, onchainHandle, offchainHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ocr2key.KeyBundle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, chaintype.ChainType, string, string) error); ok {
+		r1 = rf(ctx, chainType, onchainHandle, offchainHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OCR2_AddExternal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddExternal'
+type OCR2_AddExternal_Call struct {
+	*mock.Call
+}
+
+// AddExternal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainType chaintype.ChainType
+//   - onchainHandle string
+//   - offchainHandle string
+func (_e *OCR2_Expecter) AddExternal(ctx interface{}, chainType interface{}, onchainHandle interface{}, offchainHandle interface{}) *OCR2_AddExternal_Call {
+	return &OCR2_AddExternal_Call{Call: _e.mock.On("AddExternal", ctx, chainType, onchainHandle, offchainHandle)}
+}
+
+func (_c *OCR2_AddExternal_Call) Run(run func(ctx context.Context, chainType chaintype.ChainType, onchainHandle string, offchainHandle string)) *OCR2_AddExternal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(chaintype.ChainType), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *OCR2_AddExternal_Call) Return(_a0 ocr2key.KeyBundle, _a1 error) *OCR2_AddExternal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OCR2_AddExternal_Call) RunAndReturn(run func(context.Context, chaintype.ChainType, string, string) (ocr2key.KeyBundle, error)) *OCR2_AddExternal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: _a0, _a1
 func (_m *OCR2) Create(_a0 context.Context, _a1 chaintype.ChainType) (ocr2key.KeyBundle, error) {
 	ret := _m.Called(_a0, _a1)
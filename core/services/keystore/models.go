@@ -170,7 +170,9 @@ type keyRing struct {
 	VRF          map[string]vrfkey.KeyV2
 	Workflow     map[string]workflowkey.Key
 	DKGRecipient map[string]dkgrecipientkey.Key
-	LegacyKeys   LegacyKeyStorage
+	// External records the keys held by the external signer, by key ID
+	External   map[string]externalKey
+	LegacyKeys LegacyKeyStorage
 }
 
 func newKeyRing() *keyRing {
@@ -190,11 +192,16 @@ func newKeyRing() *keyRing {
 		VRF:          make(map[string]vrfkey.KeyV2),
 		Workflow:     make(map[string]workflowkey.Key),
 		DKGRecipient: make(map[string]dkgrecipientkey.Key),
+		External:     make(map[string]externalKey),
 	}
 }
 
 func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ekr encryptedKeyRing, err error) {
-	marshalledRawKeyRingJson, err := json.Marshal(kr.raw())
+	rawKeys, err := kr.raw()
+	if err != nil {
+		return ekr, err
+	}
+	marshalledRawKeyRingJson, err := json.Marshal(rawKeys)
 	if err != nil {
 		return ekr, err
 	}
@@ -222,20 +229,32 @@ func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ek
 	}, nil
 }
 
-func (kr *keyRing) raw() (rawKeys rawKeyRing) {
-	for _, csaKey := range kr.CSA {
+func (kr *keyRing) raw() (rawKeys rawKeyRing, err error) {
+	for id, csaKey := range kr.CSA {
+		if kr.isExternal(id) {
+			continue
+		}
 		rawKeys.CSA = append(rawKeys.CSA, internal.RawBytes(csaKey))
 	}
-	for _, ethKey := range kr.Eth {
+	for id, ethKey := range kr.Eth {
+		if kr.isExternal(id) {
+			continue
+		}
 		rawKeys.Eth = append(rawKeys.Eth, internal.RawBytes(ethKey))
 	}
 	for _, ocrKey := range kr.OCR {
 		rawKeys.OCR = append(rawKeys.OCR, internal.RawBytes(ocrKey))
 	}
-	for _, ocr2key := range kr.OCR2 {
+	for id, ocr2key := range kr.OCR2 {
+		if kr.isExternal(id) {
+			continue
+		}
 		rawKeys.OCR2 = append(rawKeys.OCR2, internal.RawBytes(ocr2key))
 	}
-	for _, p2pKey := range kr.P2P {
+	for id, p2pKey := range kr.P2P {
+		if kr.isExternal(id) {
+			continue
+		}
 		rawKeys.P2P = append(rawKeys.P2P, internal.RawBytes(p2pKey))
 	}
 	for _, cosmoskey := range kr.Cosmos {
@@ -268,7 +287,14 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	for _, dkgRecipientKey := range kr.DKGRecipient {
 		rawKeys.DKGRecipient = append(rawKeys.DKGRecipient, internal.RawBytes(dkgRecipientKey))
 	}
-	return rawKeys
+	for _, ext := range kr.External {
+		b, err := json.Marshal(ext)
+		if err != nil {
+			return rawKeys, errors.Wrapf(err, "could not encode external key %s", ext.ID)
+		}
+		rawKeys.External = append(rawKeys.External, b)
+	}
+	return rawKeys, nil
 }
 
 func (kr *keyRing) logPubKeys(lggr logger.Logger) {
@@ -404,7 +430,9 @@ type rawKeyRing struct {
 	VRF          [][]byte
 	Workflow     [][]byte
 	DKGRecipient [][]byte
-	LegacyKeys   LegacyKeyStorage `json:"-"`
+	// External holds JSON encoded externalKey records, rather than raw keys
+	External   [][]byte
+	LegacyKeys LegacyKeyStorage `json:"-"`
 }
 
 func (rawKeys rawKeyRing) keys() (*keyRing, error) {
@@ -471,6 +499,14 @@ func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 		keyRing.DKGRecipient[dkgRecipientKey.ID()] = dkgRecipientKey
 	}
 
+	for _, rawExternalKey := range rawKeys.External {
+		var ext externalKey
+		if err := json.Unmarshal(rawExternalKey, &ext); err != nil {
+			return nil, errors.Wrap(err, "invalid external key")
+		}
+		keyRing.External[ext.ID] = ext
+	}
+
 	keyRing.LegacyKeys = rawKeys.LegacyKeys
 	return keyRing, nil
 }
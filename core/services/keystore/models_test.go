@@ -115,13 +115,15 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 
 	t.Run("test legacy system", func(t *testing.T) {
 		// Add unsupported keys to raw json
-		rawJson, _ := json.Marshal(originalKeyRing.raw())
+		rawKeys, err := originalKeyRing.raw()
+		require.NoError(t, err)
+		rawJson, _ := json.Marshal(rawKeys)
 		var allKeys = map[string][]string{
 			"foo": {
 				"bar", "biz",
 			},
 		}
-		err := json.Unmarshal(rawJson, &allKeys)
+		err = json.Unmarshal(rawJson, &allKeys)
 		require.NoError(t, err)
 		// Add more ocr2 keys
 		newOCR2Key1 := ocrkey.MustNewV2XXXTestingOnly(big.NewInt(5))
@@ -140,7 +142,9 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 		err = originalKeyRing.LegacyKeys.StoreUnsupported(newRawJson, originalKeyRing)
 		require.NoError(t, err)
 		require.Equal(t, 6, originalKeyRing.LegacyKeys.legacyRawKeys.len())
-		marshalledRawKeyRingJson, err := json.Marshal(originalKeyRing.raw())
+		rawKeys, err = originalKeyRing.raw()
+		require.NoError(t, err)
+		marshalledRawKeyRingJson, err := json.Marshal(rawKeys)
 		require.NoError(t, err)
 		unloadedKeysJson, err := originalKeyRing.LegacyKeys.UnloadUnsupported(marshalledRawKeyRingJson)
 		require.NoError(t, err)
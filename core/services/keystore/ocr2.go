@@ -2,9 +2,11 @@ package keystore
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/curve25519"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
@@ -16,6 +18,7 @@ type OCR2 interface {
 	GetAllOfType(chaintype.ChainType) ([]ocr2key.KeyBundle, error)
 	Create(context.Context, chaintype.ChainType) (ocr2key.KeyBundle, error)
 	Add(ctx context.Context, key ocr2key.KeyBundle) error
+	AddExternal(ctx context.Context, chainType chaintype.ChainType, onchainHandle, offchainHandle string) (ocr2key.KeyBundle, error)
 	Delete(ctx context.Context, id string) error
 	Import(ctx context.Context, keyJSON []byte, password string) (ocr2key.KeyBundle, error)
 	Export(id string, password string) ([]byte, error)
@@ -87,6 +90,40 @@ func (ks ocr2) Add(ctx context.Context, key ocr2key.KeyBundle) error {
 	return ks.safeAddKey(ctx, key)
 }
 
+func (ks ocr2) AddExternal(ctx context.Context, chainType chaintype.ChainType, onchainHandle, offchainHandle string) (ocr2key.KeyBundle, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	ext := externalKey{
+		Type:           "OCR2",
+		Handle:         onchainHandle,
+		ChainType:      chainType,
+		OffchainHandle: offchainHandle,
+		EncryptionKey:  make([]byte, curve25519.ScalarSize),
+	}
+	if _, err := rand.Read(ext.EncryptionKey); err != nil {
+		return nil, err
+	}
+	key, err := ks.newExternalKey(ctx, ext)
+	if err != nil {
+		return nil, err
+	}
+	bundle := key.(ocr2key.KeyBundle)
+	if _, found := ks.keyRing.OCR2[bundle.ID()]; found {
+		return nil, fmt.Errorf("key with ID %s already exists", bundle.ID())
+	}
+	ext.ID = bundle.ID()
+	err = ks.safeAddExternalKey(ext, func() error {
+		return ks.safeAddKey(ctx, bundle)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
 func (ks ocr2) Delete(ctx context.Context, id string) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
@@ -127,6 +164,9 @@ func (ks ocr2) Export(id string, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if ks.keyRing.isExternal(key.ID()) {
+		return nil, ErrExternalKey
+	}
 	return ocr2key.ToEncryptedJSON(key, password, ks.scryptParams)
 }
 
@@ -16,6 +16,7 @@ type P2P interface {
 	GetAll() ([]p2pkey.KeyV2, error)
 	Create(ctx context.Context) (p2pkey.KeyV2, error)
 	Add(ctx context.Context, key p2pkey.KeyV2) error
+	AddExternal(ctx context.Context, handle string) (p2pkey.KeyV2, error)
 	Delete(ctx context.Context, id p2pkey.PeerID) (p2pkey.KeyV2, error)
 	Import(ctx context.Context, keyJSON []byte, password string) (p2pkey.KeyV2, error)
 	Export(id p2pkey.PeerID, password string) ([]byte, error)
@@ -82,6 +83,29 @@ func (ks *p2p) Add(ctx context.Context, key p2pkey.KeyV2) error {
 	return ks.safeAddKey(ctx, key)
 }
 
+func (ks *p2p) AddExternal(ctx context.Context, handle string) (p2pkey.KeyV2, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return p2pkey.KeyV2{}, ErrLocked
+	}
+	key, err := ks.newExternalKey(ctx, externalKey{Type: "P2P", Handle: handle})
+	if err != nil {
+		return p2pkey.KeyV2{}, err
+	}
+	p2pKey := key.(p2pkey.KeyV2)
+	if _, found := ks.keyRing.P2P[p2pKey.ID()]; found {
+		return p2pkey.KeyV2{}, fmt.Errorf("p2p key %s: %w", p2pKey.ID(), ErrKeyExists)
+	}
+	err = ks.safeAddExternalKey(externalKey{ID: key.ID(), Type: "P2P", Handle: handle}, func() error {
+		return ks.safeAddKey(ctx, p2pKey)
+	})
+	if err != nil {
+		return p2pkey.KeyV2{}, err
+	}
+	return p2pKey, nil
+}
+
 func (ks *p2p) Delete(ctx context.Context, id p2pkey.PeerID) (p2pkey.KeyV2, error) {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
@@ -125,6 +149,9 @@ func (ks *p2p) Export(id p2pkey.PeerID, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if ks.keyRing.isExternal(key.ID()) {
+		return nil, ErrExternalKey
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
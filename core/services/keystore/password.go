@@ -0,0 +1,104 @@
+package keystore
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"reflect"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var ErrKeystorePasswordMismatch = errors.New("old keystore password did not match")
+
+// RotatePassword re-encrypts the key ring under newPassword and scryptParams,
+// which must not be weaker than the configured ones, and saves it in place of
+// the old one. The keystore keeps using the new
+// password and parameters until it is unlocked again.
+func (km *keyManager) RotatePassword(ctx context.Context, oldPassword, newPassword string, scryptParams utils.ScryptParams) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if subtle.ConstantTimeCompare([]byte(oldPassword), []byte(km.password)) != 1 {
+		return ErrKeystorePasswordMismatch
+	}
+	if err := validateScryptParams(scryptParams, km.minScryptParams); err != nil {
+		return err
+	}
+	if newPassword == "" {
+		return errors.New("new keystore password is empty")
+	}
+	ekr, err := km.keyRing.Encrypt(newPassword, scryptParams)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt keyRing")
+	}
+	kr, err := ekr.Decrypt(newPassword)
+	if err != nil {
+		return errors.Wrap(err, "unable to decrypt re-encrypted keyRing")
+	}
+	if err = km.keyRing.verifyRoundTrip(kr); err != nil {
+		return err
+	}
+	if err = km.orm.saveEncryptedKeyRing(ctx, &ekr); err != nil {
+		return err
+	}
+	km.password = newPassword
+	km.scryptParams = scryptParams
+	return nil
+}
+
+func validateScryptParams(p, minParams utils.ScryptParams) error {
+	if p.N <= 1 || p.N&(p.N-1) != 0 {
+		return errors.Errorf("scrypt N must be a power of two greater than 1, got %d", p.N)
+	}
+	if p.P < 1 {
+		return errors.Errorf("scrypt P must be at least 1, got %d", p.P)
+	}
+	if p.N < minParams.N || p.P < minParams.P {
+		return errors.Errorf("scrypt N=%d P=%d are weaker than the configured N=%d P=%d", p.N, p.P, minParams.N, minParams.P)
+	}
+	return nil
+}
+
+// verifyRoundTrip checks that decrypted holds exactly the keys of kr.
+// warning: not thread-safe! caller must sync
+func (kr *keyRing) verifyRoundTrip(decrypted *keyRing) error {
+	wantRing, err := kr.raw()
+	if err != nil {
+		return err
+	}
+	gotRing, err := decrypted.raw()
+	if err != nil {
+		return err
+	}
+	wantRaw := reflect.ValueOf(wantRing)
+	gotRaw := reflect.ValueOf(gotRing)
+	for i := 0; i < wantRaw.NumField(); i++ {
+		field := wantRaw.Type().Field(i)
+		wantKeys, ok := wantRaw.Field(i).Interface().([][]byte)
+		if !ok {
+			continue
+		}
+		gotKeys := gotRaw.Field(i).Interface().([][]byte)
+		if len(wantKeys) != len(gotKeys) {
+			return errors.Errorf("re-encrypted key ring has %d %s keys, expected %d", len(gotKeys), field.Name, len(wantKeys))
+		}
+		slices.SortFunc(wantKeys, bytes.Compare)
+		slices.SortFunc(gotKeys, bytes.Compare)
+		for j := range wantKeys {
+			if !bytes.Equal(wantKeys[j], gotKeys[j]) {
+				return errors.Errorf("re-encrypted key ring has a mismatched %s key", field.Name)
+			}
+		}
+	}
+	want, got := kr.LegacyKeys.legacyRawKeys, decrypted.LegacyKeys.legacyRawKeys
+	if want.len() != got.len() || (want.len() > 0 && !reflect.DeepEqual(want, got)) {
+		return errors.New("re-encrypted key ring has mismatched legacy keys")
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package keystore
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer/signertest"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestKeystore_RotatePassword(t *testing.T) {
+	ctx := testutils.Context(t)
+	s := signertest.New()
+	s.NewEd25519("csa")
+
+	orm := newInMemoryORM(nil)
+	ks := newExternalTestKeystore(t, orm, s)
+
+	p2pKey, err := ks.P2P().Create(ctx)
+	require.NoError(t, err)
+	bundle, err := ks.OCR2().Create(ctx, chaintype.EVM)
+	require.NoError(t, err)
+	csaKey, err := ks.CSA().AddExternal(ctx, "csa")
+	require.NoError(t, err)
+
+	stronger := utils.ScryptParams{N: utils.FastScryptParams.N * 2, P: 1}
+
+	t.Run("rejects the wrong old password", func(t *testing.T) {
+		err := ks.RotatePassword(ctx, "wrong", "new password", stronger)
+		require.ErrorIs(t, err, ErrKeystorePasswordMismatch)
+	})
+
+	t.Run("rejects invalid scrypt params", func(t *testing.T) {
+		require.Error(t, ks.RotatePassword(ctx, password, "new password", utils.ScryptParams{N: 3, P: 1}))
+		require.Error(t, ks.RotatePassword(ctx, password, "new password", utils.ScryptParams{N: 2, P: 0}))
+	})
+
+	t.Run("rejects scrypt params weaker than the configured ones", func(t *testing.T) {
+		ks.minScryptParams = stronger
+		t.Cleanup(func() { ks.minScryptParams = utils.FastScryptParams })
+		err := ks.RotatePassword(ctx, password, "new password", utils.FastScryptParams)
+		require.ErrorContains(t, err, "weaker than the configured")
+	})
+
+	t.Run("rejects an empty new password", func(t *testing.T) {
+		require.Error(t, ks.RotatePassword(ctx, password, "", stronger))
+	})
+
+	const newPassword = "new password"
+	require.NoError(t, ks.RotatePassword(ctx, password, newPassword, stronger))
+	assert.Equal(t, stronger, ks.scryptParams)
+
+	t.Run("the old password no longer unlocks", func(t *testing.T) {
+		km := &keyManager{
+			orm:          orm,
+			keystateORM:  emptyKeystateORM{},
+			scryptParams: utils.FastScryptParams,
+			lock:         &sync.RWMutex{},
+			announce:     func(Key) {},
+			signer:       s,
+		}
+		require.Error(t, km.Unlock(ctx, password))
+		require.NoError(t, km.Unlock(ctx, newPassword))
+
+		_, err := newP2PKeyStore(km).Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		_, err = newOCR2KeyStore(km).Get(bundle.ID())
+		require.NoError(t, err)
+		reloaded, err := newCSAKeyStore(km).Get(csaKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, csaKey.PublicKey, reloaded.PublicKey)
+	})
+
+	t.Run("later saves use the new password", func(t *testing.T) {
+		_, err := ks.P2P().Create(ctx)
+		require.NoError(t, err)
+		ekr, err := orm.getEncryptedKeyRing(ctx)
+		require.NoError(t, err)
+		kr, err := ekr.Decrypt(newPassword)
+		require.NoError(t, err)
+		assert.Len(t, kr.P2P, 2)
+	})
+}
+
+func TestKeyRing_verifyRoundTrip(t *testing.T) {
+	kr := newKeyRing()
+	require.NoError(t, kr.verifyRoundTrip(newKeyRing()))
+
+	key, err := ocr2key.New(chaintype.EVM)
+	require.NoError(t, err)
+	kr.OCR2[key.ID()] = key
+	err = kr.verifyRoundTrip(newKeyRing())
+	require.ErrorContains(t, err, "has 0 OCR2 keys, expected 1")
+}
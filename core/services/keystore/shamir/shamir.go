@@ -0,0 +1,195 @@
+// Package shamir splits a secret into shares with Shamir's secret sharing
+// over GF(2^8), so that any threshold of them recovers the secret and fewer
+// reveal nothing about it.
+package shamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	sharePrefix    = "clshare1"
+	idLen          = 4
+	checksumLen    = 4
+	minThreshold   = 2
+	maxShareNumber = 255
+)
+
+var (
+	ErrInvalidShare      = errors.New("invalid share")
+	ErrMismatchedShares  = errors.New("shares are from different splits")
+	ErrDuplicateShare    = errors.New("duplicate share")
+	ErrNotEnoughShares   = errors.New("not enough shares")
+	ErrInvalidParameters = errors.New("invalid split parameters")
+)
+
+// Share is one of the shares of a split secret.
+type Share struct {
+	// ID is shared by all the shares of one split.
+	ID        [idLen]byte
+	Threshold int
+	// X is the share number, from 1.
+	X int
+	Y []byte
+}
+
+// Split splits secret into n shares, any threshold of which recover it.
+func Split(secret []byte, n, threshold int) ([]Share, error) {
+	if len(secret) == 0 {
+		return nil, errors.Wrap(ErrInvalidParameters, "secret is empty")
+	}
+	if threshold < minThreshold || threshold > n || n > maxShareNumber {
+		return nil, errors.Wrapf(ErrInvalidParameters, "need %d <= threshold <= shares <= %d, got threshold %d of %d shares", minThreshold, maxShareNumber, threshold, n)
+	}
+	var id [idLen]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+	shares := make([]Share, n)
+	for i := range shares {
+		shares[i] = Share{ID: id, Threshold: threshold, X: i + 1, Y: make([]byte, len(secret))}
+	}
+	coefficients := make([]byte, threshold)
+	for b, s := range secret {
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, err
+		}
+		coefficients[0] = s
+		for i := range shares {
+			shares[i].Y[b] = evaluate(coefficients, byte(shares[i].X))
+		}
+	}
+	return shares, nil
+}
+
+// Combine recovers the secret from at least threshold shares of one split.
+func Combine(shares []Share) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, ErrNotEnoughShares
+	}
+	first := shares[0]
+	seen := make(map[int]bool, len(shares))
+	for _, share := range shares {
+		if share.ID != first.ID || share.Threshold != first.Threshold || len(share.Y) != len(first.Y) {
+			return nil, ErrMismatchedShares
+		}
+		if seen[share.X] {
+			return nil, errors.Wrapf(ErrDuplicateShare, "share %d", share.X)
+		}
+		seen[share.X] = true
+	}
+	if len(shares) < first.Threshold {
+		return nil, errors.Wrapf(ErrNotEnoughShares, "have %d of %d", len(shares), first.Threshold)
+	}
+	shares = shares[:first.Threshold]
+
+	secret := make([]byte, len(first.Y))
+	for b := range secret {
+		// Lagrange interpolation at x = 0. Subtraction is xor in GF(2^8).
+		var s byte
+		for j, sj := range shares {
+			basis := byte(1)
+			for m, sm := range shares {
+				if m == j {
+					continue
+				}
+				basis = mul(basis, div(byte(sm.X), byte(sm.X)^byte(sj.X)))
+			}
+			s ^= mul(sj.Y[b], basis)
+		}
+		secret[b] = s
+	}
+	return secret, nil
+}
+
+// String encodes the share as text, with a checksum to catch typos.
+func (s Share) String() string {
+	body := fmt.Sprintf("%s-%x-%d-%d-%x", sharePrefix, s.ID, s.Threshold, s.X, s.Y)
+	return fmt.Sprintf("%s-%x", body, checksum(body))
+}
+
+// ParseShare decodes a share encoded by Share.String.
+func ParseShare(text string) (Share, error) {
+	text = strings.TrimSpace(text)
+	parts := strings.Split(text, "-")
+	if len(parts) != 6 || parts[0] != sharePrefix {
+		return Share{}, errors.Wrap(ErrInvalidShare, "unrecognized format")
+	}
+	body := strings.Join(parts[:5], "-")
+	sum, err := hex.DecodeString(parts[5])
+	if err != nil || !bytes.Equal(sum, checksum(body)) {
+		return Share{}, errors.Wrap(ErrInvalidShare, "checksum mismatch")
+	}
+
+	var s Share
+	id, err := hex.DecodeString(parts[1])
+	if err != nil || len(id) != idLen {
+		return Share{}, errors.Wrap(ErrInvalidShare, "bad id")
+	}
+	copy(s.ID[:], id)
+	if s.Threshold, err = strconv.Atoi(parts[2]); err != nil || s.Threshold < minThreshold || s.Threshold > maxShareNumber {
+		return Share{}, errors.Wrap(ErrInvalidShare, "bad threshold")
+	}
+	if s.X, err = strconv.Atoi(parts[3]); err != nil || s.X < 1 || s.X > maxShareNumber {
+		return Share{}, errors.Wrap(ErrInvalidShare, "bad share number")
+	}
+	if s.Y, err = hex.DecodeString(parts[4]); err != nil || len(s.Y) == 0 {
+		return Share{}, errors.Wrap(ErrInvalidShare, "bad share data")
+	}
+	return s, nil
+}
+
+func checksum(body string) []byte {
+	sum := sha256.Sum256([]byte(body))
+	return sum[:checksumLen]
+}
+
+// evaluate returns the polynomial with the given coefficients, lowest degree
+// first, at x.
+func evaluate(coefficients []byte, x byte) byte {
+	var y byte
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		y = mul(y, x) ^ coefficients[i]
+	}
+	return y
+}
+
+var expTable, logTable = func() (exp [255]byte, log [256]byte) {
+	// 3 generates the multiplicative group of GF(2^8) with the AES polynomial
+	x := byte(1)
+	for i := range exp {
+		exp[i] = x
+		log[x] = byte(i)
+		xtime := x << 1
+		if x&0x80 != 0 {
+			xtime ^= 0x1b
+		}
+		x ^= xtime
+	}
+	return
+}()
+
+func mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])+int(logTable[b]))%255]
+}
+
+func div(a, b byte) byte {
+	if b == 0 {
+		panic("shamir: division by zero")
+	}
+	if a == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])-int(logTable[b])+255)%255]
+}
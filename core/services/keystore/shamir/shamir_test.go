@@ -0,0 +1,90 @@
+package shamir
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGF256(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			require.Equal(t, byte(a), div(mul(byte(a), byte(b)), byte(b)))
+		}
+	}
+	assert.Equal(t, byte(0xc1), mul(0x57, 0x83)) // FIPS-197 section 4.2
+}
+
+func TestSplitCombine(t *testing.T) {
+	secret := []byte("correct horse battery staple")
+
+	shares, err := Split(secret, 5, 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}} {
+		var quorum []Share
+		for _, i := range subset {
+			quorum = append(quorum, shares[i])
+		}
+		got, err := Combine(quorum)
+		require.NoError(t, err)
+		assert.Equal(t, secret, got)
+	}
+
+	_, err = Combine(shares[:2])
+	require.ErrorIs(t, err, ErrNotEnoughShares)
+
+	_, err = Combine([]Share{shares[0], shares[0], shares[1]})
+	require.ErrorIs(t, err, ErrDuplicateShare)
+
+	other, err := Split(secret, 5, 3)
+	require.NoError(t, err)
+	_, err = Combine([]Share{shares[0], shares[1], other[2]})
+	require.ErrorIs(t, err, ErrMismatchedShares)
+}
+
+func TestSplit_InvalidParameters(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		secret       []byte
+		n, threshold int
+	}{
+		{"empty secret", nil, 3, 2},
+		{"threshold of one", []byte("secret"), 3, 1},
+		{"threshold above shares", []byte("secret"), 2, 3},
+		{"too many shares", []byte("secret"), 256, 2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Split(tt.secret, tt.n, tt.threshold)
+			require.ErrorIs(t, err, ErrInvalidParameters)
+		})
+	}
+}
+
+func TestShare_String(t *testing.T) {
+	shares, err := Split([]byte("secret"), 3, 2)
+	require.NoError(t, err)
+
+	text := shares[1].String()
+	parsed, err := ParseShare(" " + text + "\n")
+	require.NoError(t, err)
+	assert.Equal(t, shares[1], parsed)
+
+	t.Run("typo", func(t *testing.T) {
+		typo := []byte(text)
+		if typo[len(typo)-12] == '0' {
+			typo[len(typo)-12] = '1'
+		} else {
+			typo[len(typo)-12] = '0'
+		}
+		_, err := ParseShare(string(typo))
+		require.ErrorIs(t, err, ErrInvalidShare)
+	})
+
+	t.Run("not a share", func(t *testing.T) {
+		_, err := ParseShare("password")
+		require.ErrorIs(t, err, ErrInvalidShare)
+	})
+}
@@ -0,0 +1,71 @@
+package signer
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/asn1"
+	"math/big"
+
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+var (
+	secp256k1N     = gethcrypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// MarshalECDSASignature ASN.1 DER encodes an ECDSA signature.
+func MarshalECDSASignature(r, s *big.Int) ([]byte, error) {
+	return asn1.Marshal(ecdsaSignature{R: r, S: s})
+}
+
+// SignEthereum signs a digest with a secp256k1 key and returns the signature
+// in the 65 byte [R || S || V] format of crypto.Sign. External signers don't
+// return the recovery ID V, so it is found by recovering the public key.
+func SignEthereum(s crypto.Signer, digest []byte) ([]byte, error) {
+	pub, ok := s.Public().(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.Errorf("expected a secp256k1 key, got %T", s.Public())
+	}
+	// Keccak256 has no crypto.Hash, so SHA3_256, of the same size, stands in
+	// for it. crypto/ecdsa keys refuse to sign without a hash function.
+	der, err := s.Sign(rand.Reader, digest, crypto.SHA3_256)
+	if err != nil {
+		return nil, err
+	}
+	var sig ecdsaSignature
+	rest, err := asn1.Unmarshal(der, &sig)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid ECDSA signature")
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("invalid ECDSA signature: trailing data")
+	}
+	if sig.R.Sign() <= 0 || sig.S.Sign() <= 0 || sig.R.Cmp(secp256k1N) >= 0 || sig.S.Cmp(secp256k1N) >= 0 {
+		return nil, errors.New("invalid ECDSA signature: value out of range")
+	}
+	// EIP-2 requires S to be in the lower half of the curve order
+	if sig.S.Cmp(secp256k1HalfN) > 0 {
+		sig.S = new(big.Int).Sub(secp256k1N, sig.S)
+	}
+
+	ethSig := make([]byte, 65)
+	sig.R.FillBytes(ethSig[:32])
+	sig.S.FillBytes(ethSig[32:64])
+	expected := gethcrypto.FromECDSAPub(pub)
+	for v := byte(0); v < 2; v++ {
+		ethSig[64] = v
+		recovered, err := gethcrypto.Ecrecover(digest, ethSig)
+		if err == nil && bytes.Equal(recovered, expected) {
+			return ethSig, nil
+		}
+	}
+	return nil, errors.New("signature does not match the public key of the signer")
+}
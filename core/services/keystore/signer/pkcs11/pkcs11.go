@@ -0,0 +1,237 @@
+// Package pkcs11 implements a signer.Signer for HSMs with a PKCS#11 interface,
+// such as SoftHSM. Keys are referred to by the label of their private key
+// object, and their public key object is expected to share the same label.
+package pkcs11
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/miekg/pkcs11"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+)
+
+// Edwards curve constants of PKCS#11 v3.0, which github.com/miekg/pkcs11 doesn't define.
+const (
+	ckkECEdwards = 0x00000040
+	ckmEdDSA     = 0x00001057
+)
+
+var (
+	oidSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+	oidEd25519   = asn1.ObjectIdentifier{1, 3, 101, 112}
+)
+
+type Config struct {
+	// Module is the path of the PKCS#11 library.
+	Module string
+	// TokenLabel is the label of the token holding the keys.
+	TokenLabel string
+	// PIN is the user PIN of the token.
+	PIN string
+}
+
+var _ signer.Signer = &Signer{}
+
+type Signer struct {
+	ctx *pkcs11.Ctx
+
+	// PKCS#11 sessions can't be used concurrently
+	mu      sync.Mutex
+	session pkcs11.SessionHandle
+}
+
+// New loads the PKCS#11 library and logs in to the token.
+func New(cfg Config) (*Signer, error) {
+	ctx := pkcs11.New(cfg.Module)
+	if ctx == nil {
+		return nil, fmt.Errorf("failed to load PKCS#11 module %s", cfg.Module)
+	}
+	s := &Signer{ctx: ctx}
+	if err := s.open(cfg); err != nil {
+		ctx.Destroy()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Signer) open(cfg Config) error {
+	if err := s.ctx.Initialize(); err != nil {
+		return fmt.Errorf("failed to initialize PKCS#11 module: %w", err)
+	}
+	slot, err := s.findSlot(cfg.TokenLabel)
+	if err != nil {
+		return errors.Join(err, s.ctx.Finalize())
+	}
+	s.session, err = s.ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to open PKCS#11 session: %w", err), s.ctx.Finalize())
+	}
+	err = s.ctx.Login(s.session, pkcs11.CKU_USER, cfg.PIN)
+	if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		return errors.Join(fmt.Errorf("failed to log in to PKCS#11 token: %w", err), s.ctx.CloseSession(s.session), s.ctx.Finalize())
+	}
+	return nil
+}
+
+func (s *Signer) findSlot(tokenLabel string) (uint, error) {
+	slots, err := s.ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list PKCS#11 slots: %w", err)
+	}
+	for _, slot := range slots {
+		info, err := s.ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get info of PKCS#11 token in slot %d: %w", slot, err)
+		}
+		if info.Label == tokenLabel {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("PKCS#11 token %q not found", tokenLabel)
+}
+
+func (s *Signer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := errors.Join(s.ctx.Logout(s.session), s.ctx.CloseSession(s.session), s.ctx.Finalize())
+	s.ctx.Destroy()
+	return err
+}
+
+// caller must hold lock!
+func (s *Signer) findObject(class uint, label string) (pkcs11.ObjectHandle, error) {
+	if err := s.ctx.FindObjectsInit(s.session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}); err != nil {
+		return 0, err
+	}
+	objs, _, err := s.ctx.FindObjects(s.session, 2)
+	err = errors.Join(err, s.ctx.FindObjectsFinal(s.session))
+	if err != nil {
+		return 0, err
+	}
+	switch len(objs) {
+	case 0:
+		return 0, fmt.Errorf("key %s not found", label)
+	case 1:
+		return objs[0], nil
+	}
+	return 0, fmt.Errorf("key label %s is ambiguous", label)
+}
+
+func (s *Signer) PublicKey(ctx context.Context, handle string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, err := s.findObject(pkcs11.CKO_PUBLIC_KEY, handle)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := s.ctx.GetAttributeValue(s.session, obj, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key of %s: %w", handle, err)
+	}
+	var params, point []byte
+	for _, a := range attrs {
+		switch a.Type {
+		case pkcs11.CKA_EC_PARAMS:
+			params = a.Value
+		case pkcs11.CKA_EC_POINT:
+			point = a.Value
+		}
+	}
+	return parsePublicKey(params, point)
+}
+
+// parsePublicKey parses the CKA_EC_PARAMS and CKA_EC_POINT attributes of a public key.
+func parsePublicKey(params, point []byte) (crypto.PublicKey, error) {
+	// CKA_EC_POINT is a DER encoded OCTET STRING, although some tokens
+	// return the bare point
+	var unwrapped []byte
+	if rest, err := asn1.Unmarshal(point, &unwrapped); err == nil && len(rest) == 0 {
+		point = unwrapped
+	}
+
+	var oid asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(params, &oid); err != nil {
+		// Ed25519 keys may name their curve instead
+		var name string
+		if _, err2 := asn1.Unmarshal(params, &name); err2 != nil || name != "edwards25519" {
+			return nil, fmt.Errorf("unknown curve parameters: %w", signer.ErrUnsupportedKey)
+		}
+		oid = oidEd25519
+	}
+	switch {
+	case oid.Equal(oidSecp256k1):
+		return gethcrypto.UnmarshalPubkey(point)
+	case oid.Equal(oidEd25519):
+		if len(point) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid ed25519 public key length %d", len(point))
+		}
+		return ed25519.PublicKey(point), nil
+	}
+	return nil, fmt.Errorf("curve %s: %w", oid, signer.ErrUnsupportedKey)
+}
+
+func (s *Signer) Sign(ctx context.Context, handle string, data []byte) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, err := s.findObject(pkcs11.CKO_PRIVATE_KEY, handle)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := s.ctx.GetAttributeValue(s.session, obj, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key type of %s: %w", handle, err)
+	}
+	keyType := bytesToUint(attrs[0].Value)
+
+	var mechanism uint
+	switch keyType {
+	case pkcs11.CKK_EC:
+		mechanism = pkcs11.CKM_ECDSA
+	case ckkECEdwards:
+		mechanism = ckmEdDSA
+	default:
+		return nil, fmt.Errorf("key %s has PKCS#11 key type %#x: %w", handle, keyType, signer.ErrUnsupportedKey)
+	}
+	if err = s.ctx.SignInit(s.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, obj); err != nil {
+		return nil, fmt.Errorf("failed to sign with %s: %w", handle, err)
+	}
+	sig, err := s.ctx.Sign(s.session, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with %s: %w", handle, err)
+	}
+	if mechanism == ckmEdDSA {
+		return sig, nil
+	}
+	// CKM_ECDSA returns r || s
+	if len(sig)%2 != 0 {
+		return nil, fmt.Errorf("invalid ECDSA signature length %d", len(sig))
+	}
+	half := len(sig) / 2
+	return signer.MarshalECDSASignature(new(big.Int).SetBytes(sig[:half]), new(big.Int).SetBytes(sig[half:]))
+}
+
+// bytesToUint decodes a CK_ULONG attribute, which is in native, little-endian, byte order.
+func bytesToUint(b []byte) uint {
+	var v uint
+	for i := len(b) - 1; i >= 0; i-- {
+		v = v<<8 | uint(b[i])
+	}
+	return v
+}
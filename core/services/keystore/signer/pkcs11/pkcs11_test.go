@@ -0,0 +1,124 @@
+package pkcs11
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/asn1"
+	"os"
+	"testing"
+
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+)
+
+func mustMarshal(t *testing.T, v any) []byte {
+	b, err := asn1.Marshal(v)
+	require.NoError(t, err)
+	return b
+}
+
+func Test_parsePublicKey(t *testing.T) {
+	ethKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	ethPoint := gethcrypto.FromECDSAPub(&ethKey.PublicKey)
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name   string
+		params []byte
+		point  []byte
+		exp    crypto.PublicKey
+		err    string
+	}{
+		{"secp256k1", mustMarshal(t, oidSecp256k1), mustMarshal(t, ethPoint), &ethKey.PublicKey, ""},
+		{"secp256k1 bare point", mustMarshal(t, oidSecp256k1), ethPoint, &ethKey.PublicKey, ""},
+		{"ed25519", mustMarshal(t, oidEd25519), mustMarshal(t, []byte(edPub)), edPub, ""},
+		{"ed25519 curve name", mustMarshal(t, "edwards25519"), mustMarshal(t, []byte(edPub)), edPub, ""},
+		{"ed25519 invalid length", mustMarshal(t, oidEd25519), mustMarshal(t, []byte{1, 2, 3}), nil, "invalid ed25519 public key length 3"},
+		{"P-256", mustMarshal(t, asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}), ethPoint, nil, signer.ErrUnsupportedKey.Error()},
+		{"invalid params", []byte{1, 2, 3}, ethPoint, nil, signer.ErrUnsupportedKey.Error()},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pub, err := parsePublicKey(tt.params, tt.point)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, pub)
+		})
+	}
+}
+
+// TestSigner runs against a real token, e.g. SoftHSM:
+//
+//	softhsm2-util --init-token --free --label chainlink --pin 1234 --so-pin 1234
+//	CL_PKCS11_MODULE=/usr/lib/softhsm/libsofthsm2.so CL_PKCS11_TOKEN=chainlink CL_PKCS11_PIN=1234 go test .
+func TestSigner(t *testing.T) {
+	cfg := Config{Module: os.Getenv("CL_PKCS11_MODULE"), TokenLabel: os.Getenv("CL_PKCS11_TOKEN"), PIN: os.Getenv("CL_PKCS11_PIN")}
+	if cfg.Module == "" {
+		t.Skip("CL_PKCS11_MODULE not set")
+	}
+	ctx := testutils.Context(t)
+	s, err := New(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, s.Close()) })
+
+	generate := func(label string, mechanism uint, keyType uint, params []byte) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		_, _, err := s.ctx.GenerateKeyPair(s.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)},
+			[]*pkcs11.Attribute{
+				pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, keyType),
+				pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+				pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, params),
+				pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+			},
+			[]*pkcs11.Attribute{
+				pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, keyType),
+				pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+				pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+				pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+				pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+			})
+		require.NoError(t, err)
+	}
+
+	t.Run("secp256k1", func(t *testing.T) {
+		label := "eth-" + t.Name()
+		generate(label, pkcs11.CKM_EC_KEY_PAIR_GEN, pkcs11.CKK_EC, mustMarshal(t, oidSecp256k1))
+		key, err := signer.NewKey(ctx, s, label)
+		require.NoError(t, err)
+
+		digest := gethcrypto.Keccak256([]byte("hello"))
+		sig, err := signer.SignEthereum(key, digest)
+		require.NoError(t, err)
+		pub, err := gethcrypto.SigToPub(digest, sig)
+		require.NoError(t, err)
+		assert.Equal(t, key.Public(), pub)
+	})
+
+	t.Run("ed25519", func(t *testing.T) {
+		label := "csa-" + t.Name()
+		generate(label, 0x00001055 /* CKM_EC_EDWARDS_KEY_PAIR_GEN */, ckkECEdwards, mustMarshal(t, oidEd25519))
+		key, err := signer.NewKey(ctx, s, label)
+		require.NoError(t, err)
+
+		msg := []byte("hello")
+		sig, err := key.Sign(rand.Reader, msg, crypto.Hash(0))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(key.Public().(ed25519.PublicKey), msg, sig))
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := s.PublicKey(ctx, "missing")
+		assert.ErrorContains(t, err, "key missing not found")
+	})
+}
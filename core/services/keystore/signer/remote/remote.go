@@ -0,0 +1,124 @@
+// Package remote implements a signer.Signer for a remote signing service
+// speaking the gRPC RemoteSigner protocol of package pb, and a server exposing
+// any signer.Signer over that protocol.
+package remote
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/tls"
+	"fmt"
+
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer/remote/pb"
+)
+
+type Config struct {
+	// URL is the address of the remote signer.
+	URL string
+	// TLSCertPath is the path of the CA certificate to verify the remote
+	// signer with. The system roots are used if empty.
+	TLSCertPath string
+}
+
+var _ signer.Signer = &Signer{}
+
+type Signer struct {
+	conn   *grpc.ClientConn
+	client pb.RemoteSignerClient
+}
+
+// New connects to the remote signer. Connections always use TLS, since they
+// carry the data being signed.
+func New(cfg Config) (*Signer, error) {
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if cfg.TLSCertPath != "" {
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(cfg.TLSCertPath, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to load remote signer TLS certificate: %w", err)
+		}
+	}
+	conn, err := grpc.NewClient(cfg.URL, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to remote signer %s: %w", cfg.URL, err)
+	}
+	return &Signer{conn: conn, client: pb.NewRemoteSignerClient(conn)}, nil
+}
+
+// NewFromConn returns a Signer using an existing connection, which is not
+// closed by Close.
+func NewFromConn(conn grpc.ClientConnInterface) *Signer {
+	return &Signer{client: pb.NewRemoteSignerClient(conn)}
+}
+
+func (s *Signer) PublicKey(ctx context.Context, handle string) (crypto.PublicKey, error) {
+	resp, err := s.client.PublicKey(ctx, &pb.PublicKeyRequest{Handle: handle})
+	if err != nil {
+		return nil, err
+	}
+	switch resp.KeyType {
+	case pb.KeyType_KEY_TYPE_SECP256K1:
+		return gethcrypto.UnmarshalPubkey(resp.PublicKey)
+	case pb.KeyType_KEY_TYPE_ED25519:
+		if len(resp.PublicKey) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid ed25519 public key length %d", len(resp.PublicKey))
+		}
+		return ed25519.PublicKey(resp.PublicKey), nil
+	}
+	return nil, fmt.Errorf("key type %s: %w", resp.KeyType, signer.ErrUnsupportedKey)
+}
+
+func (s *Signer) Sign(ctx context.Context, handle string, data []byte) ([]byte, error) {
+	resp, err := s.client.Sign(ctx, &pb.SignRequest{Handle: handle, Data: data})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Signature, nil
+}
+
+func (s *Signer) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
+
+type server struct {
+	pb.UnimplementedRemoteSignerServer
+	signer signer.Signer
+}
+
+// NewServer returns a RemoteSigner server signing with s, e.g. to front an
+// HSM shared by several nodes.
+func NewServer(s signer.Signer) pb.RemoteSignerServer {
+	return &server{signer: s}
+}
+
+func (s *server) PublicKey(ctx context.Context, req *pb.PublicKeyRequest) (*pb.PublicKeyResponse, error) {
+	pub, err := s.signer.PublicKey(ctx, req.Handle)
+	if err != nil {
+		return nil, err
+	}
+	switch pk := pub.(type) {
+	case *ecdsa.PublicKey:
+		return &pb.PublicKeyResponse{KeyType: pb.KeyType_KEY_TYPE_SECP256K1, PublicKey: gethcrypto.FromECDSAPub(pk)}, nil
+	case ed25519.PublicKey:
+		return &pb.PublicKeyResponse{KeyType: pb.KeyType_KEY_TYPE_ED25519, PublicKey: pk}, nil
+	}
+	return nil, signer.ErrUnsupportedKey
+}
+
+func (s *server) Sign(ctx context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	sig, err := s.signer.Sign(ctx, req.Handle, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignResponse{Signature: sig}, nil
+}
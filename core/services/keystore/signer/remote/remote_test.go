@@ -0,0 +1,80 @@
+package remote_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"testing"
+
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer/remote"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer/remote/pb"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer/signertest"
+)
+
+func newRemoteSigner(t *testing.T, backend signer.Signer) *remote.Signer {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	pb.RegisterRemoteSignerServer(srv, remote.NewServer(backend))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+	s := remote.NewFromConn(conn)
+	t.Cleanup(func() { assert.NoError(t, s.Close()) })
+	return s
+}
+
+func TestSigner(t *testing.T) {
+	ctx := testutils.Context(t)
+	backend := signertest.New()
+	ethKey := backend.NewSecp256k1("eth")
+	csaKey := backend.NewEd25519("csa")
+	s := newRemoteSigner(t, backend)
+
+	t.Run("secp256k1", func(t *testing.T) {
+		key, err := signer.NewKey(ctx, s, "eth")
+		require.NoError(t, err)
+		assert.Equal(t, gethcrypto.FromECDSAPub(&ethKey.PublicKey), gethcrypto.FromECDSAPub(key.Public().(*ecdsa.PublicKey)))
+
+		digest := gethcrypto.Keccak256([]byte("hello"))
+		sig, err := signer.SignEthereum(key, digest)
+		require.NoError(t, err)
+		pub, err := gethcrypto.SigToPub(digest, sig)
+		require.NoError(t, err)
+		assert.Equal(t, gethcrypto.PubkeyToAddress(ethKey.PublicKey), gethcrypto.PubkeyToAddress(*pub))
+	})
+
+	t.Run("ed25519", func(t *testing.T) {
+		key, err := signer.NewKey(ctx, s, "csa")
+		require.NoError(t, err)
+		assert.Equal(t, csaKey.Public(), key.Public())
+
+		msg := []byte("hello")
+		sig, err := key.Sign(rand.Reader, msg, crypto.Hash(0))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(csaKey.Public().(ed25519.PublicKey), msg, sig))
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := s.PublicKey(ctx, "missing")
+		assert.ErrorContains(t, err, "key missing not found")
+		_, err = s.Sign(ctx, "missing", []byte("hello"))
+		assert.ErrorContains(t, err, "key missing not found")
+	})
+}
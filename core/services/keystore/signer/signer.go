@@ -0,0 +1,82 @@
+// Package signer defines backends that hold private keys outside of the
+// keystore's encrypted key ring, such as an HSM or a remote signing service.
+// The keystore only keeps a handle for such keys and signs through the backend.
+package signer
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"io"
+	"time"
+
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// ErrUnsupportedKey is returned for keys which are neither secp256k1 nor ed25519 keys.
+var ErrUnsupportedKey = errors.New("unsupported key type, expected a secp256k1 or ed25519 key")
+
+// signTimeout bounds signing through the crypto.Signer interface, which has no context.
+const signTimeout = 30 * time.Second
+
+// Signer is an external signer backend. Keys are referred to by a handle,
+// whose meaning is up to the backend, e.g. the label of a PKCS#11 key.
+//
+// All its functions should be thread-safe.
+type Signer interface {
+	// PublicKey returns the public key of a key, either an *ecdsa.PublicKey on
+	// the secp256k1 curve or an ed25519.PublicKey.
+	PublicKey(ctx context.Context, handle string) (crypto.PublicKey, error)
+	// Sign signs data with a key. data is the digest to sign for secp256k1
+	// keys and the whole message for ed25519 keys. ECDSA signatures are ASN.1
+	// DER encoded, as for crypto.Signer.
+	Sign(ctx context.Context, handle string, data []byte) ([]byte, error)
+	Close() error
+}
+
+var _ crypto.Signer = &Key{}
+
+// Key is a key held by a Signer. It implements crypto.Signer, so that it can
+// be used in place of an in-memory private key.
+type Key struct {
+	signer Signer
+	handle string
+	public crypto.PublicKey
+}
+
+// NewKey looks up the key with the given handle.
+func NewKey(ctx context.Context, s Signer, handle string) (*Key, error) {
+	pub, err := s.PublicKey(ctx, handle)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get public key of %s", handle)
+	}
+	switch pk := pub.(type) {
+	case *ecdsa.PublicKey:
+		if pk.Curve != gethcrypto.S256() {
+			return nil, errors.Wrapf(ErrUnsupportedKey, "key %s", handle)
+		}
+	case ed25519.PublicKey:
+		if len(pk) != ed25519.PublicKeySize {
+			return nil, errors.Errorf("key %s: invalid ed25519 public key length %d", handle, len(pk))
+		}
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedKey, "key %s has type %T", handle, pub)
+	}
+	return &Key{signer: s, handle: handle, public: pub}, nil
+}
+
+// Handle returns the handle the key is known by to its Signer.
+func (k *Key) Handle() string { return k.handle }
+
+func (k *Key) Public() crypto.PublicKey { return k.public }
+
+func (k *Key) Sign(_ io.Reader, data []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if _, ok := k.public.(ed25519.PublicKey); ok && opts != nil && opts.HashFunc() != crypto.Hash(0) {
+		return nil, errors.New("ed25519: cannot sign hashed message")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+	return k.signer.Sign(ctx, k.handle, data)
+}
@@ -0,0 +1,109 @@
+package signer_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer/signertest"
+)
+
+// highSSigner returns the signatures of signertest.Signer with S in the upper
+// half of the curve order, as some HSMs do.
+type highSSigner struct {
+	*signertest.Signer
+}
+
+func (s highSSigner) Sign(ctx context.Context, handle string, data []byte) ([]byte, error) {
+	sig, err := s.Signer.Sign(ctx, handle, data)
+	if err != nil {
+		return nil, err
+	}
+	var rs struct{ R, S *big.Int }
+	if _, err = asn1.Unmarshal(sig, &rs); err != nil {
+		return nil, err
+	}
+	return signer.MarshalECDSASignature(rs.R, new(big.Int).Sub(gethcrypto.S256().Params().N, rs.S))
+}
+
+func TestSignEthereum(t *testing.T) {
+	ctx := testutils.Context(t)
+	digest := gethcrypto.Keccak256([]byte("hello"))
+
+	for name, s := range map[string]interface {
+		signer.Signer
+		NewSecp256k1(handle string) *ecdsa.PrivateKey
+	}{
+		"low S":  signertest.New(),
+		"high S": highSSigner{signertest.New()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			pk := s.NewSecp256k1("eth")
+			key, err := signer.NewKey(ctx, s, "eth")
+			require.NoError(t, err)
+			assert.Equal(t, "eth", key.Handle())
+
+			sig, err := signer.SignEthereum(key, digest)
+			require.NoError(t, err)
+			require.Len(t, sig, 65)
+			pub, err := gethcrypto.SigToPub(digest, sig)
+			require.NoError(t, err)
+			assert.Equal(t, gethcrypto.PubkeyToAddress(pk.PublicKey), gethcrypto.PubkeyToAddress(*pub))
+			assert.True(t, gethcrypto.ValidateSignatureValues(sig[64], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]), true))
+		})
+	}
+
+	t.Run("rejects ed25519 keys", func(t *testing.T) {
+		s := signertest.New()
+		s.NewEd25519("csa")
+		key, err := signer.NewKey(ctx, s, "csa")
+		require.NoError(t, err)
+		_, err = signer.SignEthereum(key, digest)
+		assert.ErrorContains(t, err, "expected a secp256k1 key")
+	})
+}
+
+type p256Signer struct {
+	*signertest.Signer
+	key *ecdsa.PrivateKey
+}
+
+func (s p256Signer) PublicKey(context.Context, string) (crypto.PublicKey, error) {
+	return &s.key.PublicKey, nil
+}
+
+func TestNewKey(t *testing.T) {
+	ctx := testutils.Context(t)
+	s := signertest.New()
+
+	_, err := signer.NewKey(ctx, s, "missing")
+	assert.ErrorContains(t, err, "key missing not found")
+
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, err = signer.NewKey(ctx, p256Signer{s, p256}, "p256")
+	assert.ErrorIs(t, err, signer.ErrUnsupportedKey)
+
+	edKey := s.NewEd25519("csa")
+	key, err := signer.NewKey(ctx, s, "csa")
+	require.NoError(t, err)
+	assert.Equal(t, edKey.Public(), key.Public())
+	msg := []byte("hello")
+	sig, err := key.Sign(rand.Reader, msg, crypto.Hash(0))
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(edKey.Public().(ed25519.PublicKey), msg, sig))
+	_, err = key.Sign(rand.Reader, msg, crypto.SHA256)
+	assert.Error(t, err)
+}
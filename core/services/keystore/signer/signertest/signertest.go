@@ -0,0 +1,99 @@
+// Package signertest provides an in-memory signer.Signer for tests.
+package signertest
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"math/big"
+	"sync"
+
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+)
+
+var _ signer.Signer = &Signer{}
+
+// Signer keeps its keys in memory.
+type Signer struct {
+	mu   sync.RWMutex
+	keys map[string]crypto.PrivateKey
+}
+
+func New() *Signer {
+	return &Signer{keys: make(map[string]crypto.PrivateKey)}
+}
+
+// NewSecp256k1 generates a secp256k1 key with the given handle.
+func (s *Signer) NewSecp256k1(handle string) *ecdsa.PrivateKey {
+	key, err := gethcrypto.GenerateKey()
+	if err != nil {
+		panic(err)
+	}
+	s.add(handle, key)
+	return key
+}
+
+// NewEd25519 generates an ed25519 key with the given handle.
+func (s *Signer) NewEd25519(handle string) ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	s.add(handle, key)
+	return key
+}
+
+func (s *Signer) add(handle string, key crypto.PrivateKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[handle] = key
+}
+
+func (s *Signer) get(handle string) (crypto.PrivateKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key, ok := s.keys[handle]
+	if !ok {
+		return nil, errors.Errorf("key %s not found", handle)
+	}
+	return key, nil
+}
+
+func (s *Signer) PublicKey(ctx context.Context, handle string) (crypto.PublicKey, error) {
+	key, err := s.get(handle)
+	if err != nil {
+		return nil, err
+	}
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		return &k.PublicKey, nil
+	case ed25519.PrivateKey:
+		return k.Public(), nil
+	}
+	return nil, signer.ErrUnsupportedKey
+}
+
+func (s *Signer) Sign(ctx context.Context, handle string, data []byte) ([]byte, error) {
+	key, err := s.get(handle)
+	if err != nil {
+		return nil, err
+	}
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		sig, err := gethcrypto.Sign(data, k)
+		if err != nil {
+			return nil, err
+		}
+		return signer.MarshalECDSASignature(new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]))
+	case ed25519.PrivateKey:
+		return ed25519.Sign(k, data), nil
+	}
+	return nil, signer.ErrUnsupportedKey
+}
+
+func (s *Signer) Close() error { return nil }
@@ -539,6 +539,7 @@ func BenchmarkObserve(b *testing.B) {
 		nil,
 		nil,
 		nil,
+		nil,
 		lggr,
 		c,
 		c,
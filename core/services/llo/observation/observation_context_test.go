@@ -234,6 +234,7 @@ func TestObservationContext_Observe_integrationRealPipeline(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		lggr,
 		c,
 		c,
@@ -316,6 +317,7 @@ func BenchmarkObservationContext_Observe_integrationRealPipeline_concurrencyStre
 		nil,
 		nil,
 		nil,
+		nil,
 		lggr,
 		c,
 		c,
@@ -19,13 +19,16 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/nodestatusreporter/bridgestatus/events"
 )
 
-// Service polls Bridge status and pushes them to Beholder
+// Service polls Bridge status and pushes them to Beholder. It also probes the
+// health check URL of bridges which have one, and reports the results to the
+// bridges.HealthMonitor.
 type Service struct {
 	services.Service
 	eng *services.Engine
 
 	config     config.BridgeStatusReporter
 	bridgeORM  bridges.ORM
+	health     *bridges.HealthMonitor
 	jobORM     job.ORM
 	httpClient *http.Client
 	emitter    beholder.Emitter
@@ -34,12 +37,16 @@ type Service struct {
 const (
 	ServiceName        = "BridgeStatusReporter"
 	bridgePollPageSize = 1_000
+	// maxResponseBytes limits the status and health check responses of bridges
+	// which sign their responses, as those are read in full to be verified.
+	maxResponseBytes = 1 << 20
 )
 
 // NewBridgeStatusReporter creates a new Bridge Status Reporter Service
 func NewBridgeStatusReporter(
 	config config.BridgeStatusReporter,
 	bridgeORM bridges.ORM,
+	health *bridges.HealthMonitor,
 	jobORM job.ORM,
 	httpClient *http.Client,
 	emitter beholder.Emitter,
@@ -48,6 +55,7 @@ func NewBridgeStatusReporter(
 	s := &Service{
 		config:     config,
 		bridgeORM:  bridgeORM,
+		health:     health,
 		jobORM:     jobORM,
 		httpClient: httpClient,
 		emitter:    emitter,
@@ -61,6 +69,10 @@ func NewBridgeStatusReporter(
 
 // start starts the Bridge Status Reporter Service
 func (s *Service) start(ctx context.Context) error {
+	if interval := s.config.HealthCheckInterval(); interval > 0 && s.health != nil {
+		s.eng.GoTick(services.NewTicker(interval), s.checkAllBridges)
+	}
+
 	if !s.config.Enabled() {
 		s.eng.Info("Bridge Status Reporter Service is disabled")
 		return nil
@@ -75,13 +87,20 @@ func (s *Service) start(ctx context.Context) error {
 	return nil
 }
 
-// HealthReport returns the service health
+// HealthReport returns the service health, along with the health of every
+// bridge known to the bridges.HealthMonitor.
 func (s *Service) HealthReport() map[string]error {
-	return map[string]error{ServiceName: s.Ready()}
+	report := map[string]error{ServiceName: s.Ready()}
+	if s.health != nil {
+		for name, h := range s.health.Bridges() {
+			report[ServiceName+"."+name.String()] = h.Err()
+		}
+	}
+	return report
 }
 
-// pollAllBridges polls all registered bridges using pagination
-func (s *Service) pollAllBridges(ctx context.Context) {
+// listBridges returns all registered bridges using pagination
+func (s *Service) listBridges(ctx context.Context) ([]bridges.BridgeType, error) {
 	var allBridges []bridges.BridgeType
 	var offset = 0
 
@@ -89,8 +108,7 @@ func (s *Service) pollAllBridges(ctx context.Context) {
 	for {
 		bridgeList, _, err := s.bridgeORM.BridgeTypes(ctx, offset, bridgePollPageSize)
 		if err != nil {
-			s.eng.Debugw("Failed to fetch bridges", "error", err, "offset", offset)
-			return
+			return nil, fmt.Errorf("failed to fetch bridges at offset %d: %w", offset, err)
 		}
 
 		allBridges = append(allBridges, bridgeList...)
@@ -102,6 +120,16 @@ func (s *Service) pollAllBridges(ctx context.Context) {
 
 		offset += bridgePollPageSize
 	}
+	return allBridges, nil
+}
+
+// pollAllBridges polls all registered bridges
+func (s *Service) pollAllBridges(ctx context.Context) {
+	allBridges, err := s.listBridges(ctx)
+	if err != nil {
+		s.eng.Debugw("Failed to fetch bridges", "error", err)
+		return
+	}
 
 	if len(allBridges) == 0 {
 		s.eng.Debug("No bridges configured for Bridge Status Reporter polling")
@@ -114,17 +142,80 @@ func (s *Service) pollAllBridges(ctx context.Context) {
 	var wg sync.WaitGroup
 	for _, bridge := range allBridges {
 		wg.Add(1)
-		bridgeName := string(bridge.Name)
-		bridgeURL := bridge.URL.String()
-		go func(name, url string) {
+		go func(bridge bridges.BridgeType) {
 			defer wg.Done()
-			s.pollBridge(ctx, name, url)
-		}(bridgeName, bridgeURL)
+			s.pollBridge(ctx, bridge)
+		}(bridge)
 	}
 
 	wg.Wait()
 }
 
+// checkAllBridges probes the health check URL of every bridge which has one
+func (s *Service) checkAllBridges(ctx context.Context) {
+	allBridges, err := s.listBridges(ctx)
+	if err != nil {
+		s.eng.Debugw("Failed to fetch bridges for health checks", "error", err)
+		return
+	}
+
+	names := make([]bridges.BridgeName, len(allBridges))
+	var wg sync.WaitGroup
+	for i, bridge := range allBridges {
+		names[i] = bridge.Name
+		if bridge.HealthCheckURL == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(bridge bridges.BridgeType) {
+			defer wg.Done()
+			s.checkBridge(ctx, bridge)
+		}(bridge)
+	}
+	wg.Wait()
+
+	// Forget the health of deleted bridges
+	s.health.Retain(names)
+}
+
+// checkBridge probes the health check URL of a single bridge, which is healthy
+// if it responds with a 2xx status. The probe is sent with the security settings
+// of the bridge, like its requests.
+func (s *Service) checkBridge(ctx context.Context, bridge bridges.BridgeType) {
+	// A health check that takes longer than the interval between checks fails
+	checkCtx, cancel := context.WithTimeout(ctx, s.config.HealthCheckInterval())
+	defer cancel()
+
+	name, healthCheckURL := bridge.Name, bridge.HealthCheckURL.String()
+	err := func() error {
+		client, err := bridge.HTTPClient(s.httpClient, maxResponseBytes)
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(checkCtx, http.MethodGet, healthCheckURL, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("health check returned status %s", resp.Status)
+		}
+		return nil
+	}()
+	if ctx.Err() != nil {
+		// Shutting down
+		return
+	}
+	if err != nil {
+		s.eng.Warnw("Bridge health check failed", "bridge", name, "url", healthCheckURL, "error", err)
+	}
+	s.health.RecordCheck(name, err)
+}
+
 // handleBridgeError handles errors during bridge polling, either skipping or emitting empty telemetry
 func (s *Service) handleBridgeError(ctx context.Context, bridgeName string, jobs []JobInfo, logMsg string, logFields ...any) {
 	s.eng.Debugw(logMsg, logFields...)
@@ -135,8 +226,10 @@ func (s *Service) handleBridgeError(ctx context.Context, bridgeName string, jobs
 	s.emitBridgeStatus(ctx, bridgeName, EAResponse{}, jobs)
 }
 
-// pollBridge polls a single bridge's status endpoint
-func (s *Service) pollBridge(ctx context.Context, bridgeName string, bridgeURL string) {
+// pollBridge polls a single bridge's status endpoint, with the security
+// settings of the bridge
+func (s *Service) pollBridge(ctx context.Context, bridge bridges.BridgeType) {
+	bridgeName, bridgeURL := string(bridge.Name), bridge.URL.String()
 	s.eng.Debugw("Polling bridge", "bridge", bridgeName, "url", bridgeURL)
 
 	// Look up jobs associated with this bridge first
@@ -173,7 +266,12 @@ func (s *Service) pollBridge(ctx context.Context, bridgeName string, bridgeURL s
 		return
 	}
 
-	resp, err := s.httpClient.Do(req)
+	client, err := bridge.HTTPClient(s.httpClient, maxResponseBytes)
+	if err != nil {
+		s.handleBridgeError(ctx, bridgeName, jobs, "Failed to create client for Bridge Status Reporter status", "bridge", bridgeName, "error", err)
+		return
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		s.handleBridgeError(ctx, bridgeName, jobs, "Failed to fetch Bridge Status Reporter status", "bridge", bridgeName, "url", statusURL.String(), "error", err)
 		return
@@ -2,8 +2,16 @@ package bridgestatus
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -72,6 +80,16 @@ func parseWebURL(s string) models.WebURL {
 	return models.WebURL(*u)
 }
 
+// newTestBridge creates a bridge with the given URL, which is kept as a path if
+// it does not parse so that requests to it fail
+func newTestBridge(name, rawURL string) bridges.BridgeType {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		u = &url.URL{Path: rawURL}
+	}
+	return bridges.BridgeType{Name: bridges.BridgeName(name), URL: models.WebURL(*u)}
+}
+
 // Test fixtures
 var (
 	testBridge1 = bridges.BridgeType{
@@ -99,7 +117,7 @@ func setupTestService(t *testing.T, enabled bool, pollingInterval time.Duration,
 	// Reduce log noise
 	lggr.SetLogLevel(zapcore.ErrorLevel)
 
-	service := NewBridgeStatusReporter(bridgeStatusConfig, bridgeORM, jobORM, httpClient, emitter, lggr)
+	service := NewBridgeStatusReporter(bridgeStatusConfig, bridgeORM, nil, jobORM, httpClient, emitter, lggr)
 
 	return service, bridgeORM, jobORM, emitter
 }
@@ -118,7 +136,7 @@ func setupTestServiceWithIgnoreFlags(t *testing.T, enabled bool, pollingInterval
 	// Reduce log noise
 	lggr.SetLogLevel(zapcore.ErrorLevel)
 
-	service := NewBridgeStatusReporter(bridgeStatusConfig, bridgeORM, jobORM, httpClient, emitter, lggr)
+	service := NewBridgeStatusReporter(bridgeStatusConfig, bridgeORM, nil, jobORM, httpClient, emitter, lggr)
 
 	return service, bridgeORM, jobORM, emitter
 }
@@ -229,7 +247,7 @@ func TestService_pollBridge_Success(t *testing.T) {
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "test-bridge", "http://example.com")
+	service.pollBridge(ctx, newTestBridge("test-bridge", "http://example.com"))
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -246,7 +264,7 @@ func TestService_pollBridge_HTTPError(t *testing.T) {
 
 	// Should handle HTTP error gracefully
 	assert.NotPanics(t, func() {
-		service.pollBridge(ctx, "test-bridge", "http://invalid.invalid:8080")
+		service.pollBridge(ctx, newTestBridge("test-bridge", "http://invalid.invalid:8080"))
 	})
 
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -264,7 +282,7 @@ func TestService_pollBridge_InvalidJSON(t *testing.T) {
 	ctx := context.Background()
 
 	assert.NotPanics(t, func() {
-		service.pollBridge(ctx, "test-bridge", "http://invalid.invalid:8080")
+		service.pollBridge(ctx, newTestBridge("test-bridge", "http://invalid.invalid:8080"))
 	})
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
 	emitter.AssertNotCalled(t, "With", mock.Anything)
@@ -282,7 +300,7 @@ func TestService_pollBridge_InvalidURL(t *testing.T) {
 	ctx := context.Background()
 
 	assert.NotPanics(t, func() {
-		service.pollBridge(ctx, "test-bridge", "://invalid-url")
+		service.pollBridge(ctx, newTestBridge("test-bridge", "://invalid-url"))
 	})
 
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -301,7 +319,7 @@ func TestService_pollBridge_EmptyURL(t *testing.T) {
 	ctx := context.Background()
 
 	assert.NotPanics(t, func() {
-		service.pollBridge(ctx, "test-bridge", "")
+		service.pollBridge(ctx, newTestBridge("test-bridge", ""))
 	})
 
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -326,7 +344,7 @@ func TestService_pollBridge_URLPathPreservation(t *testing.T) {
 	ctx := context.Background()
 
 	// If URL path joining is broken, this will fail with "unexpected URL" error
-	service.pollBridge(ctx, "test-bridge", "http://localhost:8080/bridge/v1")
+	service.pollBridge(ctx, newTestBridge("test-bridge", "http://localhost:8080/bridge/v1"))
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -342,7 +360,7 @@ func TestService_pollBridge_Non200Status(t *testing.T) {
 	ctx := context.Background()
 
 	assert.NotPanics(t, func() {
-		service.pollBridge(ctx, "test-bridge", "http://example.com")
+		service.pollBridge(ctx, newTestBridge("test-bridge", "http://example.com"))
 	})
 
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -431,6 +449,7 @@ func TestService_emitBridgeStatus_CaptureOutput(t *testing.T) {
 	service := NewBridgeStatusReporter(
 		config,
 		nil, // bridgeORM not needed for this test
+		nil, // health not needed for this test
 		nil, // jobORM not needed for this test
 		nil, // httpClient not needed for this test
 		emitter,
@@ -637,6 +656,7 @@ func TestService_emitBridgeStatus_EmptyFields(t *testing.T) {
 	service := NewBridgeStatusReporter(
 		config,
 		nil, // bridgeORM not needed for this test
+		nil, // health not needed for this test
 		nil, // jobORM not needed for this test
 		nil, // httpClient not needed for this test
 		emitter,
@@ -710,7 +730,7 @@ func TestService_pollBridge_WithJobInfo(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "test-bridge", "http://example.com")
+	service.pollBridge(ctx, newTestBridge("test-bridge", "http://example.com"))
 
 	// Verify the job information (IDs and names) were included in the protobuf
 	require.NotEmpty(t, capturedProtobufBytes)
@@ -740,7 +760,7 @@ func TestService_pollBridge_JobORMError(t *testing.T) {
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "test-bridge", "http://example.com")
+	service.pollBridge(ctx, newTestBridge("test-bridge", "http://example.com"))
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -759,7 +779,7 @@ func TestService_pollBridge_IgnoreJoblessBridges_Enabled(t *testing.T) {
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "jobless-bridge", "http://example.com")
+	service.pollBridge(ctx, newTestBridge("jobless-bridge", "http://example.com"))
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -777,7 +797,7 @@ func TestService_pollBridge_IgnoreJoblessBridges_Disabled(t *testing.T) {
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "jobless-bridge", "http://example.com")
+	service.pollBridge(ctx, newTestBridge("jobless-bridge", "http://example.com"))
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -796,7 +816,7 @@ func TestService_pollBridge_IgnoreInvalidBridges_HTTPError_Enabled(t *testing.T)
 	jobORM.On("FindJob", mock.Anything, int32(1)).Return(testJob, nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "invalid-bridge", "http://invalid.invalid:8080")
+	service.pollBridge(ctx, newTestBridge("invalid-bridge", "http://invalid.invalid:8080"))
 
 	// Should NOT emit telemetry for invalid bridge when ignoreInvalidBridges is true
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -821,7 +841,7 @@ func TestService_pollBridge_IgnoreInvalidBridges_HTTPError_Disabled(t *testing.T
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "invalid-bridge", "http://invalid.invalid:8080") // This will fail with HTTP error
+	service.pollBridge(ctx, newTestBridge("invalid-bridge", "http://invalid.invalid:8080")) // This will fail with HTTP error
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -840,7 +860,7 @@ func TestService_pollBridge_IgnoreInvalidBridges_Non200Status_Enabled(t *testing
 	jobORM.On("FindJob", mock.Anything, int32(1)).Return(testJob, nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "invalid-bridge", "http://example.com")
+	service.pollBridge(ctx, newTestBridge("invalid-bridge", "http://example.com"))
 
 	// Should NOT emit telemetry for invalid bridge when ignoreInvalidBridges is true
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -865,7 +885,7 @@ func TestService_pollBridge_IgnoreInvalidBridges_Non200Status_Disabled(t *testin
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "invalid-bridge", "http://example.com")
+	service.pollBridge(ctx, newTestBridge("invalid-bridge", "http://example.com"))
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -884,7 +904,7 @@ func TestService_pollBridge_IgnoreInvalidBridges_InvalidJSON_Enabled(t *testing.
 	jobORM.On("FindJob", mock.Anything, int32(1)).Return(testJob, nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "invalid-bridge", "http://example.com")
+	service.pollBridge(ctx, newTestBridge("invalid-bridge", "http://example.com"))
 
 	// Should NOT emit telemetry for invalid bridge when ignoreInvalidBridges is true
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -909,7 +929,7 @@ func TestService_pollBridge_IgnoreInvalidBridges_InvalidJSON_Disabled(t *testing
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "invalid-bridge", "http://example.com")
+	service.pollBridge(ctx, newTestBridge("invalid-bridge", "http://example.com"))
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -924,7 +944,7 @@ func TestService_pollBridge_BothIgnoreFlags_Enabled(t *testing.T) {
 	jobORM.On("FindJobIDsWithBridge", mock.Anything, "jobless-invalid-bridge").Return([]int32{}, nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "jobless-invalid-bridge", "http://invalid.invalid:8080") // This would fail with HTTP error too
+	service.pollBridge(ctx, newTestBridge("jobless-invalid-bridge", "http://invalid.invalid:8080")) // This would fail with HTTP error too
 
 	// Should NOT emit telemetry - skipped because of no jobs (ignoreJoblessBridges)
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -945,7 +965,7 @@ func TestService_pollBridge_BothIgnoreFlags_Disabled(t *testing.T) {
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "jobless-invalid-bridge", "http://invalid.invalid:8080") // This will fail with HTTP error
+	service.pollBridge(ctx, newTestBridge("jobless-invalid-bridge", "http://invalid.invalid:8080")) // This will fail with HTTP error
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -980,7 +1000,7 @@ func TestService_pollBridge_EndToEnd_RealWebServer(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "test-bridge", server.URL)
+	service.pollBridge(ctx, newTestBridge("test-bridge", server.URL))
 
 	// Verify the complete end-to-end flow worked
 	require.NotEmpty(t, capturedProtobufBytes, "Should have emitted protobuf data")
@@ -1050,3 +1070,100 @@ func TestService_pollBridge_EndToEnd_RealWebServer(t *testing.T) {
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
 }
+
+func TestService_checkAllBridges(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/health", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthy.Close()
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+
+	healthyURL := parseWebURL(healthy.URL + "/health")
+	unhealthyURL := parseWebURL(unhealthy.URL)
+	allBridges := []bridges.BridgeType{
+		{Name: "healthy", URL: parseWebURL(testBridgeURL1), HealthCheckURL: &healthyURL},
+		{Name: "unhealthy", URL: parseWebURL(testBridgeURL2), HealthCheckURL: &unhealthyURL},
+		{Name: "unchecked", URL: parseWebURL(testBridgeURL2)},
+	}
+
+	config := mocks.NewTestBridgeStatusReporterConfig(false, testStatusPath, testPollingInterval).
+		WithHealthChecks(time.Second, 0, time.Minute)
+	bridgeORM := bridgeMocks.NewORM(t)
+	bridgeORM.On("BridgeTypes", mock.Anything, 0, 1000).Return(allBridges, len(allBridges), nil)
+	health := bridges.NewHealthMonitor(0, time.Minute)
+	health.RecordCheck("deleted", nil)
+
+	service := NewBridgeStatusReporter(config, bridgeORM, health, nil, &http.Client{}, mocks.NewBeholderEmitter(), logger.TestLogger(t))
+	service.checkAllBridges(context.Background())
+
+	assert.Equal(t, bridges.HealthStatusHealthy, health.Health("healthy").Status)
+	unhealthyHealth := health.Health("unhealthy")
+	assert.Equal(t, bridges.HealthStatusUnhealthy, unhealthyHealth.Status)
+	assert.Equal(t, bridges.CircuitOpen, unhealthyHealth.Circuit)
+	assert.Contains(t, unhealthyHealth.LastError, "503")
+	assert.Len(t, health.Bridges(), 2, "unchecked and deleted bridges are not tracked")
+
+	report := service.HealthReport()
+	require.NoError(t, report[ServiceName+".healthy"])
+	require.ErrorContains(t, report[ServiceName+".unhealthy"], "health check failed")
+}
+
+func TestService_checkAllBridges_MutualTLS(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "chainlink-node"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	clientCAs.AddCert(clientCert)
+
+	// the adapter only accepts connections with the node's client certificate
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12}
+	srv.StartTLS()
+	defer srv.Close()
+
+	healthCheckURL := parseWebURL(srv.URL + "/health")
+	caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	allBridges := []bridges.BridgeType{
+		{Name: "mtls", URL: parseWebURL(srv.URL), HealthCheckURL: &healthCheckURL, BridgeSecurity: bridges.BridgeSecurity{
+			ClientCertificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+			ClientKey:         string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+			CABundle:          caBundle,
+		}},
+		{Name: "nocert", URL: parseWebURL(srv.URL), HealthCheckURL: &healthCheckURL, BridgeSecurity: bridges.BridgeSecurity{
+			CABundle: caBundle,
+		}},
+	}
+
+	config := mocks.NewTestBridgeStatusReporterConfig(false, testStatusPath, testPollingInterval).
+		WithHealthChecks(time.Second, 0, time.Minute)
+	bridgeORM := bridgeMocks.NewORM(t)
+	bridgeORM.On("BridgeTypes", mock.Anything, 0, 1000).Return(allBridges, len(allBridges), nil)
+	health := bridges.NewHealthMonitor(0, time.Minute)
+
+	service := NewBridgeStatusReporter(config, bridgeORM, health, nil, &http.Client{}, mocks.NewBeholderEmitter(), logger.TestLogger(t))
+	service.checkAllBridges(context.Background())
+
+	assert.Equal(t, bridges.HealthStatusHealthy, health.Health("mtls").Status, "the probe presents the bridge's client certificate")
+	assert.Equal(t, bridges.HealthStatusUnhealthy, health.Health("nocert").Status)
+}
@@ -11,6 +11,10 @@ type TestBridgeStatusReporterConfig struct {
 	pollingInterval      time.Duration
 	ignoreInvalidBridges bool
 	ignoreJoblessBridges bool
+
+	healthCheckInterval     time.Duration
+	circuitBreakerThreshold uint32
+	circuitBreakerCooldown  time.Duration
 }
 
 func NewTestBridgeStatusReporterConfig(enabled bool, statusPath string, pollingInterval time.Duration) *TestBridgeStatusReporterConfig {
@@ -33,6 +37,14 @@ func NewTestBridgeStatusReporterConfigWithSkip(enabled bool, statusPath string,
 	}
 }
 
+// WithHealthChecks sets the health check and circuit breaker settings, which are disabled by default.
+func (e *TestBridgeStatusReporterConfig) WithHealthChecks(interval time.Duration, threshold uint32, cooldown time.Duration) *TestBridgeStatusReporterConfig {
+	e.healthCheckInterval = interval
+	e.circuitBreakerThreshold = threshold
+	e.circuitBreakerCooldown = cooldown
+	return e
+}
+
 func (e *TestBridgeStatusReporterConfig) Enabled() bool {
 	return e.enabled
 }
@@ -52,3 +64,15 @@ func (e *TestBridgeStatusReporterConfig) IgnoreInvalidBridges() bool {
 func (e *TestBridgeStatusReporterConfig) IgnoreJoblessBridges() bool {
 	return e.ignoreJoblessBridges
 }
+
+func (e *TestBridgeStatusReporterConfig) HealthCheckInterval() time.Duration {
+	return e.healthCheckInterval
+}
+
+func (e *TestBridgeStatusReporterConfig) CircuitBreakerThreshold() uint32 {
+	return e.circuitBreakerThreshold
+}
+
+func (e *TestBridgeStatusReporterConfig) CircuitBreakerCooldown() time.Duration {
+	return e.circuitBreakerCooldown
+}
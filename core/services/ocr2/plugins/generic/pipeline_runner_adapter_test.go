@@ -54,6 +54,7 @@ func TestAdapter_Integration(t *testing.T) {
 		cfg.JobPipeline(),
 		cfg.WebServer(),
 		nil,
+		nil,
 		keystore.Eth(),
 		keystore.VRF(),
 		logger,
@@ -0,0 +1,155 @@
+package pipeline
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+// CacheEntry is a result stored by a cache task.
+type CacheEntry struct {
+	Value    any
+	StoredAt time.Time
+}
+
+// CacheStore keeps the results of cache tasks until they expire. Cache tasks
+// prefix their keys with the job ID, so the entries of a job are its own.
+type CacheStore interface {
+	// Get returns the entry stored under key, or false if there is no entry or it has expired.
+	Get(ctx context.Context, key string) (CacheEntry, bool, error)
+	Put(ctx context.Context, key string, entry CacheEntry, expiresAt time.Time) error
+	DeleteExpired(ctx context.Context) error
+}
+
+const (
+	CacheStoreMemory = "memory"
+	CacheStoreDB     = "db"
+)
+
+// maxInMemoryCacheEntries bounds the entries of an in-memory cache store, so
+// that it cannot grow without bound even if the run reaper is disabled.
+const maxInMemoryCacheEntries = 10_000
+
+type inMemoryCacheEntry struct {
+	CacheEntry
+	expiresAt time.Time
+}
+
+type inMemoryCacheStore struct {
+	mu         sync.Mutex
+	entries    map[string]inMemoryCacheEntry
+	maxEntries int
+}
+
+var _ CacheStore = (*inMemoryCacheStore)(nil)
+
+// NewInMemoryCacheStore returns a CacheStore local to this node, which does not
+// survive restarts. Expired entries are evicted when they are read, and when
+// the store is full; a full store then evicts the entry which expires first.
+func NewInMemoryCacheStore() CacheStore {
+	return newInMemoryCacheStore(maxInMemoryCacheEntries)
+}
+
+func newInMemoryCacheStore(maxEntries int) *inMemoryCacheStore {
+	return &inMemoryCacheStore{entries: make(map[string]inMemoryCacheEntry), maxEntries: maxEntries}
+}
+
+func (s *inMemoryCacheStore) Get(_ context.Context, key string) (CacheEntry, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.entries[key]
+	if !ok {
+		return CacheEntry{}, false, nil
+	}
+	if !time.Now().Before(entry.expiresAt) {
+		delete(s.entries, key)
+		return CacheEntry{}, false, nil
+	}
+	return entry.CacheEntry, true, nil
+}
+
+func (s *inMemoryCacheStore) Put(_ context.Context, key string, entry CacheEntry, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries[key]; !ok && len(s.entries) >= s.maxEntries {
+		s.deleteExpired(time.Now())
+		if len(s.entries) >= s.maxEntries {
+			s.evictFirstExpiring()
+		}
+	}
+	s.entries[key] = inMemoryCacheEntry{CacheEntry: entry, expiresAt: expiresAt}
+	return nil
+}
+
+func (s *inMemoryCacheStore) DeleteExpired(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleteExpired(time.Now())
+	return nil
+}
+
+func (s *inMemoryCacheStore) deleteExpired(now time.Time) {
+	for key, entry := range s.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+func (s *inMemoryCacheStore) evictFirstExpiring() {
+	var (
+		first     string
+		expiresAt time.Time
+	)
+	for key, entry := range s.entries {
+		if first == "" || entry.expiresAt.Before(expiresAt) {
+			first, expiresAt = key, entry.expiresAt
+		}
+	}
+	delete(s.entries, first)
+}
+
+type dbCacheStore struct {
+	ds sqlutil.DataSource
+}
+
+var _ CacheStore = (*dbCacheStore)(nil)
+
+// NewDBCacheStore returns a CacheStore backed by the pipeline_cache_entries
+// table, which survives restarts.
+func NewDBCacheStore(ds sqlutil.DataSource) CacheStore {
+	return &dbCacheStore{ds: ds}
+}
+
+func (s *dbCacheStore) Get(ctx context.Context, key string) (CacheEntry, bool, error) {
+	var row struct {
+		Value    jsonserializable.JSONSerializable
+		StoredAt time.Time
+	}
+	err := s.ds.GetContext(ctx, &row, `SELECT value, stored_at FROM pipeline_cache_entries WHERE key = $1 AND expires_at > NOW()`, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return CacheEntry{}, false, nil
+	} else if err != nil {
+		return CacheEntry{}, false, errors.Wrapf(err, "failed to fetch cache entry %s", key)
+	}
+	return CacheEntry{Value: row.Value.Val, StoredAt: row.StoredAt}, true, nil
+}
+
+func (s *dbCacheStore) Put(ctx context.Context, key string, entry CacheEntry, expiresAt time.Time) error {
+	value := jsonserializable.JSONSerializable{Val: entry.Value, Valid: true}
+	_, err := s.ds.ExecContext(ctx, `INSERT INTO pipeline_cache_entries (key, value, stored_at, expires_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, stored_at = EXCLUDED.stored_at, expires_at = EXCLUDED.expires_at`,
+		key, value, entry.StoredAt, expiresAt)
+	return errors.Wrapf(err, "failed to store cache entry %s", key)
+}
+
+func (s *dbCacheStore) DeleteExpired(ctx context.Context) error {
+	_, err := s.ds.ExecContext(ctx, `DELETE FROM pipeline_cache_entries WHERE expires_at <= NOW()`)
+	return errors.Wrap(err, "failed to delete expired cache entries")
+}
@@ -210,6 +210,8 @@ type TaskRunResult struct {
 	Attempts   uint
 	CreatedAt  time.Time
 	FinishedAt null.Time
+	// AttemptHistory is only kept for tasks configured with retries
+	AttemptHistory TaskRunAttempts
 	// runInfo is never persisted
 	runInfo RunInfo
 }
@@ -314,6 +316,7 @@ const (
 	TaskTypeBase64Encode     TaskType = "base64encode"
 	TaskTypeBridge           TaskType = "bridge"
 	TaskTypeCBORParse        TaskType = "cborparse"
+	TaskTypeCache            TaskType = "cache"
 	TaskTypeCoalesce         TaskType = "coalesce"
 	TaskTypeConditional      TaskType = "conditional"
 	TaskTypeDivide           TaskType = "divide"
@@ -328,6 +331,7 @@ const (
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
 	TaskTypeJSONParse        TaskType = "jsonparse"
+	TaskTypeJSONQuery        TaskType = "jsonquery"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
@@ -339,11 +343,13 @@ const (
 	TaskTypeMin              TaskType = "min"
 	TaskTypeMode             TaskType = "mode"
 	TaskTypeMultiply         TaskType = "multiply"
+	TaskTypeOutlierFilter    TaskType = "outlierfilter"
 	TaskTypeSum              TaskType = "sum"
 	TaskTypeUppercase        TaskType = "uppercase"
 	TaskTypeVRF              TaskType = "vrf"
 	TaskTypeVRFV2            TaskType = "vrfv2"
 	TaskTypeVRFV2Plus        TaskType = "vrfv2plus"
+	TaskTypeWeightedMedian   TaskType = "weightedmedian"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -384,6 +390,10 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap any, ID int, dotID string)
 		task = &MeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMedian:
 		task = &MedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeWeightedMedian:
+		task = &WeightedMedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeOutlierFilter:
+		task = &OutlierFilterTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMin:
 		task = &MinTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMode:
@@ -394,6 +404,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap any, ID int, dotID string)
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJSONQuery:
+		task = &JSONQueryTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
@@ -448,6 +460,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap any, ID int, dotID string)
 		task = &Base64EncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeCoalesce:
 		task = &CoalesceTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeCache:
+		task = &CacheTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, pkgerrors.Errorf(`unknown task type: "%v"`, taskType)
 	}
@@ -93,6 +93,20 @@ func TestRetryUnmarshal(t *testing.T) {
 			time.Second * 5,
 			time.Minute,
 		},
+		{
+			"only min backoff specified",
+			`ds1 [type=any retries=5 minBackoff="1s"];`,
+			5,
+			time.Second,
+			time.Minute,
+		},
+		{
+			"only max backoff specified",
+			`ds1 [type=any retries=5 maxBackoff="10s"];`,
+			5,
+			time.Second * 5,
+			time.Second * 10,
+		},
 		{
 			"all params set",
 			`ds1 [type=http retries=10 minBackoff="1s" maxBackoff="30m"];`,
@@ -152,6 +166,10 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeMultiply, &pipeline.MultiplyTask{}},
 		{pipeline.TaskTypeDivide, &pipeline.DivideTask{}},
 		{pipeline.TaskTypeJSONParse, &pipeline.JSONParseTask{}},
+		{pipeline.TaskTypeJSONQuery, &pipeline.JSONQueryTask{}},
+		{pipeline.TaskTypeWeightedMedian, &pipeline.WeightedMedianTask{}},
+		{pipeline.TaskTypeOutlierFilter, &pipeline.OutlierFilterTask{}},
+		{pipeline.TaskTypeCache, &pipeline.CacheTask{}},
 		{pipeline.TaskTypeCBORParse, &pipeline.CBORParseTask{}},
 		{pipeline.TaskTypeAny, &pipeline.AnyTask{}},
 		{pipeline.TaskTypeVRF, &pipeline.VRFTask{}},
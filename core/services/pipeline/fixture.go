@@ -0,0 +1,265 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// RunFixture captures a finished run together with the responses of its
+// bridge, http and ethtx tasks, so that the run can later be re-executed
+// offline and the results compared. The HTTP responses of bridge and http
+// tasks are replayed through their transport, so those tasks run in full;
+// tasks without recorded HTTP responses are replaced by their stubbed result.
+type RunFixture struct {
+	RunID           int64             `json:"runID"`
+	JobID           int32             `json:"jobID"`
+	JobName         string            `json:"jobName"`
+	JobType         string            `json:"jobType"`
+	DotDagSource    string            `json:"dotDagSource"`
+	MaxTaskDuration time.Duration     `json:"maxTaskDuration"`
+	Inputs          map[string]any    `json:"inputs"`
+	Responses       TaskStubs         `json:"responses"`
+	HTTPResponses   RecordedResponses `json:"httpResponses,omitempty"`
+	TaskRuns        []FixtureTaskRun  `json:"taskRuns"`
+}
+
+// FixtureTaskRun is the recorded outcome of a single task.
+type FixtureTaskRun struct {
+	DotID  string          `json:"dotID"`
+	Type   TaskType        `json:"type"`
+	Output json.RawMessage `json:"output,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// TaskRunDiff describes a task whose replayed outcome differs from the
+// recorded one.
+type TaskRunDiff struct {
+	DotID          string `json:"dotID"`
+	ExpectedOutput string `json:"expectedOutput"`
+	ActualOutput   string `json:"actualOutput"`
+	ExpectedError  string `json:"expectedError"`
+	ActualError    string `json:"actualError"`
+}
+
+// NewRunFixture records the run's persisted task results. The run must have
+// been loaded with its task runs, which are only stored for every task when
+// the job saves successful task runs or the run errored. Only the body of the
+// responses of bridge and http tasks is persisted, so successful ones are
+// recorded as a 200 response with that body.
+func NewRunFixture(run Run) (RunFixture, error) {
+	if len(run.PipelineTaskRuns) == 0 {
+		return RunFixture{}, errors.Errorf("run %d has no persisted task runs to record", run.ID)
+	}
+	if !run.FinishedAt.Valid {
+		return RunFixture{}, errors.Errorf("run %d has not finished yet", run.ID)
+	}
+
+	f := RunFixture{
+		RunID:           run.ID,
+		JobID:           run.PipelineSpec.JobID,
+		JobName:         run.PipelineSpec.JobName,
+		JobType:         run.PipelineSpec.JobType,
+		DotDagSource:    run.PipelineSpec.DotDagSource,
+		MaxTaskDuration: run.PipelineSpec.MaxTaskDuration.Duration(),
+		Responses:       make(TaskStubs),
+		HTTPResponses:   make(RecordedResponses),
+	}
+	if inputs, ok := run.Inputs.Val.(map[string]any); ok && run.Inputs.Valid {
+		f.Inputs = inputs
+	}
+
+	for _, tr := range run.PipelineTaskRuns {
+		recorded := FixtureTaskRun{DotID: tr.DotID, Type: tr.Type, Error: tr.Error.ValueOrZero()}
+		if tr.Output.Valid {
+			output, err := tr.Output.MarshalJSON()
+			if err != nil {
+				return RunFixture{}, errors.Wrapf(err, "failed to encode output of task %s", tr.DotID)
+			}
+			recorded.Output = output
+		}
+		f.TaskRuns = append(f.TaskRuns, recorded)
+
+		if body, ok := tr.Output.Val.(string); ok && isTransportTaskType(tr.Type) && tr.Output.Valid && !tr.Error.Valid {
+			f.HTTPResponses[tr.DotID] = []RecordedResponse{{StatusCode: http.StatusOK, Body: body}}
+		} else if IsSimulatedTaskType(tr.Type) {
+			f.Responses[tr.DotID] = TaskStub{Value: tr.Output.Val, Error: tr.Error.ValueOrZero()}
+		}
+	}
+	return f, nil
+}
+
+// Spec returns the pipeline spec the recorded run was executed with.
+func (f RunFixture) Spec() Spec {
+	return Spec{
+		DotDagSource:    f.DotDagSource,
+		MaxTaskDuration: sqlutil.Interval(f.MaxTaskDuration),
+		JobID:           f.JobID,
+		JobName:         f.JobName,
+		JobType:         f.JobType,
+	}
+}
+
+// Diff compares replayed task results against the recorded ones. Outputs are
+// compared in their JSON encoding, the same form in which they are persisted.
+func (f RunFixture) Diff(trrs TaskRunResults) ([]TaskRunDiff, error) {
+	actual := make(map[string]TaskRunResult, len(trrs))
+	for _, trr := range trrs {
+		actual[trr.Task.DotID()] = trr
+	}
+
+	var diffs []TaskRunDiff
+	for _, recorded := range f.TaskRuns {
+		expectedOutput, err := normalizeJSON(recorded.Output)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid recorded output for task %s", recorded.DotID)
+		}
+		diff := TaskRunDiff{
+			DotID:          recorded.DotID,
+			ExpectedOutput: expectedOutput,
+			ExpectedError:  recorded.Error,
+		}
+		if trr, ok := actual[recorded.DotID]; ok {
+			diff.ActualError = trr.Result.ErrorDB().ValueOrZero()
+			if diff.ActualOutput, err = resultJSON(trr.Result); err != nil {
+				return nil, errors.Wrapf(err, "failed to encode output of task %s", recorded.DotID)
+			}
+		}
+		if diff.ExpectedOutput != diff.ActualOutput || diff.ExpectedError != diff.ActualError {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs, nil
+}
+
+func resultJSON(result Result) (string, error) {
+	output := result.OutputDB()
+	if !output.Valid {
+		return "", nil
+	}
+	b, err := output.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	return normalizeJSON(b)
+}
+
+// normalizeJSON re-encodes the value so that equal values compare equal
+// regardless of key order and number formatting.
+func normalizeJSON(b []byte) (string, error) {
+	if len(b) == 0 {
+		return "", nil
+	}
+	var js jsonserializable.JSONSerializable
+	if err := js.UnmarshalJSON(b); err != nil {
+		return "", err
+	}
+	if !js.Valid {
+		return "", nil
+	}
+	normalized, err := json.Marshal(js.Val)
+	return string(normalized), err
+}
+
+// RerecordRunLive re-executes a finished run in memory with its original
+// inputs, and returns a fixture of the re-execution rather than of the run.
+// The requests of its bridge and http tasks are sent to their live endpoints,
+// and both their responses and the results of every task are those of the
+// re-execution; only its ethtx and cache tasks are served their persisted
+// results. Use NewRunFixture to record what the run itself did.
+func RerecordRunLive(ctx context.Context, r Runner, run Run) (RunFixture, error) {
+	persisted, err := NewRunFixture(run)
+	if err != nil {
+		return RunFixture{}, err
+	}
+	spec := persisted.Spec()
+	p, err := r.InitializePipeline(spec)
+	if err != nil {
+		return RunFixture{}, err
+	}
+	p.stubTasks(persisted.Responses, func(task Task) bool {
+		return IsSimulatedTaskType(task.Type()) && !isTransportTaskType(task.Type())
+	})
+	spec.Pipeline = p
+
+	recorder := NewResponseRecorder()
+	rerun, _, err := r.ExecuteRun(WithTaskTransport(ctx, recorder), spec, NewVarsFrom(persisted.Inputs))
+	if err != nil {
+		return RunFixture{}, err
+	}
+	f, err := NewRunFixture(*rerun)
+	if err != nil {
+		return RunFixture{}, err
+	}
+	f.RunID = run.ID
+	f.Inputs = persisted.Inputs
+	f.HTTPResponses = recorder.Responses()
+	for dotID := range f.HTTPResponses {
+		delete(f.Responses, dotID)
+	}
+	return f, nil
+}
+
+// NewReplayRunner returns a runner which replays fixtures without a database
+// or a running node. Every bridge resolves to a placeholder URL, as the
+// requests of bridge tasks are answered with their recorded responses and
+// never sent.
+func NewReplayRunner(cfg Config, bridgeCfg BridgeConfig, lggr logger.Logger) Runner {
+	return NewRunner(nil, replayBridgeORM{}, cfg, bridgeCfg, nil, nil, nil, nil, lggr, nil, nil)
+}
+
+// replayBridgeORM finds a placeholder for every bridge. Its other methods are
+// never called by a replayed run.
+type replayBridgeORM struct {
+	bridges.ORM
+}
+
+func (replayBridgeORM) FindBridge(_ context.Context, name bridges.BridgeName) (bridges.BridgeType, error) {
+	return bridges.BridgeType{
+		Name: name,
+		URL:  models.WebURL(url.URL{Scheme: "http", Host: string(name) + ".invalid"}),
+	}, nil
+}
+
+// ReplayRun re-executes the recorded run in memory. Bridge and http tasks are
+// served their recorded HTTP responses, and every other side-effecting task
+// its stubbed result.
+func ReplayRun(ctx context.Context, r Runner, f RunFixture) (*Run, TaskRunResults, error) {
+	spec := f.Spec()
+	p, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = p.validateStubs(f.Responses); err != nil {
+		return nil, nil, err
+	}
+	for dotID := range f.HTTPResponses {
+		task := p.ByDotID(dotID)
+		if task == nil || !isTransportTaskType(task.Type()) {
+			return nil, nil, errors.Errorf("HTTP responses recorded for task %q, which is not a %s or %s task", dotID, TaskTypeBridge, TaskTypeHTTP)
+		}
+	}
+	p.stubTasks(f.Responses, func(task Task) bool {
+		_, recorded := f.HTTPResponses[task.DotID()]
+		return IsSimulatedTaskType(task.Type()) && !recorded
+	})
+	spec.Pipeline = p
+	return r.ExecuteRun(WithTaskTransport(ctx, NewResponseReplayer(f.HTTPResponses)), spec, NewVarsFrom(f.Inputs))
+}
+
+// isTransportTaskType returns true if tasks of the given type send their
+// requests through the TaskTransport of the run.
+func isTransportTaskType(taskType TaskType) bool {
+	return taskType == TaskTypeBridge || taskType == TaskTypeHTTP
+}
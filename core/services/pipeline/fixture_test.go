@@ -0,0 +1,182 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func newReplayRunner(t *testing.T) pipeline.Runner {
+	cfg := configtest.NewGeneralConfig(t, nil)
+	btORM := bridgesMocks.NewORM(t)
+	btORM.On("FindBridge", mock.Anything, bridges.BridgeName("price_adapter")).Return(bridges.BridgeType{
+		Name: "price_adapter",
+		URL:  cltest.WebURL(t, "https://adapter.example.com"),
+	}, nil).Maybe()
+	return pipeline.NewRunner(nil, btORM, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, nil, logger.TestLogger(t), nil, nil)
+}
+
+func TestRunFixture_Replay(t *testing.T) {
+	t.Parallel()
+
+	spec := pipeline.Spec{JobID: 1, JobName: "feed", DotDagSource: `
+ds1          [type=http method=GET url="https://chain.link/price"];
+ds1_parse    [type=jsonparse path="data,price"];
+ds2          [type=bridge name=price_adapter];
+ds2_parse    [type=jsonparse path="data,price"];
+answer       [type=median];
+
+ds1 -> ds1_parse -> answer;
+ds2 -> ds2_parse -> answer;
+`}
+	r := newReplayRunner(t)
+	recorded, _, err := pipeline.SimulateRun(testutils.Context(t), r, spec, pipeline.NewVarsFrom(map[string]any{"jobRun": map[string]any{"meta": nil}}), pipeline.TaskStubs{
+		"ds1": {Value: `{"data":{"price":100}}`},
+		"ds2": {Value: `{"data":{"price":200}}`},
+	})
+	require.NoError(t, err)
+
+	// fixtures are written to and read from disk
+	newFixture := func(t *testing.T) pipeline.RunFixture {
+		fixture, err := pipeline.NewRunFixture(*recorded)
+		require.NoError(t, err)
+		b, err := json.Marshal(fixture)
+		require.NoError(t, err)
+		fixture = pipeline.RunFixture{}
+		require.NoError(t, json.Unmarshal(b, &fixture))
+		return fixture
+	}
+
+	t.Run("replays recorded responses", func(t *testing.T) {
+		fixture := newFixture(t)
+		assert.Equal(t, int32(1), fixture.JobID)
+		assert.Len(t, fixture.TaskRuns, 5)
+		assert.Empty(t, fixture.Responses)
+		require.Len(t, fixture.HTTPResponses, 2)
+		assert.Equal(t, []pipeline.RecordedResponse{{StatusCode: http.StatusOK, Body: `{"data":{"price":100}}`}}, fixture.HTTPResponses["ds1"])
+
+		_, trrs, err := pipeline.ReplayRun(testutils.Context(t), r, fixture)
+		require.NoError(t, err)
+		diffs, err := fixture.Diff(trrs)
+		require.NoError(t, err)
+		assert.Empty(t, diffs)
+	})
+
+	t.Run("reports diverging tasks", func(t *testing.T) {
+		fixture := newFixture(t)
+		fixture.HTTPResponses["ds2"] = []pipeline.RecordedResponse{{StatusCode: http.StatusInternalServerError, Body: `{"error":"adapter down"}`}}
+
+		_, trrs, err := pipeline.ReplayRun(testutils.Context(t), r, fixture)
+		require.NoError(t, err)
+		diffs, err := fixture.Diff(trrs)
+		require.NoError(t, err)
+		require.Len(t, diffs, 3)
+
+		byDotID := make(map[string]pipeline.TaskRunDiff)
+		for _, diff := range diffs {
+			byDotID[diff.DotID] = diff
+		}
+		assert.Contains(t, byDotID["ds2"].ActualError, "adapter down")
+		assert.NotEmpty(t, byDotID["ds2_parse"].ActualError)
+		assert.Equal(t, byDotID["answer"].ExpectedOutput, `"150"`)
+		assert.Equal(t, byDotID["answer"].ActualOutput, `"100"`)
+	})
+
+	t.Run("replays without a database", func(t *testing.T) {
+		fixture := newFixture(t)
+		cfg := configtest.NewGeneralConfig(t, nil)
+		offline := pipeline.NewReplayRunner(cfg.JobPipeline(), cfg.WebServer(), logger.TestLogger(t))
+
+		_, trrs, err := pipeline.ReplayRun(testutils.Context(t), offline, fixture)
+		require.NoError(t, err)
+		diffs, err := fixture.Diff(trrs)
+		require.NoError(t, err)
+		assert.Empty(t, diffs)
+	})
+
+	t.Run("serves stubs to tasks without recorded responses", func(t *testing.T) {
+		fixture := newFixture(t)
+		delete(fixture.HTTPResponses, "ds2")
+		fixture.Responses["ds2"] = pipeline.TaskStub{Value: `{"data":{"price":200}}`}
+
+		_, trrs, err := pipeline.ReplayRun(testutils.Context(t), r, fixture)
+		require.NoError(t, err)
+		diffs, err := fixture.Diff(trrs)
+		require.NoError(t, err)
+		assert.Empty(t, diffs)
+	})
+
+	t.Run("rejects responses recorded for other tasks", func(t *testing.T) {
+		fixture := newFixture(t)
+		fixture.HTTPResponses["answer"] = []pipeline.RecordedResponse{{StatusCode: http.StatusOK}}
+
+		_, _, err := pipeline.ReplayRun(testutils.Context(t), r, fixture)
+		require.ErrorContains(t, err, `HTTP responses recorded for task "answer"`)
+	})
+
+	t.Run("requires persisted task runs", func(t *testing.T) {
+		_, err := pipeline.NewRunFixture(pipeline.Run{ID: 42})
+		require.EqualError(t, err, "run 42 has no persisted task runs to record")
+	})
+}
+
+func TestRerecordRunLive(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Price-Source", "test")
+		_, _ = w.Write([]byte(`{"data":{"price":300}}`))
+	}))
+	defer server.Close()
+
+	spec := pipeline.Spec{JobID: 1, DotDagSource: fmt.Sprintf(`
+ds1          [type=http method=GET url="%s"];
+ds1_parse    [type=jsonparse path="data,price"];
+submit       [type=ethtx to="0x0000000000000000000000000000000000000001" data="$(ds1_parse)"];
+
+ds1 -> ds1_parse -> submit;
+`, server.URL)}
+	r := newReplayRunner(t)
+	run, _, err := pipeline.SimulateRun(testutils.Context(t), r, spec, pipeline.NewVarsFrom(nil), pipeline.TaskStubs{
+		"ds1":    {Value: `{"data":{"price":100}}`},
+		"submit": {Value: "0xabc"},
+	})
+	require.NoError(t, err)
+	run.ID = 42
+
+	persisted, err := pipeline.NewRunFixture(*run)
+	require.NoError(t, err)
+	assert.Equal(t, []pipeline.RecordedResponse{{StatusCode: http.StatusOK, Body: `{"data":{"price":100}}`}}, persisted.HTTPResponses["ds1"])
+
+	fixture, err := pipeline.RerecordRunLive(testutils.Context(t), r, *run)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), fixture.RunID)
+	require.Len(t, fixture.HTTPResponses["ds1"], 1)
+	recorded := fixture.HTTPResponses["ds1"][0]
+	assert.Equal(t, http.StatusOK, recorded.StatusCode)
+	assert.Equal(t, "test", recorded.Header.Get("X-Price-Source"))
+	assert.JSONEq(t, `{"data":{"price":300}}`, recorded.Body)
+	assert.Equal(t, pipeline.TaskStubs{"submit": {Value: "0xabc"}}, fixture.Responses)
+
+	// the fixture holds the results of the re-execution
+	server.Close()
+	_, trrs, err := pipeline.ReplayRun(testutils.Context(t), r, fixture)
+	require.NoError(t, err)
+	diffs, err := fixture.Diff(trrs)
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+}
@@ -252,6 +252,11 @@ func Parse(text string) (*Pipeline, error) {
 		if err != nil {
 			return nil, err
 		}
+		if cacheTask, is := task.(*CacheTask); is {
+			if err = cacheTask.parseSubgraph(); err != nil {
+				return nil, err
+			}
+		}
 
 		if task.OutputIndex() > 0 {
 			_, exists := resultIdxs[task.OutputIndex()]
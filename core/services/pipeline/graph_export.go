@@ -0,0 +1,224 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gonum.org/v1/gonum/graph/topo"
+	"gopkg.in/guregu/null.v4"
+)
+
+// GraphFormat is a format a pipeline graph can be rendered in.
+type GraphFormat string
+
+const (
+	GraphFormatDOT     GraphFormat = "dot"
+	GraphFormatMermaid GraphFormat = "mermaid"
+	GraphFormatJSON    GraphFormat = "json"
+)
+
+// GraphExport is the DAG of a pipeline as the runner sees it, including the
+// implicit edges added for variable references between tasks.
+type GraphExport struct {
+	Nodes []GraphExportNode `json:"nodes"`
+	Edges []GraphExportEdge `json:"edges"`
+}
+
+// GraphExportNode is a task in the pipeline. Run is only set when the graph is
+// overlaid with a run that executed the task.
+type GraphExportNode struct {
+	ID         string              `json:"id"`
+	Type       TaskType            `json:"type"`
+	Attributes map[string]string   `json:"attributes"`
+	Run        *GraphExportTaskRun `json:"run,omitempty"`
+}
+
+// GraphExportTaskRun is the outcome of a task in a specific run.
+type GraphExportTaskRun struct {
+	CreatedAt  time.Time   `json:"createdAt"`
+	FinishedAt null.Time   `json:"finishedAt"`
+	Error      null.String `json:"error"`
+}
+
+// Duration returns how long the task took, or false if it has not finished.
+func (r GraphExportTaskRun) Duration() (time.Duration, bool) {
+	if !r.FinishedAt.Valid {
+		return 0, false
+	}
+	return r.FinishedAt.Time.Sub(r.CreatedAt), true
+}
+
+// GraphExportEdge connects two tasks by dot ID. Implicit edges were not
+// declared in the spec but added because To refers to the result of From.
+type GraphExportEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Implicit bool   `json:"implicit"`
+}
+
+// NewGraphExport parses the DOT source of a pipeline into a GraphExport, with
+// the nodes in the order the runner executes them.
+func NewGraphExport(dotSource string) (*GraphExport, error) {
+	if strings.TrimSpace(dotSource) == "" {
+		return nil, errors.New("empty pipeline")
+	}
+	g := NewGraph()
+	if err := g.UnmarshalText([]byte(dotSource)); err != nil {
+		return nil, err
+	}
+	nodes, err := topo.SortStabilized(g, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to topologically sort the graph, cycle detected")
+	}
+
+	export := &GraphExport{Nodes: make([]GraphExportNode, 0, len(nodes)), Edges: []GraphExportEdge{}}
+	for _, n := range nodes {
+		node := n.(*GraphNode)
+		attrs := make(map[string]string, len(node.attrs))
+		for k, v := range node.attrs {
+			if k != "type" {
+				attrs[k] = v
+			}
+		}
+		export.Nodes = append(export.Nodes, GraphExportNode{ID: node.dotID, Type: TaskType(node.attrs["type"]), Attributes: attrs})
+	}
+
+	for edges := g.Edges(); edges.Next(); {
+		edge := edges.Edge().(*GraphEdge)
+		export.Edges = append(export.Edges, GraphExportEdge{
+			From:     edge.From().(*GraphNode).dotID,
+			To:       edge.To().(*GraphNode).dotID,
+			Implicit: edge.IsImplicit(),
+		})
+	}
+	byPosition := make(map[string]int, len(nodes))
+	for i, node := range export.Nodes {
+		byPosition[node.ID] = i
+	}
+	sort.Slice(export.Edges, func(i, j int) bool {
+		a, b := export.Edges[i], export.Edges[j]
+		if byPosition[a.From] != byPosition[b.From] {
+			return byPosition[a.From] < byPosition[b.From]
+		}
+		return byPosition[a.To] < byPosition[b.To]
+	})
+
+	return export, nil
+}
+
+// OverlayRun attaches the timing and error of every task run in run to the
+// node with the same dot ID.
+func (g *GraphExport) OverlayRun(run Run) {
+	taskRuns := make(map[string]TaskRun, len(run.PipelineTaskRuns))
+	for _, tr := range run.PipelineTaskRuns {
+		taskRuns[tr.DotID] = tr
+	}
+	for i, node := range g.Nodes {
+		if tr, ok := taskRuns[node.ID]; ok {
+			g.Nodes[i].Run = &GraphExportTaskRun{CreatedAt: tr.CreatedAt, FinishedAt: tr.FinishedAt, Error: tr.Error}
+		}
+	}
+}
+
+// Render returns the graph in the given format.
+func (g *GraphExport) Render(format GraphFormat) ([]byte, error) {
+	switch format {
+	case GraphFormatDOT:
+		return []byte(g.DOT()), nil
+	case GraphFormatMermaid:
+		return []byte(g.Mermaid()), nil
+	case GraphFormatJSON:
+		return json.Marshal(g)
+	default:
+		return nil, errors.Errorf("unknown graph format %q, expected %q, %q or %q", format, GraphFormatDOT, GraphFormatMermaid, GraphFormatJSON)
+	}
+}
+
+// DOT renders the graph as a Graphviz digraph. Implicit edges are dashed and
+// errored tasks are drawn red.
+func (g *GraphExport) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph {\n")
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&sb, "\t%s [type=%s", dotQuote(node.ID), dotQuote(string(node.Type)))
+		keys := make([]string, 0, len(node.Attributes))
+		for k := range node.Attributes {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&sb, " %s=%s", k, dotQuote(node.Attributes[k]))
+		}
+		fmt.Fprintf(&sb, " label=%s", dotQuote(strings.Join(node.labelLines(), "\n")))
+		if node.Run != nil && node.Run.Error.Valid {
+			sb.WriteString(" color=red")
+		}
+		sb.WriteString("];\n")
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "\t%s -> %s", dotQuote(edge.From), dotQuote(edge.To))
+		if edge.Implicit {
+			sb.WriteString(" [style=dashed]")
+		}
+		sb.WriteString(";\n")
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// Mermaid renders the graph as a Mermaid flowchart. Implicit edges are dotted
+// and errored tasks are drawn red.
+func (g *GraphExport) Mermaid() string {
+	var sb strings.Builder
+	sb.WriteString("flowchart TD\n")
+	ids := make(map[string]string, len(g.Nodes))
+	for i, node := range g.Nodes {
+		ids[node.ID] = fmt.Sprintf("task%d", i)
+		lines := node.labelLines()
+		for j, line := range lines {
+			lines[j] = strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;", "\r\n", "<br/>", "\n", "<br/>", "\r", "<br/>").Replace(line)
+		}
+		fmt.Fprintf(&sb, "\t%s[\"%s\"]\n", ids[node.ID], strings.Join(lines, "<br/>"))
+	}
+	for _, edge := range g.Edges {
+		arrow := "-->"
+		if edge.Implicit {
+			arrow = "-.->"
+		}
+		fmt.Fprintf(&sb, "\t%s %s %s\n", ids[edge.From], arrow, ids[edge.To])
+	}
+	for _, node := range g.Nodes {
+		if node.Run != nil && node.Run.Error.Valid {
+			fmt.Fprintf(&sb, "\tstyle %s stroke:#d00,stroke-width:2px\n", ids[node.ID])
+		}
+	}
+	return sb.String()
+}
+
+func (n GraphExportNode) labelLines() []string {
+	lines := []string{n.ID, string(n.Type)}
+	if n.Run == nil {
+		return lines
+	}
+	if d, finished := n.Run.Duration(); finished {
+		lines = append(lines, d.Round(time.Millisecond).String())
+	} else {
+		lines = append(lines, "unfinished")
+	}
+	if n.Run.Error.Valid {
+		lines = append(lines, "error: "+n.Run.Error.String)
+	}
+	return lines
+}
+
+// dotEscaper escapes a string for a double-quoted DOT ID, turning newlines
+// into centered line breaks.
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
@@ -0,0 +1,141 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestGraphExport(t *testing.T) {
+	t.Parallel()
+
+	const source = `
+ds     [type=http method=GET url="https://chain.link/price"];
+parse  [type=jsonparse path="data,price"];
+scale  [type=multiply input="$(parse)" times=100];
+answer [type=median];
+
+ds -> parse -> answer;
+`
+
+	t.Run("includes implicit edges", func(t *testing.T) {
+		export, err := pipeline.NewGraphExport(source)
+		require.NoError(t, err)
+
+		ids := make([]string, len(export.Nodes))
+		for i, node := range export.Nodes {
+			ids[i] = node.ID
+		}
+		assert.Equal(t, []string{"ds", "parse", "scale", "answer"}, ids)
+		assert.Equal(t, pipeline.TaskTypeHTTP, export.Nodes[0].Type)
+		assert.Equal(t, map[string]string{"method": "GET", "url": "https://chain.link/price"}, export.Nodes[0].Attributes)
+		assert.Equal(t, []pipeline.GraphExportEdge{
+			{From: "ds", To: "parse"},
+			{From: "parse", To: "scale", Implicit: true},
+			{From: "parse", To: "answer"},
+		}, export.Edges)
+	})
+
+	t.Run("renders dot", func(t *testing.T) {
+		export, err := pipeline.NewGraphExport(source)
+		require.NoError(t, err)
+
+		dot := export.DOT()
+		assert.Contains(t, dot, `"ds" [type="http" method="GET" url="https://chain.link/price" label="ds\nhttp"];`)
+		assert.Contains(t, dot, `"ds" -> "parse";`)
+		assert.Contains(t, dot, `"parse" -> "scale" [style=dashed];`)
+
+		// the rendered graph is valid DOT
+		g := pipeline.NewGraph()
+		require.NoError(t, g.UnmarshalText([]byte(dot[len("digraph {"):len(dot)-len("}\n")])))
+		assert.Equal(t, 4, g.Nodes().Len())
+		assert.Equal(t, 3, g.Edges().Len())
+	})
+
+	t.Run("renders mermaid", func(t *testing.T) {
+		export, err := pipeline.NewGraphExport(source)
+		require.NoError(t, err)
+
+		assert.Equal(t, `flowchart TD
+	task0["ds<br/>http"]
+	task1["parse<br/>jsonparse"]
+	task2["scale<br/>multiply"]
+	task3["answer<br/>median"]
+	task0 --> task1
+	task1 -.-> task2
+	task1 --> task3
+`, export.Mermaid())
+	})
+
+	t.Run("overlays a run", func(t *testing.T) {
+		export, err := pipeline.NewGraphExport(source)
+		require.NoError(t, err)
+
+		start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		export.OverlayRun(pipeline.Run{PipelineTaskRuns: []pipeline.TaskRun{
+			{DotID: "ds", CreatedAt: start, FinishedAt: null.TimeFrom(start.Add(1500 * time.Millisecond))},
+			{DotID: "parse", CreatedAt: start, FinishedAt: null.TimeFrom(start.Add(time.Millisecond)), Error: null.StringFrom(`no "price" in data`)},
+		}})
+
+		require.NotNil(t, export.Nodes[0].Run)
+		d, finished := export.Nodes[0].Run.Duration()
+		assert.True(t, finished)
+		assert.Equal(t, 1500*time.Millisecond, d)
+		assert.Nil(t, export.Nodes[2].Run)
+
+		dot := export.DOT()
+		assert.Contains(t, dot, `label="ds\nhttp\n1.5s"];`)
+		assert.Contains(t, dot, `label="parse\njsonparse\n1ms\nerror: no \"price\" in data" color=red];`)
+
+		mermaid := export.Mermaid()
+		assert.Contains(t, mermaid, `task1["parse<br/>jsonparse<br/>1ms<br/>error: no #quot;price#quot; in data"]`)
+		assert.Contains(t, mermaid, "style task1 stroke:#d00,stroke-width:2px")
+	})
+
+	t.Run("escapes backslashes and newlines", func(t *testing.T) {
+		export, err := pipeline.NewGraphExport(`ds [type=http method=GET url="https://chain.link/a\\b"];`)
+		require.NoError(t, err)
+		start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		export.OverlayRun(pipeline.Run{PipelineTaskRuns: []pipeline.TaskRun{
+			{DotID: "ds", CreatedAt: start, FinishedAt: null.TimeFrom(start), Error: null.StringFrom("bad response:\n{\"error\": \"C:\\tmp\"}")},
+		}})
+
+		dot := export.DOT()
+		assert.Contains(t, dot, `url="https://chain.link/a\\b"`)
+		assert.Contains(t, dot, `label="ds\nhttp\n0s\nerror: bad response:\n{\"error\": \"C:\\tmp\"}" color=red];`)
+
+		g := pipeline.NewGraph()
+		require.NoError(t, g.UnmarshalText([]byte(dot[len("digraph {"):len(dot)-len("}\n")])))
+		assert.Equal(t, 1, g.Nodes().Len())
+
+		assert.Contains(t, export.Mermaid(), `task0["ds<br/>http<br/>0s<br/>error: bad response:<br/>{#quot;error#quot;: #quot;C:\tmp#quot;}"]`)
+	})
+
+	t.Run("renders json", func(t *testing.T) {
+		export, err := pipeline.NewGraphExport(source)
+		require.NoError(t, err)
+
+		b, err := export.Render(pipeline.GraphFormatJSON)
+		require.NoError(t, err)
+		var decoded pipeline.GraphExport
+		require.NoError(t, json.Unmarshal(b, &decoded))
+		assert.Equal(t, *export, decoded)
+
+		_, err = export.Render("svg")
+		require.EqualError(t, err, `unknown graph format "svg", expected "dot", "mermaid" or "json"`)
+	})
+
+	t.Run("invalid pipelines", func(t *testing.T) {
+		_, err := pipeline.NewGraphExport("  ")
+		require.EqualError(t, err, "empty pipeline")
+
+		_, err = pipeline.NewGraphExport(`a [type=memo value=1]; b [type=memo value=1]; a -> b -> a;`)
+		require.ErrorContains(t, err, "cycle detected")
+	})
+}
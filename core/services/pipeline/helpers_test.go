@@ -30,6 +30,17 @@ const (
     `
 )
 
+func NewInMemoryCacheStoreWithLimit(maxEntries int) CacheStore {
+	return newInMemoryCacheStore(maxEntries)
+}
+
+func InMemoryCacheStoreLen(s CacheStore) int {
+	store := s.(*inMemoryCacheStore)
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return len(store.entries)
+}
+
 func (t *BridgeTask) HelperSetDependencies(
 	config Config,
 	bridgeConfig BridgeConfig,
@@ -45,6 +56,10 @@ func (t *BridgeTask) HelperSetDependencies(
 	t.specId = specId
 }
 
+func (t *BridgeTask) HelperSetHealth(health *bridges.HealthMonitor) {
+	t.health = health
+}
+
 func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
 	t.config = config
 	t.httpClient = restrictedHTTPClient
@@ -274,11 +274,40 @@ type TaskRun struct {
 	FinishedAt    null.Time                         `json:"finishedAt"`
 	Index         int32                             `json:"index"`
 	DotID         string                            `json:"dotId"`
+	Attempts      TaskRunAttempts                   `json:"attempts"`
 
 	// Used internally for sorting completed results
 	task Task
 }
 
+// TaskRunAttempt is the outcome of a single attempt of a task that is configured with retries.
+type TaskRunAttempt struct {
+	Attempt    uint      `json:"attempt"`
+	Error      string    `json:"error,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
+type TaskRunAttempts []TaskRunAttempt
+
+func (tra *TaskRunAttempts) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("TaskRunAttempts#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(bytes, tra)
+}
+
+func (tra TaskRunAttempts) Value() (driver.Value, error) {
+	if len(tra) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(tra)
+}
+
 func (tr TaskRun) GetID() string {
 	return fmt.Sprintf("%v", tr.ID)
 }
@@ -62,6 +62,7 @@ type runner struct {
 	btORM                  bridges.ORM
 	config                 Config
 	bridgeConfig           BridgeConfig
+	bridgeHealth           *bridges.HealthMonitor
 	legacyEVMChains        legacyevm.LegacyChainContainer
 	ethKeyStore            ETHKeyStore
 	vrfKeyStore            VRFKeyStore
@@ -70,6 +71,9 @@ type runner struct {
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
 
+	memoryCache        CacheStore
+	cacheRevalidations sync.Map
+
 	// test helper
 	runFinished func(*Run)
 
@@ -112,6 +116,7 @@ func NewRunner(
 	btORM bridges.ORM,
 	cfg Config,
 	bridgeCfg BridgeConfig,
+	bridgeHealth *bridges.HealthMonitor,
 	legacyChains legacyevm.LegacyChainContainer,
 	ethks ETHKeyStore,
 	vrfks VRFKeyStore,
@@ -125,6 +130,7 @@ func NewRunner(
 		btORM:                  bridges.NewCache(btORM, lggr, bridges.DefaultUpsertInterval),
 		config:                 cfg,
 		bridgeConfig:           bridgeCfg,
+		bridgeHealth:           bridgeHealth,
 		legacyEVMChains:        legacyChains,
 		ethKeyStore:            ethks,
 		vrfKeyStore:            vrfks,
@@ -134,6 +140,7 @@ func NewRunner(
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		memoryCache:            NewInMemoryCacheStore(),
 	}
 
 	r.runReaperWorker = commonutils.NewSleeperTask(
@@ -226,6 +233,8 @@ type memoryTaskRun struct {
 	inputs   []Result // sorted by input index
 	vars     Vars
 	attempts uint
+	// deadline shared by all attempts of a retried task, zero if unbounded
+	deadline time.Time
 }
 
 // When a task panics, we catch the panic and wrap it in an error for reporting to the scheduler.
@@ -329,8 +338,13 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 		return
 	}
 
-	// initialize certain task params
-	for _, task := range pipeline.Tasks {
+	r.initializeTasks(spec, pipeline.Tasks)
+	return pipeline, nil
+}
+
+// initializeTasks sets the params of tasks which depend on the runner or spec.
+func (r *runner) initializeTasks(spec Spec, tasks []Task) {
+	for _, task := range tasks {
 		task.Base().uuid = uuid.New()
 
 		switch task.Type() {
@@ -343,6 +357,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
 			// orm added to BridgeTask
 			task.(*BridgeTask).orm = r.btORM
+			task.(*BridgeTask).health = r.bridgeHealth
 			task.(*BridgeTask).specId = spec.ID
 			// URL is "safe" because it comes from the node's own database. We
 			// must use the unrestrictedHTTPClient because some node operators
@@ -363,6 +378,10 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*EstimateGasLimitTask).legacyChains = r.legacyEVMChains
 			task.(*EstimateGasLimitTask).specGasLimit = spec.GasLimit
 			task.(*EstimateGasLimitTask).jobType = spec.JobType
+		case TaskTypeCache:
+			task.(*CacheTask).runner = r
+			task.(*CacheTask).spec = spec
+			r.initializeTasks(spec, task.(*CacheTask).subgraph.Tasks)
 		case TaskTypeETHTx:
 			task.(*ETHTxTask).keyStore = r.ethKeyStore
 			task.(*ETHTxTask).legacyChains = r.legacyEVMChains
@@ -372,8 +391,6 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 		default:
 		}
 	}
-
-	return pipeline, nil
 }
 
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
@@ -444,6 +461,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
+			Attempts:      result.AttemptHistory,
 			task:          result.Task,
 		})
 
@@ -545,6 +563,7 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	// - Pipeline-level timeout
 	// - Specific task timeout (task.TaskTimeout)
 	// - Job level task timeout (spec.MaxTaskDuration)
+	// - Remainder of spec.MaxTaskDuration for a retried task (taskRun.deadline)
 	// - Passed in context
 
 	// CAUTION: Think twice before changing any of the context handling code
@@ -561,6 +580,10 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		ctx, cancel = context.WithTimeout(ctx, time.Duration(spec.MaxTaskDuration))
 		defer cancel()
 	}
+	if !taskRun.deadline.IsZero() {
+		ctx, cancel = context.WithDeadline(ctx, taskRun.deadline)
+		defer cancel()
+	}
 
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
 	loggerFields := []any{"runInfo", runInfo,
@@ -781,6 +804,27 @@ func (r *runner) runReaper() {
 	} else {
 		r.lggr.Debugw("Pipeline run reaper completed successfully")
 	}
+
+	for _, store := range []CacheStore{r.memoryCache, NewDBCacheStore(r.orm.DataSource())} {
+		if err = store.DeleteExpired(ctx); err != nil {
+			r.lggr.Errorw("Pipeline cache reaper failed", "err", err)
+			r.SvcErrBuffer.Append(err)
+		}
+	}
+}
+
+func (r *runner) cacheStore(name string) (CacheStore, error) {
+	switch name {
+	case "", CacheStoreMemory:
+		return r.memoryCache, nil
+	case CacheStoreDB:
+		if r.orm == nil {
+			return nil, pkgerrors.New("db cache store is not available")
+		}
+		return NewDBCacheStore(r.orm.DataSource()), nil
+	default:
+		return nil, pkgerrors.Wrapf(ErrBadInput, "unknown cache store %q, expected %q or %q", name, CacheStoreMemory, CacheStoreDB)
+	}
 }
 
 // init task: Searches the database for runs stuck in the 'running' state while the node was previously killed.
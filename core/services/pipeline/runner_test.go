@@ -49,7 +49,7 @@ func newRunner(t testing.TB, db *sqlx.DB, bridgeORM bridges.ORM, cfg chainlink.G
 	})
 	orm := mocks.NewORM(t)
 	c := clhttptest.NewTestLocalOnlyHTTPClient()
-	r := pipeline.NewRunner(orm, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, logger.TestLogger(t), c, c)
+	r := pipeline.NewRunner(orm, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), nil, legacyChains, ethKeyStore, nil, logger.TestLogger(t), c, c)
 	return r, orm
 }
 
@@ -523,7 +523,7 @@ func Test_PipelineRunner_HandleFaultsPersistRun(t *testing.T) {
 		KeyStore:       ethKeyStore,
 	})
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), nil, legacyChains, ethKeyStore, nil, lggr, nil, nil)
 
 	spec := pipeline.Spec{
 		ID: 1,
@@ -569,7 +569,7 @@ func Test_PipelineRunner_ExecuteAndInsertFinishedRun_SavingTheSpec(t *testing.T)
 		KeyStore:       ethKeyStore,
 	})
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), nil, legacyChains, ethKeyStore, nil, lggr, nil, nil)
 
 	spec := pipeline.Spec{
 		DotDagSource: `
@@ -1045,7 +1045,7 @@ func Test_PipelineRunner_ExecuteRun(t *testing.T) {
 			KeyStore:       ethKeyStore,
 		})
 		lggr := logger.TestLogger(t)
-		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, legacyChains, ethKeyStore, nil, lggr, nil, nil)
 
 		template := `
 succeed             [type=memo value=%d]
@@ -176,10 +176,19 @@ func (s *scheduler) Run() {
 
 		// retrieve previous attempt count
 		result.Attempts = s.results[result.Task.ID()].Attempts
+		result.AttemptHistory = s.results[result.Task.ID()].AttemptHistory
 
 		// only count as an attempt if the job actually ran. If we're exiting then it got cancelled
 		if !s.exiting {
 			result.Attempts++
+			if result.Task.TaskRetries() > 0 && !result.runInfo.IsPending {
+				result.AttemptHistory = append(result.AttemptHistory, TaskRunAttempt{
+					Attempt:    result.Attempts,
+					Error:      result.Result.ErrorDB().ValueOrZero(),
+					CreatedAt:  result.CreatedAt,
+					FinishedAt: result.FinishedAt.ValueOrZero(),
+				})
+			}
 		}
 
 		// store task run
@@ -220,17 +229,11 @@ func (s *scheduler) Run() {
 		}
 
 		// if task hasn't reached it's max retry count yet, we schedule it again
-		if result.Attempts < uint(result.Task.TaskRetries()) && result.Result.Error != nil {
+		if delay, retry := s.retryAfter(result); retry {
 			// we immediately increase the in-flight counter so the pipeline doesn't terminate
 			// while we wait for the next retry
 			s.waiting++
 
-			backoff := backoff.Backoff{
-				Factor: 2,
-				Min:    result.Task.TaskMinBackoff(),
-				Max:    result.Task.TaskMaxBackoff(),
-			}
-
 			go func(vars Vars) {
 				select {
 				case <-ctx.Done():
@@ -242,10 +245,11 @@ func (s *scheduler) Run() {
 						CreatedAt:  now, // TODO: more accurate start time
 						FinishedAt: null.TimeFrom(now),
 					})
-				case <-time.After(backoff.ForAttempt(float64(result.Attempts - 1))): // we subtract 1 because backoff 0-indexes
+				case <-time.After(delay):
 					// schedule a new attempt
 					run := s.newMemoryTaskRun(result.Task, vars)
 					run.attempts = result.Attempts
+					run.deadline, _ = s.attemptsDeadline(result)
 					s.logger.Tracew("scheduling task run", "dot_id", run.task.DotID(), "attempts", run.attempts)
 					s.taskCh <- run
 				}
@@ -274,6 +278,37 @@ func (s *scheduler) Run() {
 	close(s.taskCh)
 }
 
+// retryAfter returns the backoff to wait before the next attempt of a failed task, or false if the task must not
+// be retried.
+func (s *scheduler) retryAfter(result TaskRunResult) (time.Duration, bool) {
+	if result.Result.Error == nil || result.Attempts >= uint(result.Task.TaskRetries()) {
+		return 0, false
+	}
+
+	backoff := backoff.Backoff{
+		Factor: 2,
+		Min:    result.Task.TaskMinBackoff(),
+		Max:    result.Task.TaskMaxBackoff(),
+	}
+	delay := backoff.ForAttempt(float64(result.Attempts - 1)) // we subtract 1 because backoff 0-indexes
+
+	if deadline, ok := s.attemptsDeadline(result); ok && time.Now().Add(delay).After(deadline) {
+		s.logger.Debugw("not retrying task run, next attempt would exceed MaxTaskDuration", "dot_id", result.Task.DotID(), "attempts", result.Attempts)
+		return 0, false
+	}
+	return delay, true
+}
+
+// attemptsDeadline returns the time by which all attempts of a task have to finish. The job's MaxTaskDuration
+// bounds a task as a whole, so retries do not extend it.
+func (s *scheduler) attemptsDeadline(result TaskRunResult) (time.Time, bool) {
+	maxTaskDuration := s.run.PipelineSpec.MaxTaskDuration.Duration()
+	if maxTaskDuration <= 0 || len(result.AttemptHistory) == 0 {
+		return time.Time{}, false
+	}
+	return result.AttemptHistory[0].CreatedAt.Add(maxTaskDuration), true
+}
+
 func (s *scheduler) markRemaining(err error) {
 	now := time.Now()
 	for _, task := range s.pipeline.Tasks {
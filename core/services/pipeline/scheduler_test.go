@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
@@ -20,10 +21,11 @@ type event struct {
 func TestScheduler(t *testing.T) {
 	// NOTE: task type does not matter in the test cases, it's just there so it's parsed successfully
 	tests := []struct {
-		name      string
-		spec      string
-		events    []event
-		assertion func(t *testing.T, p Pipeline, results map[int]TaskRunResult)
+		name            string
+		spec            string
+		maxTaskDuration time.Duration
+		events          []event
+		assertion       func(t *testing.T, p Pipeline, results map[int]TaskRunResult)
 	}{
 		{
 			name: "fail early immediately cancels subsequent tasks",
@@ -74,6 +76,15 @@ func TestScheduler(t *testing.T) {
 				// a is marked as errored with the last error in sequence
 				require.Equal(t, uint(3), result.Attempts)
 				require.Equal(t, ErrTimeout, result.Result.Error)
+				// every attempt is recorded
+				require.Len(t, result.AttemptHistory, 3)
+				for i, attempt := range result.AttemptHistory {
+					require.Equal(t, uint(i+1), attempt.Attempt)
+				}
+				require.Equal(t, ErrTaskRunFailed.Error(), result.AttemptHistory[0].Error)
+				require.Equal(t, ErrTimeout.Error(), result.AttemptHistory[2].Error)
+				// b isn't configured with retries
+				require.Empty(t, results[p.ByDotID("b").ID()].AttemptHistory)
 			},
 		},
 		{
@@ -102,6 +113,34 @@ func TestScheduler(t *testing.T) {
 				require.NoError(t, result.Result.Error)
 				require.Equal(t, 1, result.Result.Value)
 				require.Equal(t, uint(2), result.Attempts)
+				require.Len(t, result.AttemptHistory, 2)
+				require.Equal(t, ErrTaskRunFailed.Error(), result.AttemptHistory[0].Error)
+				require.Empty(t, result.AttemptHistory[1].Error)
+			},
+		},
+		{
+			name: "retry task: stop once the next attempt would exceed MaxTaskDuration",
+			spec: `
+			a [type=median retries=3 minBackoff="1s" maxBackoff="1s"]
+			b [type=median index=0]
+			a -> b`,
+			maxTaskDuration: 100 * time.Millisecond,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrTaskRunFailed},
+				},
+				// the backoff exceeds the remaining MaxTaskDuration, so `a` is failed right away
+				{
+					expected: "b",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				result := results[p.ByDotID("a").ID()]
+				require.Equal(t, uint(1), result.Attempts)
+				require.Equal(t, ErrTaskRunFailed, result.Result.Error)
+				require.Len(t, result.AttemptHistory, 1)
 			},
 		},
 		{
@@ -134,7 +173,7 @@ func TestScheduler(t *testing.T) {
 		p, err := Parse(test.spec)
 		require.NoError(t, err)
 		vars := NewVarsFrom(nil)
-		run := NewRun(Spec{}, vars)
+		run := NewRun(Spec{MaxTaskDuration: sqlutil.Interval(test.maxTaskDuration)}, vars)
 		s := newScheduler(p, run, vars, logger.TestLogger(t))
 
 		go s.Run()
@@ -0,0 +1,129 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// ErrNoTaskStub is returned by a simulated side-effecting task when the
+// caller did not supply a response for it.
+var ErrNoTaskStub = errors.New("no stubbed response supplied for side-effecting task")
+
+// simulatedTaskTypes are the task types which reach outside of the node, be
+// it an adapter, a URL or a chain, or share state between runs, and are
+// therefore never executed during a simulated run.
+var simulatedTaskTypes = map[TaskType]bool{
+	TaskTypeBridge:           true,
+	TaskTypeCache:            true,
+	TaskTypeETHCall:          true,
+	TaskTypeEstimateGasLimit: true,
+	TaskTypeETHTx:            true,
+	TaskTypeHTTP:             true,
+}
+
+// IsSimulatedTaskType returns true if tasks of the given type are replaced by
+// a stubbed response during a simulated run.
+func IsSimulatedTaskType(taskType TaskType) bool {
+	return simulatedTaskTypes[taskType]
+}
+
+func simulatedTaskTypeNames() string {
+	names := make([]string, 0, len(simulatedTaskTypes))
+	for taskType := range simulatedTaskTypes {
+		names = append(names, string(taskType))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// TaskStub is a caller-supplied response that is returned in place of
+// executing a side-effecting task.
+type TaskStub struct {
+	Value any    `json:"value"`
+	Error string `json:"error"`
+}
+
+// Result converts the stub into the Result reported for the task.
+func (s TaskStub) Result() Result {
+	if s.Error != "" {
+		return Result{Error: errors.New(s.Error)}
+	}
+	return Result{Value: s.Value}
+}
+
+// TaskStubs maps a task's dot ID to the response it should return during a
+// simulated run.
+type TaskStubs map[string]TaskStub
+
+// stubTask wraps a side-effecting task, keeping its place in the graph but
+// returning a stubbed result instead of running it.
+type stubTask struct {
+	Task
+	stub  TaskStub
+	found bool
+}
+
+func (t *stubTask) Run(_ context.Context, _ logger.Logger, _ Vars, _ []Result) (Result, RunInfo) {
+	if !t.found {
+		return Result{Error: errors.Wrapf(ErrNoTaskStub, "%s(%s)", t.DotID(), t.Type())}, RunInfo{}
+	}
+	return t.stub.Result(), RunInfo{}
+}
+
+// StubSideEffects replaces every side-effecting task in the pipeline with a
+// task returning the matching stub. Stubs for unknown or side-effect free tasks
+// are rejected so that typos in dot IDs do not go unnoticed.
+func (p *Pipeline) StubSideEffects(stubs TaskStubs) error {
+	if err := p.validateStubs(stubs); err != nil {
+		return err
+	}
+	p.stubTasks(stubs, func(task Task) bool { return IsSimulatedTaskType(task.Type()) })
+	return nil
+}
+
+func (p *Pipeline) validateStubs(stubs TaskStubs) error {
+	for dotID := range stubs {
+		task := p.ByDotID(dotID)
+		if task == nil {
+			return errors.Errorf("stub supplied for unknown task %q", dotID)
+		}
+		if !IsSimulatedTaskType(task.Type()) {
+			return errors.Errorf("stub supplied for task %q of type %s, only %s tasks can be stubbed", dotID, task.Type(), simulatedTaskTypeNames())
+		}
+	}
+	return nil
+}
+
+// stubTasks replaces the tasks for which stubbed returns true with a task
+// returning the matching stub.
+func (p *Pipeline) stubTasks(stubs TaskStubs, stubbed func(Task) bool) {
+	for i, task := range p.Tasks {
+		if !stubbed(task) {
+			continue
+		}
+		stub, found := stubs[task.DotID()]
+		p.Tasks[i] = &stubTask{Task: task, stub: stub, found: found}
+	}
+}
+
+// SimulateRun executes the spec in memory with every side-effecting task
+// replaced by its stub. Nothing is persisted and no transactions are
+// broadcast.
+func SimulateRun(ctx context.Context, r Runner, spec Spec, vars Vars, stubs TaskStubs) (*Run, TaskRunResults, error) {
+	// always re-parse so that a cached pipeline on the spec is left untouched
+	spec.Pipeline = nil
+	p, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = p.StubSideEffects(stubs); err != nil {
+		return nil, nil, err
+	}
+	spec.Pipeline = p
+	return r.ExecuteRun(ctx, spec, vars)
+}
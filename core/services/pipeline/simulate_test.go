@@ -0,0 +1,100 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+func newSimulationRunner(t *testing.T) pipeline.Runner {
+	cfg := mocks.NewConfig(t)
+	cfg.On("VerboseLogging").Return(false).Maybe()
+	cfg.On("MaxRunDuration").Return(testutils.WaitTimeout(t)).Maybe()
+	return pipeline.NewRunner(nil, nil, cfg, nil, nil, nil, nil, nil, logger.TestLogger(t), nil, nil)
+}
+
+func TestSimulateRun(t *testing.T) {
+	t.Parallel()
+
+	spec := pipeline.Spec{DotDagSource: `
+ds1          [type=http method=GET url="https://chain.link/price"];
+ds1_parse    [type=jsonparse path="data,price"];
+ds2          [type=bridge name=price_adapter];
+ds2_parse    [type=jsonparse path="data,price"];
+answer       [type=median];
+gas          [type=estimategaslimit to="0x0000000000000000000000000000000000000001" from="0x0000000000000000000000000000000000000002" data="$(answer)"];
+submit       [type=ethtx to="0x0000000000000000000000000000000000000001" data="$(answer)" gasLimit="$(gas)"];
+check        [type=ethcall contract="0x0000000000000000000000000000000000000001" data="$(submit)"];
+
+ds1 -> ds1_parse -> answer;
+ds2 -> ds2_parse -> answer;
+answer -> gas -> submit -> check;
+`}
+
+	t.Run("returns stubbed responses for side-effecting tasks", func(t *testing.T) {
+		r := newSimulationRunner(t)
+		stubs := pipeline.TaskStubs{
+			"ds1":    {Value: `{"data":{"price":100}}`},
+			"ds2":    {Value: `{"data":{"price":200}}`},
+			"gas":    {Value: 21000},
+			"submit": {Value: "0xabc"},
+			"check":  {Value: "0x01"},
+		}
+
+		run, trrs, err := pipeline.SimulateRun(testutils.Context(t), r, spec, pipeline.NewVarsFrom(nil), stubs)
+		require.NoError(t, err)
+		require.Len(t, trrs, 8)
+		assert.False(t, run.HasErrors())
+
+		for _, trr := range trrs {
+			switch trr.Task.DotID() {
+			case "answer":
+				assert.Equal(t, "150", trr.Result.Value.(interface{ String() string }).String())
+			case "submit":
+				assert.Equal(t, "0xabc", trr.Result.Value)
+			case "check":
+				assert.Equal(t, "0x01", trr.Result.Value)
+			}
+		}
+		// the spec itself is left untouched
+		assert.Nil(t, spec.Pipeline)
+	})
+
+	t.Run("errors tasks without a stub", func(t *testing.T) {
+		r := newSimulationRunner(t)
+		stubs := pipeline.TaskStubs{
+			"ds1": {Value: `{"data":{"price":100}}`},
+			"ds2": {Error: "adapter down"},
+			"gas": {Value: 21000},
+		}
+
+		run, trrs, err := pipeline.SimulateRun(testutils.Context(t), r, spec, pipeline.NewVarsFrom(nil), stubs)
+		require.NoError(t, err)
+		require.True(t, run.HasFatalErrors())
+
+		for _, trr := range trrs {
+			switch trr.Task.DotID() {
+			case "ds2":
+				assert.EqualError(t, trr.Result.Error, "adapter down")
+			case "submit":
+				assert.ErrorIs(t, trr.Result.Error, pipeline.ErrNoTaskStub)
+			}
+		}
+	})
+
+	t.Run("rejects stubs for unknown or side-effect free tasks", func(t *testing.T) {
+		r := newSimulationRunner(t)
+
+		_, _, err := pipeline.SimulateRun(testutils.Context(t), r, spec, pipeline.NewVarsFrom(nil), pipeline.TaskStubs{"nope": {}})
+		require.EqualError(t, err, `stub supplied for unknown task "nope"`)
+
+		_, _, err = pipeline.SimulateRun(testutils.Context(t), r, spec, pipeline.NewVarsFrom(nil), pipeline.TaskStubs{"answer": {}})
+		require.ErrorContains(t, err, `stub supplied for task "answer" of type median`)
+	})
+}
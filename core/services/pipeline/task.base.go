@@ -76,7 +76,7 @@ func (t BaseTask) TaskMinBackoff() time.Duration {
 }
 
 func (t BaseTask) TaskMaxBackoff() time.Duration {
-	if t.MinBackoff > 0 {
+	if t.MaxBackoff > 0 {
 		return t.MaxBackoff
 	}
 	return time.Minute
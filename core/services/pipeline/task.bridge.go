@@ -67,6 +67,7 @@ type BridgeTask struct {
 
 	specId       int32
 	orm          bridges.ORM
+	health       *bridges.HealthMonitor
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
@@ -125,10 +126,19 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
-	url, err := t.getBridgeURLFromName(overtimeCtx, name)
+	bt, err := t.getBridgeFromName(overtimeCtx, name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := URLParam(bt.URL)
+	client, err := bt.HTTPClient(t.httpClient, t.config.DefaultHTTPLimit())
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	client = taskHTTPClient(ctx, t.DotID(), client)
+	// Requests sent through a task transport are not live traffic, so they
+	// neither consult nor update the circuit breaker and cache of the bridge.
+	live := taskTransportFromContext(ctx) == nil
 
 	var metaMap MapParam
 
@@ -175,10 +185,29 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
-	var cachedResponse bool
-	responseBytes, statusCode, headers, start, finish, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	var (
+		cachedResponse bool
+		responseBytes  []byte
+		statusCode     int
+		headers        http.Header
+		start, finish  time.Time
+	)
+	bridgeName := bridges.BridgeName(name)
+	var circuitErr error
+	if live {
+		circuitErr = t.allowRequest(bridgeName)
+	}
+	if circuitErr != nil {
+		// The request fails fast, and falls back to the cache like any other
+		// failed request.
+		err = circuitErr
+		start = time.Now()
+		finish = start
+	} else {
+		responseBytes, statusCode, headers, start, finish, err = makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+		promBridgeLatency.WithLabelValues(t.Name, statusCodeGroup(statusCode)).Set(finish.Sub(start).Seconds())
+	}
 	elapsed := finish.Sub(start)
-	promBridgeLatency.WithLabelValues(t.Name, statusCodeGroup(statusCode)).Set(elapsed.Seconds())
 
 	defer func() {
 		telemetryCh := GetTelemetryCh(ctx)
@@ -213,6 +242,9 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
 		statusCode = code
 	}
+	if live && circuitErr == nil {
+		t.recordOutcome(ctx, bridgeName, statusCode, err)
+	}
 
 	if err != nil || statusCode != http.StatusOK {
 		if adapterErr := eautils.BestEffortExtractEAError(responseBytes); adapterErr != nil {
@@ -220,7 +252,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		}
 
 		promBridgeErrors.WithLabelValues(t.Name).Inc()
-		if cacheTTL == 0 {
+		if cacheTTL == 0 || !live {
 			lggr.Debugw("Bridge task: request failed",
 				"response", string(responseBytes),
 				"url", url.String(),
@@ -264,7 +296,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		}
 	}
 
-	if !cachedResponse && cacheTTL > 0 {
+	if live && !cachedResponse && cacheTTL > 0 {
 		err := t.orm.UpsertBridgeResponse(overtimeCtx, t.dotID, t.specId, responseBytes)
 		if err != nil {
 			lggr.Errorw("Bridge task: failed to upsert response in bridge cache", "err", err)
@@ -305,12 +337,39 @@ func (bt *BridgeTelemetry) resolveStreamID(t *BridgeTask, vars Vars, lggr logger
 	}
 }
 
-func (t *BridgeTask) getBridgeURLFromName(ctx context.Context, name StringParam) (URLParam, error) {
+// allowRequest returns bridges.ErrCircuitOpen if the circuit breaker of the
+// bridge is open.
+func (t *BridgeTask) allowRequest(name bridges.BridgeName) error {
+	if t.health == nil {
+		return nil
+	}
+	return t.health.Allow(name)
+}
+
+// recordOutcome reports the outcome of a request to the circuit breaker of the
+// bridge. Only errors and 5xx responses count as failures, since other
+// responses show that the bridge is up. Requests cut short by the run being
+// canceled are not reported.
+func (t *BridgeTask) recordOutcome(ctx context.Context, name bridges.BridgeName, statusCode int, err error) {
+	if t.health == nil || ctx.Err() != nil {
+		return
+	}
+	switch {
+	case err != nil:
+		t.health.RecordFailure(name, err)
+	case statusCode >= http.StatusInternalServerError:
+		t.health.RecordFailure(name, errors.Errorf("status code %d", statusCode))
+	default:
+		t.health.RecordSuccess(name)
+	}
+}
+
+func (t *BridgeTask) getBridgeFromName(ctx context.Context, name StringParam) (bridges.BridgeType, error) {
 	bt, err := t.orm.FindBridge(ctx, bridges.BridgeName(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bridges.BridgeType{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
 	}
-	return URLParam(bt.URL), nil
+	return bt, nil
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -1202,6 +1202,58 @@ func TestBridgeTask_AdapterTimeout(t *testing.T) {
 	})
 }
 
+func TestBridgeTask_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {})
+
+	var requests atomic.Int32
+	s1 := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+	defer s1.Close()
+
+	orm := bridges.NewORM(db)
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s1.URL})
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: btcUSDPairing,
+		CacheTTL:    "30s",
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(ctx, pipeline.Pipeline{}, *sqlutil.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, c)
+	health := bridges.NewHealthMonitor(2, time.Hour)
+	task.HelperSetHealth(health)
+
+	for range 2 {
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+	}
+	assert.Equal(t, bridges.CircuitOpen, health.Health(bridge.Name).Circuit)
+
+	result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.ErrorIs(t, result.Error, bridges.ErrCircuitOpen)
+	assert.Equal(t, int32(2), requests.Load(), "open circuit breaker fails fast")
+
+	t.Run("falls back to cache", func(t *testing.T) {
+		require.NoError(t, orm.UpsertBridgeResponse(ctx, task.DotID(), specID, []byte(`{"data":{"result":9700}}`)))
+
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, `{"data":{"result":9700}}`, result.Value.(string))
+		assert.Equal(t, int32(2), requests.Load())
+	})
+}
+
 func TestBridgeTask_PipelineAdapterLWBAError(t *testing.T) {
 	t.Parallel()
 
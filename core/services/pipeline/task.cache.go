@@ -0,0 +1,178 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// CacheTask runs the sub-pipeline given in pipeline and caches its result under
+// key, so that the subgraph does not run again while the entry is fresh. The
+// key may contain variable expressions, e.g. key="eth-usd-$(jobSpec.evmChainID)".
+// Within staleWhileRevalidate after the ttl the stale result is returned and
+// refreshed in the background.
+//
+// The subgraph has to have a single terminal task. It can refer to the results
+// of the surrounding pipeline, but its dot IDs must not clash with them:
+//
+//	cached [type=cache key="eth-usd" ttl="30s" pipeline="
+//	    ds    [type=http method=GET url=\"https://chain.link/eth-usd\"];
+//	    parse [type=jsonparse path=\"data,price\"];
+//	    ds -> parse;
+//	"];
+//
+// store is either "memory" (default), which is local to the node and holds at
+// most 10,000 entries across all jobs, or "db".
+// Entries are scoped to the job, so jobs using the same key do not share them.
+//
+// Return types:
+//
+//	the result of the subgraph's terminal task
+type CacheTask struct {
+	BaseTask             `mapstructure:",squash"`
+	Key                  string        `json:"key"`
+	TTL                  time.Duration `json:"ttl"`
+	StaleWhileRevalidate time.Duration `json:"staleWhileRevalidate"`
+	Store                string        `json:"store"`
+	Pipeline             string        `json:"pipeline"`
+
+	runner   *runner
+	spec     Spec
+	subgraph *Pipeline
+}
+
+var _ Task = (*CacheTask)(nil)
+
+func (t *CacheTask) Type() TaskType {
+	return TaskTypeCache
+}
+
+func (t *CacheTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	key, err := interpolateVarExprs(t.Key, vars)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "key")}, runInfo
+	} else if strings.TrimSpace(key) == "" {
+		return Result{Error: errors.Wrap(ErrParameterEmpty, "key")}, runInfo
+	}
+	if t.TTL <= 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "ttl must be positive")}, runInfo
+	}
+	if t.StaleWhileRevalidate < 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "staleWhileRevalidate must not be negative")}, runInfo
+	}
+	store, err := t.runner.cacheStore(t.Store)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	key = fmt.Sprintf("%d/%s", t.spec.JobID, key)
+
+	entry, found, err := store.Get(ctx, key)
+	if err != nil {
+		lggr.Warnw("Cache task: failed to read cache, running subgraph", "key", key, "err", err)
+	}
+	if found {
+		age := time.Since(entry.StoredAt)
+		if age <= t.TTL {
+			return Result{Value: entry.Value}, runInfo
+		}
+		if age <= t.TTL+t.StaleWhileRevalidate {
+			t.revalidate(lggr, store, key, vars.Copy())
+			return Result{Value: entry.Value}, runInfo
+		}
+	}
+
+	return t.refresh(ctx, lggr, store, key, vars), runInfo
+}
+
+// revalidate refreshes the entry in the background, unless a refresh of the
+// key is already in flight or the runner is not running.
+func (t *CacheTask) revalidate(lggr logger.Logger, store CacheStore, key string, vars Vars) {
+	if _, inFlight := t.runner.cacheRevalidations.LoadOrStore(key, struct{}{}); inFlight {
+		return
+	}
+	started := t.runner.IfStarted(func() {
+		t.runner.wgDone.Add(1)
+		go func() {
+			defer t.runner.wgDone.Done()
+			defer t.runner.cacheRevalidations.Delete(key)
+			ctx, cancel := t.runner.chStop.NewCtx()
+			defer cancel()
+			if result := t.refresh(ctx, lggr, store, key, vars); result.Error != nil {
+				lggr.Warnw("Cache task: failed to revalidate stale entry", "key", key, "err", result.Error)
+			}
+		}()
+	})
+	if !started {
+		t.runner.cacheRevalidations.Delete(key)
+	}
+}
+
+// refresh runs the subgraph and stores its result unless it errored.
+func (t *CacheTask) refresh(ctx context.Context, lggr logger.Logger, store CacheStore, key string, vars Vars) Result {
+	spec := t.spec
+	spec.DotDagSource = t.subgraph.Source
+	spec.Pipeline = t.subgraph
+	_, trrs, err := t.runner.ExecuteRun(ctx, spec, vars)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "subgraph")}
+	}
+	result, err := trrs.FinalResult().SingularResult()
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "subgraph must have exactly one terminal task")}
+	} else if result.Error != nil {
+		return Result{Error: errors.Wrap(result.Error, "subgraph")}
+	}
+
+	now := time.Now()
+	if err := store.Put(ctx, key, CacheEntry{Value: result.Value, StoredAt: now}, now.Add(t.TTL+t.StaleWhileRevalidate)); err != nil {
+		lggr.Warnw("Cache task: failed to store result", "key", key, "err", err)
+	}
+	return Result{Value: result.Value}
+}
+
+// parseSubgraph parses the sub-pipeline, once when the surrounding pipeline
+// is parsed.
+func (t *CacheTask) parseSubgraph() (err error) {
+	t.subgraph, err = Parse(t.dotSource())
+	return errors.Wrapf(err, "pipeline of cache task %s", t.DotID())
+}
+
+// dotSource returns the sub-pipeline. The DOT decoder leaves quoted attributes
+// spanning several lines as they are, so those are unquoted here.
+func (t *CacheTask) dotSource() string {
+	s := t.Pipeline
+	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		s = strings.ReplaceAll(s[1:len(s)-1], `\"`, `"`)
+	}
+	return s
+}
+
+// interpolateVarExprs replaces every variable expression in s with the
+// formatted value of the variable.
+func interpolateVarExprs(s string, vars Vars) (string, error) {
+	var err error
+	interpolated := variableRegexp.ReplaceAllStringFunc(s, func(expr string) string {
+		keypath := strings.TrimSpace(expr[2 : len(expr)-1])
+		val, getErr := vars.Get(keypath)
+		if getErr != nil {
+			err = getErr
+			return ""
+		}
+		if valErr, isErr := val.(error); isErr {
+			err = errors.Wrapf(ErrBadInput, "%s is an error: %v", keypath, valErr)
+			return ""
+		}
+		return fmt.Sprint(val)
+	})
+	return interpolated, err
+}
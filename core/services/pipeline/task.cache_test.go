@@ -0,0 +1,161 @@
+package pipeline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+func TestCacheTask(t *testing.T) {
+	t.Parallel()
+
+	cachedPrice := func(attrs string) pipeline.Spec {
+		return pipeline.Spec{DotDagSource: `
+cached [type=cache key="price-$(pair)" ` + attrs + ` pipeline="
+    scale [type=multiply input=\"$(price)\" times=10];
+"];
+`}
+	}
+	runResult := func(t *testing.T, r pipeline.Runner, spec pipeline.Spec, vars map[string]any) pipeline.Result {
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(vars))
+		require.NoError(t, err)
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		return result
+	}
+	run := func(t *testing.T, r pipeline.Runner, spec pipeline.Spec, pair string, price int) string {
+		result := runResult(t, r, spec, map[string]any{"pair": pair, "price": price})
+		require.NoError(t, result.Error)
+		return result.Value.(decimal.Decimal).String()
+	}
+
+	t.Run("runs the subgraph on a miss and returns the entry while fresh", func(t *testing.T) {
+		r := newSimulationRunner(t)
+		spec := cachedPrice(`ttl="1h"`)
+
+		assert.Equal(t, "10", run(t, r, spec, "eth-usd", 1))
+		assert.Equal(t, "10", run(t, r, spec, "eth-usd", 2))
+		assert.Equal(t, "30", run(t, r, spec, "btc-usd", 3))
+	})
+
+	t.Run("does not share entries between jobs", func(t *testing.T) {
+		r := newSimulationRunner(t)
+		spec := cachedPrice(`ttl="1h"`)
+		spec.JobID = 1
+		other := cachedPrice(`ttl="1h"`)
+		other.JobID = 2
+
+		assert.Equal(t, "10", run(t, r, spec, "eth-usd", 1))
+		assert.Equal(t, "20", run(t, r, other, "eth-usd", 2))
+		assert.Equal(t, "10", run(t, r, spec, "eth-usd", 3))
+	})
+
+	t.Run("runs the subgraph again once the entry expired", func(t *testing.T) {
+		r := newSimulationRunner(t)
+		spec := cachedPrice(`ttl="1ms"`)
+
+		require.Equal(t, "10", run(t, r, spec, "eth-usd", 1))
+		time.Sleep(5 * time.Millisecond)
+		require.Equal(t, "20", run(t, r, spec, "eth-usd", 2))
+	})
+
+	t.Run("returns a stale entry and revalidates it in the background", func(t *testing.T) {
+		cfg := mocks.NewConfig(t)
+		cfg.On("VerboseLogging").Return(false).Maybe()
+		cfg.On("MaxRunDuration").Return(testutils.WaitTimeout(t)).Maybe()
+		cfg.On("ReaperInterval").Return(time.Duration(0)).Maybe()
+		orm := mocks.NewORM(t)
+		orm.On("GetUnfinishedRuns", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+		r := pipeline.NewRunner(orm, nil, cfg, nil, nil, nil, nil, nil, logger.TestLogger(t), nil, nil)
+		servicetest.Run(t, r)
+		spec := cachedPrice(`ttl="1ms" staleWhileRevalidate="1h"`)
+
+		require.Equal(t, "10", run(t, r, spec, "eth-usd", 1))
+		time.Sleep(5 * time.Millisecond)
+		require.Equal(t, "10", run(t, r, spec, "eth-usd", 2))
+		assert.Eventually(t, func() bool {
+			return run(t, r, spec, "eth-usd", 3) != "10"
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			attrs   string
+			wantErr error
+		}{
+			{"missing ttl", ``, pipeline.ErrBadInput},
+			{"negative staleWhileRevalidate", `ttl="1h" staleWhileRevalidate="-1s"`, pipeline.ErrBadInput},
+			{"unknown store", `ttl="1h" store="redis"`, pipeline.ErrBadInput},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				result := runResult(t, newSimulationRunner(t), cachedPrice(test.attrs), map[string]any{"pair": "eth-usd", "price": 1})
+				require.Equal(t, test.wantErr, errors.Cause(result.Error))
+			})
+		}
+
+		t.Run("invalid subgraph", func(t *testing.T) {
+			_, err := pipeline.Parse(`cached [type=cache key="price" ttl="1h" pipeline="a -> b; b -> a;"];`)
+			require.ErrorContains(t, err, "pipeline of cache task cached")
+		})
+
+		t.Run("missing key variable", func(t *testing.T) {
+			result := runResult(t, newSimulationRunner(t), cachedPrice(`ttl="1h"`), map[string]any{"price": 1})
+			require.ErrorIs(t, result.Error, pipeline.ErrKeypathNotFound)
+		})
+	})
+}
+
+func TestInMemoryCacheStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	now := time.Now()
+
+	t.Run("evicts expired entries when they are read", func(t *testing.T) {
+		store := pipeline.NewInMemoryCacheStoreWithLimit(10)
+		require.NoError(t, store.Put(ctx, "expired", pipeline.CacheEntry{Value: 1}, now.Add(-time.Second)))
+		require.NoError(t, store.Put(ctx, "fresh", pipeline.CacheEntry{Value: 2}, now.Add(time.Hour)))
+
+		_, ok, err := store.Get(ctx, "expired")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, 1, pipeline.InMemoryCacheStoreLen(store))
+	})
+
+	t.Run("caps the number of entries", func(t *testing.T) {
+		store := pipeline.NewInMemoryCacheStoreWithLimit(2)
+		require.NoError(t, store.Put(ctx, "expired", pipeline.CacheEntry{Value: 1}, now.Add(-time.Second)))
+		require.NoError(t, store.Put(ctx, "late", pipeline.CacheEntry{Value: 2}, now.Add(2*time.Hour)))
+		require.NoError(t, store.Put(ctx, "early", pipeline.CacheEntry{Value: 3}, now.Add(time.Hour)))
+		assert.Equal(t, 2, pipeline.InMemoryCacheStoreLen(store), "the expired entry makes room")
+
+		require.NoError(t, store.Put(ctx, "latest", pipeline.CacheEntry{Value: 4}, now.Add(3*time.Hour)))
+		assert.Equal(t, 2, pipeline.InMemoryCacheStoreLen(store))
+		_, ok, err := store.Get(ctx, "early")
+		require.NoError(t, err)
+		assert.False(t, ok, "the entry expiring first is evicted")
+		for _, key := range []string{"late", "latest"} {
+			_, ok, err = store.Get(ctx, key)
+			require.NoError(t, err)
+			assert.True(t, ok, key)
+		}
+
+		// overwriting an entry of a full store evicts nothing
+		require.NoError(t, store.Put(ctx, "late", pipeline.CacheEntry{Value: 5}, now.Add(time.Hour)))
+		assert.Equal(t, 2, pipeline.InMemoryCacheStoreLen(store))
+	})
+}
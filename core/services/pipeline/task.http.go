@@ -103,6 +103,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
+	client = taskHTTPClient(ctx, t.DotID(), client)
 	responseBytes, statusCode, respHeaders, start, finish, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
 	elapsed := finish.Sub(start).Milliseconds()
 	if err != nil {
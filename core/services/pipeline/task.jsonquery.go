@@ -0,0 +1,114 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	stderrors "errors"
+
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+// JSONQueryTask extracts a value from JSON with a GJSON path query
+// (https://github.com/tidwall/gjson/blob/master/SYNTAX.md), which unlike the
+// path of jsonparse can iterate over arrays and filter them, e.g.
+//
+//	query=<data.#(symbol=="ETH").price>
+//	query=<data.#(volume>1000)#.price>
+//
+// Return types:
+//
+//	float64 | int64 | *big.Int | decimal.Decimal (see jsonserializable.ReinterpretJSONNumbers)
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type JSONQueryTask struct {
+	BaseTask `mapstructure:",squash"`
+	Query    string `json:"query"`
+	Data     string `json:"data"`
+	// Lax when disabled will return an error if the query does not match anything
+	// Lax when enabled will return nil with no error if the query does not match anything
+	Lax string
+}
+
+var _ Task = (*JSONQueryTask)(nil)
+
+func (t *JSONQueryTask) Type() TaskType {
+	return TaskTypeJSONQuery
+}
+
+func (t *JSONQueryTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		query StringParam
+		data  jsonQueryDataParam
+		lax   BoolParam
+	)
+	err = stderrors.Join(
+		errors.Wrap(ResolveParam(&query, From(VarExpr(t.Query, vars), NonemptyString(t.Query))), "query"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if !gjson.ValidBytes(data) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "data is not valid JSON: %s", data)}, runInfo
+	}
+
+	match := gjson.GetBytes(data, string(query))
+	if !match.Exists() {
+		if bool(lax) {
+			return Result{Value: nil}, runInfo
+		}
+		return Result{Error: errors.Wrapf(ErrKeypathNotFound, "could not resolve query %q in %s", query, data)}, runInfo
+	}
+
+	var decoded any
+	d := json.NewDecoder(bytes.NewReader([]byte(match.Raw)))
+	d.UseNumber()
+	if err = d.Decode(&decoded); err != nil {
+		return Result{Error: stderrors.Join(ErrBadInput, err)}, runInfo
+	}
+
+	decoded, err = jsonserializable.ReinterpretJSONNumbers(decoded)
+	if err != nil {
+		return Result{Error: stderrors.Join(ErrBadInput, err)}, runInfo
+	}
+
+	return Result{Value: decoded}, runInfo
+}
+
+// jsonQueryDataParam accepts JSON text, as well as maps and slices already
+// decoded by a previous task, which are encoded back to JSON.
+type jsonQueryDataParam []byte
+
+func (p *jsonQueryDataParam) UnmarshalPipelineParam(val any) error {
+	switch v := val.(type) {
+	case map[string]any, []any:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return errors.Wrap(ErrBadInput, err.Error())
+		}
+		*p = b
+		return nil
+	}
+
+	var b BytesParam
+	if err := b.UnmarshalPipelineParam(val); err != nil {
+		return err
+	}
+	*p = jsonQueryDataParam(b)
+	return nil
+}
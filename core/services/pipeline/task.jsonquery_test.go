@@ -0,0 +1,178 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJSONQueryTask(t *testing.T) {
+	t.Parallel()
+
+	const prices = `{"data":[{"symbol":"BTC","price":65000.5,"volume":2000},{"symbol":"ETH","price":3200,"volume":500},{"symbol":"LINK","price":14.25,"volume":1500}]}`
+
+	tests := []struct {
+		name              string
+		data              string
+		query             string
+		lax               string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          any
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{
+			"filter array by key",
+			"",
+			`data.#(symbol=="ETH").price`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			int64(3200),
+			nil,
+			"",
+		},
+		{
+			"collect field of all matches",
+			"",
+			`data.#(volume>1000)#.symbol`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			[]any{"BTC", "LINK"},
+			nil,
+			"",
+		},
+		{
+			"object result",
+			"",
+			`data.2`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			map[string]any{"symbol": "LINK", "price": 14.25, "volume": int64(1500)},
+			nil,
+			"",
+		},
+		{
+			"array length",
+			"",
+			`data.#`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			int64(3),
+			nil,
+			"",
+		},
+		{
+			"large int result",
+			"",
+			"some_id",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"some_id":1564679049192120321}`}},
+			int64(1564679049192120321),
+			nil,
+			"",
+		},
+		{
+			"decoded map input",
+			"",
+			`data.#(symbol=="BTC").price`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: map[string]any{"data": []any{map[string]any{"symbol": "BTC", "price": 65000.5}}}}},
+			65000.5,
+			nil,
+			"",
+		},
+		{
+			"query and data from vars",
+			"$(foo.data)",
+			"$(foo.query)",
+			"",
+			pipeline.NewVarsFrom(map[string]any{
+				"foo": map[string]any{"data": prices, "query": `data.#(symbol=="LINK").price`},
+			}),
+			nil,
+			14.25,
+			nil,
+			"",
+		},
+		{
+			"no match",
+			"",
+			`data.#(symbol=="DOGE").price`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrKeypathNotFound,
+			`could not resolve query "data.#(symbol==\"DOGE\").price"`,
+		},
+		{
+			"no match with lax",
+			"",
+			`data.#(symbol=="DOGE").price`,
+			"true",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			nil,
+			"",
+		},
+		{
+			"invalid JSON",
+			"",
+			"data",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data": [`}},
+			nil,
+			pipeline.ErrBadInput,
+			"data is not valid JSON",
+		},
+		{
+			"missing query",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrParameterEmpty,
+			"query",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JSONQueryTask{
+				BaseTask: pipeline.NewBaseTask(0, "query", nil, nil, 0),
+				Query:    test.query,
+				Data:     test.data,
+				Lax:      test.lax,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.ErrorIs(t, result.Error, test.wantErrorCause)
+				require.ErrorContains(t, result.Error, test.wantErrorContains)
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
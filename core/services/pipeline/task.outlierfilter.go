@@ -0,0 +1,132 @@
+package pipeline
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// OutlierFilterTask drops values that deviate too far from the weighted median
+// of all values, so that they can be aggregated by a following median, mean or
+// weightedmedian task, e.g. values="$(filter.values)". Exactly one of:
+//
+//	maxDeviation   maximum deviation relative to the median, e.g. "0.05" for 5%
+//	madMultiplier  maximum deviation as a multiple k of the median absolute deviation
+//
+// Errored values and outliers both count as faults against allowedFaults.
+//
+// Return types:
+//
+//	map[string]interface{}{
+//	    "values":    []interface{} // decimal.Decimal, the values that were kept
+//	    "weights":   []interface{} // decimal.Decimal, the weights of the kept values
+//	    "discarded": []interface{} // map[string]interface{} with the "index" of the value and its "value" or "error"
+//	}
+type OutlierFilterTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Weights       string `json:"weights"`
+	AllowedFaults string `json:"allowedFaults"`
+	MaxDeviation  string `json:"maxDeviation"`
+	MADMultiplier string `json:"madMultiplier"`
+}
+
+var _ Task = (*OutlierFilterTask)(nil)
+
+func (t *OutlierFilterTask) Type() TaskType {
+	return TaskTypeOutlierFilter
+}
+
+func (t *OutlierFilterTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	byDeviation := strings.TrimSpace(t.MaxDeviation) != ""
+	byMAD := strings.TrimSpace(t.MADMultiplier) != ""
+	if byDeviation == byMAD {
+		return Result{Error: errors.Wrap(ErrBadInput, "exactly one of maxDeviation or madMultiplier must be set")}, runInfo
+	}
+
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		valuesAndErrs      SliceParam
+		weights            DecimalSliceParam
+		limit              DecimalParam
+		allowedFaults      int
+	)
+	err := stderrors.Join(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&weights, From(VarExpr(t.Weights, vars), JSONWithVarExprs(t.Weights, vars, false), nil)), "weights"),
+	)
+	if byDeviation {
+		err = stderrors.Join(err, errors.Wrap(ResolveParam(&limit, From(VarExpr(t.MaxDeviation, vars), NonemptyString(t.MaxDeviation))), "maxDeviation"))
+	} else {
+		err = stderrors.Join(err, errors.Wrap(ResolveParam(&limit, From(VarExpr(t.MADMultiplier, vars), NonemptyString(t.MADMultiplier))), "madMultiplier"))
+	}
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if limit.Decimal().IsNegative() {
+		return Result{Error: errors.Wrapf(ErrBadInput, "maximum deviation %s must not be negative", limit.Decimal())}, runInfo
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = max(len(valuesAndErrs)-1, 0)
+	}
+
+	wv, err := newWeightedValues(valuesAndErrs, weights)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	median, ok := weightedMedian(wv.values, wv.weights)
+	if !ok {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no values with a positive weight to filter")}, runInfo
+	}
+
+	deviations := make([]decimal.Decimal, len(wv.values))
+	for i, value := range wv.values {
+		deviations[i] = value.Sub(median).Abs()
+	}
+	var threshold decimal.Decimal
+	if byDeviation {
+		threshold = median.Abs().Mul(limit.Decimal())
+	} else {
+		mad, _ := weightedMedian(deviations, wv.weights)
+		threshold = mad.Mul(limit.Decimal())
+	}
+
+	var (
+		kept      = []any{}
+		keptW     = []any{}
+		discarded = []any{}
+	)
+	for i, j := 0, 0; i < len(valuesAndErrs); i++ {
+		if err, isFault := wv.faults[i]; isFault {
+			discarded = append(discarded, map[string]any{"index": i, "error": err.Error()})
+			continue
+		}
+		if deviations[j].GreaterThan(threshold) {
+			discarded = append(discarded, map[string]any{"index": i, "value": wv.values[j]})
+		} else {
+			kept = append(kept, wv.values[j])
+			keptW = append(keptW, wv.weights[j])
+		}
+		j++
+	}
+
+	if len(discarded) > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty and outlying inputs %v to outlierfilter task > number allowed faults %v", len(discarded), allowedFaults)}, runInfo
+	}
+
+	return Result{Value: map[string]any{
+		"values":    kept,
+		"weights":   keptW,
+		"discarded": discarded,
+	}}, runInfo
+}
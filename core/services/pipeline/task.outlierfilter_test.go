@@ -0,0 +1,193 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestOutlierFilterTask(t *testing.T) {
+	t.Parallel()
+
+	prices := []pipeline.Result{
+		{Value: mustDecimal(t, "100")},
+		{Value: mustDecimal(t, "101")},
+		{Value: mustDecimal(t, "130")},
+		{Value: mustDecimal(t, "99")},
+		{Value: mustDecimal(t, "102")},
+	}
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		weights       string
+		allowedFaults string
+		maxDeviation  string
+		madMultiplier string
+		wantValues    []string
+		wantWeights   []string
+		wantDiscarded []any
+		wantError     error
+	}{
+		{
+			"relative deviation from the median",
+			prices,
+			"",
+			"",
+			"0.05",
+			"",
+			[]string{"100", "101", "99", "102"},
+			[]string{"1", "1", "1", "1"},
+			[]any{map[string]any{"index": 2, "value": decimal.RequireFromString("130")}},
+			nil,
+		},
+		{
+			"multiple of the median absolute deviation",
+			prices,
+			"",
+			"",
+			"",
+			"3",
+			[]string{"100", "101", "99", "102"},
+			[]string{"1", "1", "1", "1"},
+			[]any{map[string]any{"index": 2, "value": decimal.RequireFromString("130")}},
+			nil,
+		},
+		{
+			"weights are carried through",
+			prices,
+			"[1, 2, 1, 1, 3]",
+			"",
+			"0.015",
+			"",
+			[]string{"100", "101", "102"},
+			[]string{"1", "2", "3"},
+			[]any{
+				map[string]any{"index": 2, "value": decimal.RequireFromString("130")},
+				map[string]any{"index": 3, "value": decimal.RequireFromString("99")},
+			},
+			nil,
+		},
+		{
+			"errored inputs are discarded",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}, {Error: errors.New("adapter down")}, {Value: mustDecimal(t, "101")}},
+			"",
+			"",
+			"0.05",
+			"",
+			[]string{"100", "101"},
+			[]string{"1", "1"},
+			[]any{map[string]any{"index": 1, "error": "adapter down"}},
+			nil,
+		},
+		{
+			"outliers count against allowed faults",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}, {Error: errors.New("adapter down")}, {Value: mustDecimal(t, "101")}, {Value: mustDecimal(t, "200")}},
+			"",
+			"1",
+			"0.05",
+			"",
+			nil,
+			nil,
+			nil,
+			pipeline.ErrTooManyErrors,
+		},
+		{
+			"both thresholds",
+			prices,
+			"",
+			"",
+			"0.05",
+			"3",
+			nil,
+			nil,
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"no threshold",
+			prices,
+			"",
+			"",
+			"",
+			"",
+			nil,
+			nil,
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"negative threshold",
+			prices,
+			"",
+			"",
+			"-0.05",
+			"",
+			nil,
+			nil,
+			nil,
+			pipeline.ErrBadInput,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.OutlierFilterTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Weights:       test.weights,
+				AllowedFaults: test.allowedFaults,
+				MaxDeviation:  test.maxDeviation,
+				MADMultiplier: test.madMultiplier,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantError != nil {
+				require.Equal(t, test.wantError, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+
+			result := output.Value.(map[string]any)
+			decimalStrings := func(vals any) (strs []string) {
+				for _, v := range vals.([]any) {
+					strs = append(strs, v.(decimal.Decimal).String())
+				}
+				return
+			}
+			assert.Equal(t, test.wantValues, decimalStrings(result["values"]))
+			assert.Equal(t, test.wantWeights, decimalStrings(result["weights"]))
+			assert.Equal(t, test.wantDiscarded, result["discarded"])
+		})
+	}
+
+	t.Run("feeds a weighted median", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+ds1    [type=memo value="100"];
+ds2    [type=memo value="101"];
+ds3    [type=memo value="250"];
+filter [type=outlierfilter weights="[1, 3, 1]" maxDeviation="0.1"];
+answer [type=weightedmedian values="$(filter.values)" weights="$(filter.weights)"];
+
+ds1 -> filter;
+ds2 -> filter;
+ds3 -> filter;
+filter -> answer;
+`}
+		run, trrs, err := pipeline.SimulateRun(testutils.Context(t), newSimulationRunner(t), spec, pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, err)
+		require.False(t, run.HasErrors())
+
+		final := trrs.FinalResult()
+		require.Len(t, final.Values, 1)
+		assert.Equal(t, "101", final.Values[0].(decimal.Decimal).String())
+	})
+}
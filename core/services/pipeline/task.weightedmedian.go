@@ -0,0 +1,157 @@
+package pipeline
+
+import (
+	"context"
+	stderrors "errors"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// WeightedMedianTask takes the median of its values, where each value counts as
+// many times as its weight. Weights are given in the same order as the values,
+// e.g. weights="[1, 2, 1]", and default to 1.
+//
+// Like median, errored values are dropped as long as there are no more of them
+// than allowedFaults, and the result is a bare decimal, so that weightedmedian
+// can replace median without changing the tasks consuming its result. To learn
+// which inputs were dropped, aggregate the output of an outlierfilter task,
+// whose "discarded" reports them, e.g. values="$(filter.values)"
+// weights="$(filter.weights)".
+//
+// Return types:
+//
+//	decimal.Decimal
+type WeightedMedianTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Weights       string `json:"weights"`
+	AllowedFaults string `json:"allowedFaults"`
+}
+
+var _ Task = (*WeightedMedianTask)(nil)
+
+func (t *WeightedMedianTask) Type() TaskType {
+	return TaskTypeWeightedMedian
+}
+
+func (t *WeightedMedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		valuesAndErrs      SliceParam
+		weights            DecimalSliceParam
+		allowedFaults      int
+	)
+	err := stderrors.Join(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&weights, From(VarExpr(t.Weights, vars), JSONWithVarExprs(t.Weights, vars, false), nil)), "weights"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = max(len(valuesAndErrs)-1, 0)
+	}
+
+	wv, err := newWeightedValues(valuesAndErrs, weights)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if len(wv.faults) > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to weightedmedian task > number allowed faults %v", len(wv.faults), allowedFaults)}, runInfo
+	}
+
+	median, ok := weightedMedian(wv.values, wv.weights)
+	if !ok {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no values with a positive weight to medianize")}, runInfo
+	}
+	return Result{Value: median}, runInfo
+}
+
+// weightedValues are the values of an aggregation task with their weights,
+// after the faulty inputs have been set aside.
+type weightedValues struct {
+	values  []decimal.Decimal
+	weights []decimal.Decimal
+	// errors of the faulty values by their index among the task's values
+	faults map[int]error
+}
+
+func newWeightedValues(valuesAndErrs SliceParam, weights DecimalSliceParam) (weightedValues, error) {
+	if weights != nil && len(weights) != len(valuesAndErrs) {
+		return weightedValues{}, errors.Wrapf(ErrBadInput, "got %d weights for %d values", len(weights), len(valuesAndErrs))
+	}
+
+	wv := weightedValues{faults: make(map[int]error)}
+	for i, v := range valuesAndErrs {
+		if err, isErr := v.(error); isErr {
+			wv.faults[i] = err
+			continue
+		}
+
+		var value DecimalParam
+		if err := value.UnmarshalPipelineParam(v); err != nil {
+			return weightedValues{}, errors.Wrapf(ErrBadInput, "values: %v", err)
+		}
+		weight := decimal.NewFromInt(1)
+		if weights != nil {
+			weight = weights[i]
+		}
+		if weight.IsNegative() {
+			return weightedValues{}, errors.Wrapf(ErrBadInput, "weight %s of value %d is negative", weight, i)
+		}
+
+		wv.values = append(wv.values, value.Decimal())
+		wv.weights = append(wv.weights, weight)
+	}
+	return wv, nil
+}
+
+// weightedMedian returns the value at which the cumulative weight of the sorted
+// values reaches half of the total weight, or the mean of it and the next value
+// if exactly half is reached. With equal weights this is the regular median.
+// Values with a zero weight are ignored.
+func weightedMedian(values, weights []decimal.Decimal) (decimal.Decimal, bool) {
+	type weighted struct {
+		value, weight decimal.Decimal
+	}
+	var sorted []weighted
+	total := decimal.Zero
+	for i := range values {
+		if !weights[i].IsPositive() {
+			continue
+		}
+		sorted = append(sorted, weighted{values[i], weights[i]})
+		total = total.Add(weights[i])
+	}
+	if len(sorted) == 0 {
+		return decimal.Decimal{}, false
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].value.LessThan(sorted[j].value)
+	})
+	half := total.Div(decimal.NewFromInt(2))
+	cumulative := decimal.Zero
+	for i, w := range sorted {
+		cumulative = cumulative.Add(w.weight)
+		switch cumulative.Cmp(half) {
+		case 0:
+			if i+1 < len(sorted) {
+				return w.value.Add(sorted[i+1].value).Div(decimal.NewFromInt(2)), true
+			}
+			return w.value, true
+		case 1:
+			return w.value, true
+		}
+	}
+	return sorted[len(sorted)-1].value, true
+}
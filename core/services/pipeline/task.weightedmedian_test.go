@@ -0,0 +1,170 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestWeightedMedianTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		weights       string
+		allowedFaults string
+		want          pipeline.Result
+	}{
+		{
+			"no weights is the regular median",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "4")}},
+			"",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2.5")},
+		},
+		{
+			"equal weights is the regular median",
+			[]pipeline.Result{{Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"[2, 2, 2]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2")},
+		},
+		{
+			"heavy source dominates",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "10")}},
+			"[1, 1, 3]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "10")},
+		},
+		{
+			"exactly half the weight averages the neighbours",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "10")}},
+			"[1, 2, 3]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "6")},
+		},
+		{
+			"fractional weights",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "101")}, {Value: mustDecimal(t, "102")}},
+			"[0.2, 0.25, 0.55]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "102")},
+		},
+		{
+			"zero weights are ignored",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			"[0, 1, 1]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2.5")},
+		},
+		{
+			"errored inputs within allowed faults",
+			[]pipeline.Result{{Error: errors.New("")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			"[5, 1, 2]",
+			"1",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+		},
+		{
+			"more errors than allowed faults",
+			[]pipeline.Result{{Error: errors.New("")}, {Error: errors.New("")}, {Value: mustDecimal(t, "3")}},
+			"[1, 1, 1]",
+			"1",
+			pipeline.Result{Error: pipeline.ErrTooManyErrors},
+		},
+		{
+			"all weights zero",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"[0, 0]",
+			"",
+			pipeline.Result{Error: pipeline.ErrWrongInputCardinality},
+		},
+		{
+			"negative weight",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"[1, -1]",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+		{
+			"weights don't match values",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"[1, 2, 3]",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.WeightedMedianTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Weights:       test.weights,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				require.Equal(t, test.want.Value.(*decimal.Decimal).String(), output.Value.(decimal.Decimal).String())
+			}
+		})
+	}
+
+	t.Run("values and weights from vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]any{
+			"foo": map[string]any{"values": []any{"1", "2", "10"}, "weight": 3},
+		})
+		task := pipeline.WeightedMedianTask{
+			BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Values:   "$(foo.values)",
+			Weights:  "[1, 1, $(foo.weight)]",
+		}
+		output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, output.Error)
+		require.Equal(t, "10", output.Value.(decimal.Decimal).String())
+	})
+
+	t.Run("dropped inputs are reported by a preceding outlierfilter", func(t *testing.T) {
+		inputs := []pipeline.Result{{Error: errors.New("adapter down")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}}
+
+		task := pipeline.WeightedMedianTask{
+			BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Weights:       "[5, 1, 2]",
+			AllowedFaults: "1",
+		}
+		output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), inputs)
+		require.NoError(t, output.Error)
+		require.IsType(t, decimal.Decimal{}, output.Value)
+
+		filter := pipeline.OutlierFilterTask{
+			BaseTask:      pipeline.NewBaseTask(0, "filter", nil, nil, 0),
+			Weights:       "[5, 1, 2]",
+			AllowedFaults: "1",
+			MaxDeviation:  "1",
+		}
+		filtered, _ := filter.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), inputs)
+		require.NoError(t, filtered.Error)
+		assert.Equal(t, []any{map[string]any{"index": 0, "error": "adapter down"}}, filtered.Value.(map[string]any)["discarded"])
+
+		task = pipeline.WeightedMedianTask{
+			BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Values:   "$(filter.values)",
+			Weights:  "$(filter.weights)",
+		}
+		fromFilter, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]any{"filter": filtered.Value}), nil)
+		require.NoError(t, fromFilter.Error)
+		require.Equal(t, output.Value.(decimal.Decimal).String(), fromFilter.Value.(decimal.Decimal).String())
+	})
+}
@@ -0,0 +1,167 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNoRecordedResponse is returned by a ResponseReplayer for requests of a
+// task whose recorded responses are used up.
+var ErrNoRecordedResponse = errors.New("no recorded response left for task")
+
+// TaskTransport intercepts the HTTP requests made by http and bridge tasks. It
+// is attached to the context of a run with WithTaskTransport.
+type TaskTransport interface {
+	// RoundTripper returns the round tripper which sends the requests of the
+	// task with the given dot ID, in place of next.
+	RoundTripper(dotID string, next http.RoundTripper) http.RoundTripper
+}
+
+type taskTransportKey struct{}
+
+// WithTaskTransport returns a context whose runs send the requests of their
+// http and bridge tasks through tt.
+func WithTaskTransport(ctx context.Context, tt TaskTransport) context.Context {
+	return context.WithValue(ctx, taskTransportKey{}, tt)
+}
+
+func taskTransportFromContext(ctx context.Context) TaskTransport {
+	tt, _ := ctx.Value(taskTransportKey{}).(TaskTransport)
+	return tt
+}
+
+// taskHTTPClient returns client, with its transport wrapped by the task
+// transport of the context if there is one.
+func taskHTTPClient(ctx context.Context, dotID string, client *http.Client) *http.Client {
+	tt := taskTransportFromContext(ctx)
+	if tt == nil {
+		return client
+	}
+	var wrapped http.Client
+	if client != nil {
+		wrapped = *client
+	}
+	next := wrapped.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	wrapped.Transport = tt.RoundTripper(dotID, next)
+	return &wrapped
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// RecordedResponse is an HTTP response received by a task.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// RecordedResponses maps the dot ID of a task to the responses it received, in
+// order.
+type RecordedResponses map[string][]RecordedResponse
+
+// ResponseRecorder is a TaskTransport which sends requests as usual, and
+// records every response received by each task.
+type ResponseRecorder struct {
+	mu        sync.Mutex
+	responses RecordedResponses
+}
+
+var _ TaskTransport = (*ResponseRecorder)(nil)
+
+func NewResponseRecorder() *ResponseRecorder {
+	return &ResponseRecorder{responses: make(RecordedResponses)}
+}
+
+func (r *ResponseRecorder) RoundTripper(dotID string, next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		resp, err := next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.responses[dotID] = append(r.responses[dotID], RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       string(body),
+		})
+		return resp, nil
+	})
+}
+
+// Responses returns the responses recorded so far.
+func (r *ResponseRecorder) Responses() RecordedResponses {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	responses := make(RecordedResponses, len(r.responses))
+	for dotID, rs := range r.responses {
+		responses[dotID] = slices.Clone(rs)
+	}
+	return responses
+}
+
+// ResponseReplayer is a TaskTransport which never reaches the network. Each
+// request of a task is answered with the next response recorded for it.
+type ResponseReplayer struct {
+	mu        sync.Mutex
+	responses RecordedResponses
+}
+
+var _ TaskTransport = (*ResponseReplayer)(nil)
+
+func NewResponseReplayer(responses RecordedResponses) *ResponseReplayer {
+	return &ResponseReplayer{responses: maps.Clone(responses)}
+}
+
+func (r *ResponseReplayer) RoundTripper(dotID string, _ http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		r.mu.Lock()
+		queue := r.responses[dotID]
+		if len(queue) == 0 {
+			r.mu.Unlock()
+			return nil, errors.Wrapf(ErrNoRecordedResponse, "%s", dotID)
+		}
+		recorded := queue[0]
+		r.responses[dotID] = queue[1:]
+		r.mu.Unlock()
+
+		header := recorded.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	})
+}
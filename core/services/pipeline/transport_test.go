@@ -0,0 +1,73 @@
+package pipeline_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestResponseRecorder(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	defer server.Close()
+
+	recorder := pipeline.NewResponseRecorder()
+	client := &http.Client{Transport: recorder.RoundTripper("ds1", http.DefaultTransport)}
+	for _, path := range []string{"/a", "/b"} {
+		resp, err := client.Get(server.URL + path)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, path, string(body), "the response is passed on unchanged")
+	}
+
+	responses := recorder.Responses()
+	require.Len(t, responses["ds1"], 2)
+	assert.Equal(t, http.StatusAccepted, responses["ds1"][0].StatusCode)
+	assert.Equal(t, "/a", responses["ds1"][0].Body)
+	assert.Equal(t, "/b", responses["ds1"][1].Body)
+}
+
+func TestResponseReplayer(t *testing.T) {
+	t.Parallel()
+
+	replayer := pipeline.NewResponseReplayer(pipeline.RecordedResponses{
+		"ds1": {
+			{StatusCode: http.StatusOK, Header: http.Header{"X-Test": {"1"}}, Body: "first"},
+			{StatusCode: http.StatusBadGateway, Body: "second"},
+		},
+	})
+	client := &http.Client{Transport: replayer.RoundTripper("ds1", nil)}
+
+	resp, err := client.Get("https://unreachable.invalid")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "1", resp.Header.Get("X-Test"))
+	assert.Equal(t, "first", string(body))
+
+	resp, err = client.Get("https://unreachable.invalid")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+
+	_, err = client.Get("https://unreachable.invalid")
+	require.ErrorIs(t, err, pipeline.ErrNoRecordedResponse)
+
+	other := &http.Client{Transport: replayer.RoundTripper("ds2", nil)}
+	_, err = other.Get("https://unreachable.invalid")
+	require.ErrorIs(t, err, pipeline.ErrNoRecordedResponse)
+}
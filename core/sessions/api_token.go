@@ -0,0 +1,140 @@
+package sessions
+
+import (
+	"crypto/subtle"
+	"database/sql/driver"
+	"time"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// DefaultAPITokenName is the name audit events give the user's own API token,
+// set with POST /v2/user/token, to tell it apart from named API tokens.
+const DefaultAPITokenName = "default"
+
+// APIToken is one of a user's named API tokens. Unlike the user's own token,
+// it expires, and its scope can restrict it to part of what the user's role
+// permits.
+type APIToken struct {
+	ID                int64
+	UserEmail         string
+	Name              string
+	Scope             Scope
+	TokenKey          string
+	TokenSalt         string
+	TokenHashedSecret string
+	ExpiresAt         time.Time
+	CreatedAt         time.Time
+}
+
+// CreateAPITokenRequest is sent to create a named API token.
+type CreateAPITokenRequest struct {
+	Password  string    `json:"password"`
+	Name      string    `json:"name"`
+	Scope     []string  `json:"scope"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// NewAPIToken validates the request and returns a new named API token for
+// email, along with the token to hand out, which is not stored.
+func NewAPIToken(email string, req CreateAPITokenRequest) (APIToken, *auth.Token, error) {
+	if req.Name == "" {
+		return APIToken{}, nil, pkgerrors.New("API token name must not be empty")
+	}
+	if req.Name == DefaultAPITokenName {
+		return APIToken{}, nil, pkgerrors.Errorf("API token name %q is reserved for the user's own token", DefaultAPITokenName)
+	}
+	if !req.ExpiresAt.After(time.Now()) {
+		return APIToken{}, nil, pkgerrors.New("API token must expire in the future")
+	}
+	var scope Scope
+	for _, s := range req.Scope {
+		p, err := ParsePermission(s)
+		if err != nil {
+			return APIToken{}, nil, err
+		}
+		scope = append(scope, p)
+	}
+
+	token := auth.NewToken()
+	salt := utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(token, salt)
+	if err != nil {
+		return APIToken{}, nil, pkgerrors.Wrap(err, "API token")
+	}
+	return APIToken{
+		UserEmail:         email,
+		Name:              req.Name,
+		Scope:             scope,
+		TokenKey:          token.AccessKey,
+		TokenSalt:         salt,
+		TokenHashedSecret: hashedSecret,
+		ExpiresAt:         req.ExpiresAt,
+	}, token, nil
+}
+
+// Authenticate returns true if token is this API token and it has not expired.
+func (t APIToken) Authenticate(token *auth.Token) (bool, error) {
+	if !t.ExpiresAt.After(time.Now()) {
+		return false, nil
+	}
+	hashedSecret, err := auth.HashedSecret(token, t.TokenSalt)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(t.TokenHashedSecret)) == 1, nil
+}
+
+// Scope restricts an API token to the actions its permissions allow. An empty
+// scope places no restriction beyond the user's role.
+type Scope []Permission
+
+// Allows reports whether the scope permits action on the object id of
+// resource.
+func (s Scope) Allows(resource Resource, id string, action Action) bool {
+	if len(s) == 0 {
+		return true
+	}
+	for _, p := range s {
+		if p.Allows(resource, id, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// Strings returns the permissions of the scope in their text form.
+func (s Scope) Strings() []string {
+	strs := make([]string, len(s))
+	for i, p := range s {
+		strs[i] = p.String()
+	}
+	return strs
+}
+
+// Value implements driver.Valuer, storing the scope as a text array.
+func (s Scope) Value() (driver.Value, error) {
+	return pq.StringArray(s.Strings()).Value()
+}
+
+// Scan implements sql.Scanner.
+func (s *Scope) Scan(value any) error {
+	var strs pq.StringArray
+	if err := strs.Scan(value); err != nil {
+		return err
+	}
+	scope := make(Scope, len(strs))
+	for i, str := range strs {
+		p, err := ParsePermission(str)
+		if err != nil {
+			return err
+		}
+		scope[i] = p
+	}
+	*s = scope
+	return nil
+}
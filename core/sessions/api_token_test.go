@@ -0,0 +1,68 @@
+package sessions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestNewAPIToken(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Now().Add(time.Hour)
+	for _, tt := range []struct {
+		name    string
+		req     sessions.CreateAPITokenRequest
+		wantErr string
+	}{
+		{"empty name", sessions.CreateAPITokenRequest{ExpiresAt: expiresAt}, "must not be empty"},
+		{"reserved name", sessions.CreateAPITokenRequest{Name: sessions.DefaultAPITokenName, ExpiresAt: expiresAt}, "is reserved"},
+		{"no expiry", sessions.CreateAPITokenRequest{Name: "ci"}, "must expire in the future"},
+		{"bad scope", sessions.CreateAPITokenRequest{Name: "ci", Scope: []string{"jobs:read"}, ExpiresAt: expiresAt}, `unknown action "read"`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := sessions.NewAPIToken("a@b.c", tt.req)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	apiToken, token, err := sessions.NewAPIToken("a@b.c", sessions.CreateAPITokenRequest{Name: "ci", Scope: []string{"jobs:run:42"}, ExpiresAt: expiresAt})
+	require.NoError(t, err)
+	assert.Equal(t, token.AccessKey, apiToken.TokenKey)
+	assert.Equal(t, []string{"jobs:run:42"}, apiToken.Scope.Strings())
+
+	ok, err := apiToken.Authenticate(token)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = apiToken.Authenticate(&auth.Token{AccessKey: token.AccessKey, Secret: "wrong"})
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	apiToken.ExpiresAt = time.Now().Add(-time.Second)
+	ok, err = apiToken.Authenticate(token)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestScope(t *testing.T) {
+	t.Parallel()
+
+	var unrestricted sessions.Scope
+	assert.True(t, unrestricted.Allows(sessions.ResourceKeys, "", sessions.ActionAdmin))
+
+	var scope sessions.Scope
+	require.NoError(t, scope.Scan([]byte(`{jobs:view,bridges:edit}`)))
+	assert.True(t, scope.Allows(sessions.ResourceJobs, "1", sessions.ActionView))
+	assert.False(t, scope.Allows(sessions.ResourceJobs, "1", sessions.ActionRun))
+	assert.True(t, scope.Allows(sessions.ResourceBridges, "", sessions.ActionEdit))
+	assert.False(t, scope.Allows(sessions.ResourceKeys, "", sessions.ActionView))
+
+	value, err := scope.Value()
+	require.NoError(t, err)
+	assert.Equal(t, `{"jobs:view","bridges:edit"}`, value)
+}
@@ -56,9 +56,16 @@ type AuthenticationProvider interface {
 	SetAuthToken(ctx context.Context, user *User, token *auth.Token) error
 	CreateAndSetAuthToken(ctx context.Context, user *User) (*auth.Token, error)
 	DeleteAuthToken(ctx context.Context, user *User) error
+	CreateAPIToken(ctx context.Context, token *APIToken) error
+	ListAPITokens(ctx context.Context, email string) ([]APIToken, error)
+	DeleteAPIToken(ctx context.Context, email, name string) error
+	FindUserByNamedAPIToken(ctx context.Context, apiToken string) (User, APIToken, error)
 	SetPassword(ctx context.Context, user *User, newPassword string) error
 	TestPassword(ctx context.Context, email, password string) error
 	Sessions(ctx context.Context, offset, limit int) ([]Session, error)
+	ListSessions(ctx context.Context, email string) ([]Session, error)
+	RevokeSession(ctx context.Context, publicID string) error
+	RevokeUserSessions(ctx context.Context, email string) error
 	GetUserWebAuthn(ctx context.Context, email string) ([]WebAuthn, error)
 	SaveWebAuthn(ctx context.Context, token *WebAuthn) error
 	ExtendRouter(r *gin.RouterGroup) error
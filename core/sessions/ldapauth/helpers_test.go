@@ -34,8 +34,22 @@ const (
 	NodeEditorsGroupCN  = "NodeEditors"
 	NodeRunnersGroupCN  = "NodeRunners"
 	NodeReadOnlyGroupCN = "NodeReadOnly"
+
+	NodeBridgeOperatorsGroupCN = "NodeBridgeOperators"
 )
 
+// Implements config.WebServerRole
+type TestRole struct {
+	RoleName        string
+	RolePermissions []string
+	GroupCN         string
+}
+
+func (r *TestRole) Name() string          { return r.RoleName }
+func (r *TestRole) Permissions() []string { return r.RolePermissions }
+func (r *TestRole) LDAPGroupCN() string   { return r.GroupCN }
+func (r *TestRole) OIDCClaim() string     { return "" }
+
 // Implement a setter function within the _test file so that the ldapauth_test module can set the unexported field with a mock
 func (l *ldapAuthenticator) SetLDAPClient(newClient LDAPClient) {
 	l.ldapClient = newClient
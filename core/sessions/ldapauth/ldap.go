@@ -57,6 +57,7 @@ type ldapAuthenticator struct {
 	ds          sqlutil.DataSource
 	ldapClient  LDAPClient
 	config      config.LDAP
+	roles       sessions.Roles
 	lggr        logger.Logger
 	auditLogger audit.AuditLogger
 }
@@ -67,6 +68,7 @@ var _ sessions.AuthenticationProvider = (*ldapAuthenticator)(nil)
 func NewLDAPAuthenticator(
 	ds sqlutil.DataSource,
 	ldapCfg config.LDAP,
+	roles sessions.Roles,
 	dev bool,
 	lggr logger.Logger,
 	auditLogger audit.AuditLogger,
@@ -92,6 +94,7 @@ func NewLDAPAuthenticator(
 		ds:          ds,
 		ldapClient:  newLDAPClient(ldapCfg),
 		config:      ldapCfg,
+		roles:       roles,
 		lggr:        lggr.Named("LDAPAuthenticationProvider"),
 		auditLogger: auditLogger,
 	}
@@ -266,6 +269,19 @@ func (l *ldapAuthenticator) ListUsers(ctx context.Context) ([]sessions.User, err
 		l.lggr.Error("error in ldapGroupMembersListToUser: ", err)
 		return users, errors.New("unable to list group users")
 	}
+	// Query for list of uniqueMember IDs present in custom role groups
+	var customUsers []sessions.User
+	for _, role := range l.roles.Custom() {
+		if role.LDAPGroupCN == "" {
+			continue
+		}
+		roleUsers, err := l.ldapGroupMembersListToUser(conn, role.LDAPGroupCN, role.Name)
+		if err != nil {
+			l.lggr.Error("error in ldapGroupMembersListToUser: ", err)
+			return users, errors.New("unable to list group users")
+		}
+		customUsers = append(customUsers, roleUsers...)
+	}
 	// Query for list of uniqueMember IDs present in Read group
 	readUsers, err := l.ldapGroupMembersListToUser(conn, l.config.ReadUserGroupCN(), sessions.UserRoleView)
 	if err != nil {
@@ -277,6 +293,7 @@ func (l *ldapAuthenticator) ListUsers(ctx context.Context) ([]sessions.User, err
 	users = append(users, adminUsers...)
 	users = append(users, editUsers...)
 	users = append(users, runUsers...)
+	users = append(users, customUsers...)
 	users = append(users, readUsers...)
 
 	// Dedupe preserving order of highest role
@@ -366,6 +383,9 @@ func (l *ldapAuthenticator) AuthorizedUserWithSession(ctx context.Context, sessi
 		}
 		return sessions.User{}, sessions.ErrUserSessionExpired
 	}
+	if _, err := l.ds.ExecContext(ctx, "UPDATE ldap_sessions SET last_used = now() WHERE id = $1", sessionID); err != nil {
+		return sessions.User{}, err
+	}
 	return sessions.User{
 		Email: foundSession.UserEmail,
 		Role:  foundSession.UserRole,
@@ -440,11 +460,13 @@ func (l *ldapAuthenticator) CreateSession(ctx context.Context, sr sessions.Sessi
 	session := sessions.NewSession()
 	_, err = l.ds.ExecContext(
 		ctx,
-		"INSERT INTO ldap_sessions (id, user_email, user_role, localauth_user, created_at) VALUES ($1, $2, $3, $4, now())",
+		"INSERT INTO ldap_sessions (id, user_email, user_role, localauth_user, ip_address, user_agent, created_at) VALUES ($1, $2, $3, $4, $5, $6, now())",
 		session.ID,
 		strings.ToLower(sr.Email),
 		foundUser.Role,
 		isLocalUser,
+		sr.IPAddress,
+		sr.UserAgent,
 	)
 	if err != nil {
 		l.lggr.Errorf("unable to create new session in ldap_sessions table %v", err)
@@ -593,6 +615,26 @@ func (l *ldapAuthenticator) DeleteAuthToken(ctx context.Context, user *sessions.
 	return err
 }
 
+// CreateAPIToken is not supported, as named API tokens are stored with local users
+func (l *ldapAuthenticator) CreateAPIToken(ctx context.Context, token *sessions.APIToken) error {
+	return sessions.ErrNotSupported
+}
+
+// ListAPITokens is not supported, as named API tokens are stored with local users
+func (l *ldapAuthenticator) ListAPITokens(ctx context.Context, email string) ([]sessions.APIToken, error) {
+	return nil, sessions.ErrNotSupported
+}
+
+// DeleteAPIToken is not supported, as named API tokens are stored with local users
+func (l *ldapAuthenticator) DeleteAPIToken(ctx context.Context, email, name string) error {
+	return sessions.ErrNotSupported
+}
+
+// FindUserByNamedAPIToken is not supported, as named API tokens are stored with local users
+func (l *ldapAuthenticator) FindUserByNamedAPIToken(ctx context.Context, apiToken string) (sessions.User, sessions.APIToken, error) {
+	return sessions.User{}, sessions.APIToken{}, sessions.ErrNotSupported
+}
+
 // SaveWebAuthn is not supported for read only LDAP
 func (l *ldapAuthenticator) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error {
 	return sessions.ErrNotSupported
@@ -601,13 +643,45 @@ func (l *ldapAuthenticator) SaveWebAuthn(ctx context.Context, token *sessions.We
 // Sessions returns all sessions limited by the parameters.
 func (l *ldapAuthenticator) Sessions(ctx context.Context, offset, limit int) ([]sessions.Session, error) {
 	var sessions []sessions.Session
-	sql := `SELECT * FROM ldap_sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
+	sql := `SELECT id, user_email AS email, ip_address, user_agent, last_used, created_at FROM ldap_sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
 	if err := l.ds.SelectContext(ctx, &sessions, sql, limit, offset); err != nil {
 		return sessions, nil
 	}
 	return sessions, nil
 }
 
+// ListSessions returns the unexpired sessions of a user, or of all users if email is empty, most recently used first.
+func (l *ldapAuthenticator) ListSessions(ctx context.Context, email string) ([]sessions.Session, error) {
+	var ss []sessions.Session
+	sql := `SELECT id, user_email AS email, ip_address, user_agent, last_used, created_at FROM ldap_sessions
+	WHERE created_at + $1 >= now() AND ($2::text = '' OR user_email = lower($2))
+	ORDER BY last_used DESC, id`
+	err := l.ds.SelectContext(ctx, &ss, sql, l.config.SessionTimeout().Duration(), email)
+	return ss, err
+}
+
+// RevokeSession deletes a ldap_sessions entry by its public ID, see sessions.Session.PublicID.
+func (l *ldapAuthenticator) RevokeSession(ctx context.Context, publicID string) error {
+	result, err := l.ds.ExecContext(ctx, "DELETE FROM ldap_sessions WHERE "+sessions.PublicSessionIDSQL+" = $1", publicID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// RevokeUserSessions deletes all ldap_sessions entries of a user.
+func (l *ldapAuthenticator) RevokeUserSessions(ctx context.Context, email string) error {
+	_, err := l.ds.ExecContext(ctx, "DELETE FROM ldap_sessions WHERE user_email = lower($1)", email)
+	return err
+}
+
 // FindExternalInitiator supports the 'Run' role external intiator header auth functionality
 func (l *ldapAuthenticator) FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error) {
 	exi := &bridges.ExternalInitiator{}
@@ -771,6 +845,7 @@ func ldapGroupMembersListToUser(
 func (l *ldapAuthenticator) groupSearchResultsToUserRole(ldapGroups []*ldap.Entry) (sessions.UserRole, error) {
 	return GroupSearchResultsToUserRole(
 		ldapGroups,
+		l.roles,
 		l.config.AdminUserGroupCN(),
 		l.config.EditUserGroupCN(),
 		l.config.RunUserGroupCN(),
@@ -778,33 +853,18 @@ func (l *ldapAuthenticator) groupSearchResultsToUserRole(ldapGroups []*ldap.Entr
 	)
 }
 
-func GroupSearchResultsToUserRole(ldapGroups []*ldap.Entry, adminCN string, editCN string, runCN string, readCN string) (sessions.UserRole, error) {
-	// If defined Admin group name is present in groups search result, return UserRoleAdmin
-	for _, group := range ldapGroups {
-		if group.GetAttributeValue("cn") == adminCN {
-			return sessions.UserRoleAdmin, nil
-		}
+func GroupSearchResultsToUserRole(ldapGroups []*ldap.Entry, roles sessions.Roles, adminCN string, editCN string, runCN string, readCN string) (sessions.UserRole, error) {
+	groups := make([]string, len(ldapGroups))
+	for i, group := range ldapGroups {
+		groups[i] = group.GetAttributeValue("cn")
 	}
-	// Check edit role
-	for _, group := range ldapGroups {
-		if group.GetAttributeValue("cn") == editCN {
-			return sessions.UserRoleEdit, nil
-		}
-	}
-	// Check run role
-	for _, group := range ldapGroups {
-		if group.GetAttributeValue("cn") == runCN {
-			return sessions.UserRoleRun, nil
-		}
-	}
-	// Check view role
-	for _, group := range ldapGroups {
-		if group.GetAttributeValue("cn") == readCN {
-			return sessions.UserRoleView, nil
-		}
+	// Take the highest built-in role, or else the first matching custom role, before falling back to view
+	role, ok := roles.FindRole(groups, adminCN, editCN, runCN, readCN, func(r sessions.Role) string { return r.LDAPGroupCN })
+	if !ok {
+		// No role group found, error
+		return sessions.UserRoleView, ErrUserNoLDAPGroups
 	}
-	// No role group found, error
-	return sessions.UserRoleView, ErrUserNoLDAPGroups
+	return role, nil
 }
 
 const constantTimeEmailLength = 256
@@ -13,6 +13,7 @@ import (
 
 	"github.com/jmoiron/sqlx"
 
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
@@ -528,6 +529,10 @@ func TestORM_MapSearchGroups(t *testing.T) {
 	t.Parallel()
 
 	cfg := ldapauth.TestConfig{}
+	roles, err := sessions.NewRoles([]config.WebServerRole{
+		&ldapauth.TestRole{RoleName: "bridge-operator", RolePermissions: []string{"bridges:edit"}, GroupCN: ldapauth.NodeBridgeOperatorsGroupCN},
+	})
+	require.NoError(t, err)
 
 	tests := []struct {
 		name                    string
@@ -599,6 +604,56 @@ func TestORM_MapSearchGroups(t *testing.T) {
 			sessions.UserRoleView,
 			nil,
 		},
+		{
+			"user in custom role and view groups",
+			[]*ldap.Entry{
+				{
+					DN: fmt.Sprintf("cn=%s,ou=Groups,dc=example,dc=com", ldapauth.NodeReadOnlyGroupCN),
+					Attributes: []*ldap.EntryAttribute{
+						{
+							Name:   "cn",
+							Values: []string{ldapauth.NodeReadOnlyGroupCN},
+						},
+					},
+				},
+				{
+					DN: fmt.Sprintf("cn=%s,ou=Groups,dc=example,dc=com", ldapauth.NodeBridgeOperatorsGroupCN),
+					Attributes: []*ldap.EntryAttribute{
+						{
+							Name:   "cn",
+							Values: []string{ldapauth.NodeBridgeOperatorsGroupCN},
+						},
+					},
+				},
+			},
+			sessions.UserRole("bridge-operator"),
+			nil,
+		},
+		{
+			"user in custom role and run groups",
+			[]*ldap.Entry{
+				{
+					DN: fmt.Sprintf("cn=%s,ou=Groups,dc=example,dc=com", ldapauth.NodeBridgeOperatorsGroupCN),
+					Attributes: []*ldap.EntryAttribute{
+						{
+							Name:   "cn",
+							Values: []string{ldapauth.NodeBridgeOperatorsGroupCN},
+						},
+					},
+				},
+				{
+					DN: fmt.Sprintf("cn=%s,ou=Groups,dc=example,dc=com", ldapauth.NodeRunnersGroupCN),
+					Attributes: []*ldap.EntryAttribute{
+						{
+							Name:   "cn",
+							Values: []string{ldapauth.NodeRunnersGroupCN},
+						},
+					},
+				},
+			},
+			sessions.UserRoleRun,
+			nil,
+		},
 		{
 			"user in none",
 			[]*ldap.Entry{},
@@ -636,6 +691,7 @@ func TestORM_MapSearchGroups(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			role, err := ldapauth.GroupSearchResultsToUserRole(
 				test.groupsQuerySearchResult,
+				roles,
 				cfg.AdminUserGroupCN(),
 				cfg.EditUserGroupCN(),
 				cfg.RunUserGroupCN(),
@@ -20,6 +20,7 @@ type LDAPServerStateSyncer struct {
 	ds           sqlutil.DataSource
 	ldapClient   LDAPClient
 	config       config.LDAP
+	roles        sessions.Roles
 	lggr         logger.Logger
 	nextSyncTime time.Time
 	done         chan struct{}
@@ -30,12 +31,14 @@ type LDAPServerStateSyncer struct {
 func NewLDAPServerStateSyncer(
 	ds sqlutil.DataSource,
 	config config.LDAP,
+	roles sessions.Roles,
 	lggr logger.Logger,
 ) *LDAPServerStateSyncer {
 	return &LDAPServerStateSyncer{
 		ds:         ds,
 		ldapClient: newLDAPClient(config),
 		config:     config,
+		roles:      roles,
 		lggr:       lggr.Named("LDAPServerStateSync"),
 		done:       make(chan struct{}),
 		stopCh:     make(services.StopChan),
@@ -147,6 +150,19 @@ func (l *LDAPServerStateSyncer) Work(ctx context.Context) {
 		l.lggr.Error("Error in ldapGroupMembersListToUser: ", err)
 		return
 	}
+	// Query for list of uniqueMember IDs present in custom role groups
+	var customUsers []sessions.User
+	for _, role := range l.roles.Custom() {
+		if role.LDAPGroupCN == "" {
+			continue
+		}
+		roleUsers, err := l.ldapGroupMembersListToUser(conn, role.LDAPGroupCN, role.Name)
+		if err != nil {
+			l.lggr.Error("Error in ldapGroupMembersListToUser: ", err)
+			return
+		}
+		customUsers = append(customUsers, roleUsers...)
+	}
 	// Query for list of uniqueMember IDs present in Edit group
 	readUsers, err := l.ldapGroupMembersListToUser(conn, l.config.ReadUserGroupCN(), sessions.UserRoleView)
 	if err != nil {
@@ -157,6 +173,7 @@ func (l *LDAPServerStateSyncer) Work(ctx context.Context) {
 	users = append(users, adminUsers...)
 	users = append(users, editUsers...)
 	users = append(users, runUsers...)
+	users = append(users, customUsers...)
 	users = append(users, readUsers...)
 
 	// Dedupe preserving order of highest role (sorted)
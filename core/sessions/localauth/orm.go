@@ -3,6 +3,7 @@ package localauth
 import (
 	"context"
 	"crypto/subtle"
+	"database/sql"
 	"encoding/json"
 	"strings"
 	"time"
@@ -23,6 +24,7 @@ import (
 type orm struct {
 	ds              sqlutil.DataSource
 	sessionDuration time.Duration
+	roles           sessions.Roles
 	lggr            logger.Logger
 	auditLogger     audit.AuditLogger
 }
@@ -31,10 +33,11 @@ type orm struct {
 var _ sessions.AuthenticationProvider = (*orm)(nil)
 var _ sessions.BasicAdminUsersORM = (*orm)(nil)
 
-func NewORM(ds sqlutil.DataSource, sd time.Duration, lggr logger.Logger, auditLogger audit.AuditLogger) sessions.AuthenticationProvider {
+func NewORM(ds sqlutil.DataSource, sd time.Duration, roles sessions.Roles, lggr logger.Logger, auditLogger audit.AuditLogger) sessions.AuthenticationProvider {
 	return &orm{
 		ds:              ds,
 		sessionDuration: sd,
+		roles:           roles,
 		lggr:            lggr.Named("LocalAuthAuthenticationProviderORM"),
 		auditLogger:     auditLogger,
 	}
@@ -173,7 +176,7 @@ func (o *orm) CreateSession(ctx context.Context, sr sessions.SessionRequest) (st
 	if len(uwas) == 0 {
 		lggr.Infof("No MFA for user. Creating Session")
 		session := sessions.NewSession()
-		_, err = o.ds.ExecContext(ctx, "INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
+		_, err = o.ds.ExecContext(ctx, "INSERT INTO sessions (id, email, ip_address, user_agent, last_used, created_at) VALUES ($1, $2, $3, $4, now(), now())", session.ID, user.Email, sr.IPAddress, sr.UserAgent)
 		o.auditLogger.Audit(audit.AuthLoginSuccessNo2FA, map[string]any{"email": sr.Email})
 		return session.ID, err
 	}
@@ -213,7 +216,7 @@ func (o *orm) CreateSession(ctx context.Context, sr sessions.SessionRequest) (st
 	lggr.Infof("User passed MFA authentication and login will proceed")
 	// This is a success so we can create the sessions
 	session := sessions.NewSession()
-	_, err = o.ds.ExecContext(ctx, "INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
+	_, err = o.ds.ExecContext(ctx, "INSERT INTO sessions (id, email, ip_address, user_agent, last_used, created_at) VALUES ($1, $2, $3, $4, now(), now())", session.ID, user.Email, sr.IPAddress, sr.UserAgent)
 	if err != nil {
 		return "", err
 	}
@@ -267,7 +270,7 @@ func (o *orm) UpdateRole(ctx context.Context, email, newRole string) (sessions.U
 		}
 
 		// Patch validated role
-		userRole, err := sessions.GetUserRole(newRole)
+		userRole, err := o.roles.GetUserRole(newRole)
 		if err != nil {
 			return err
 		}
@@ -341,6 +344,47 @@ func (o *orm) DeleteAuthToken(ctx context.Context, user *sessions.User) error {
 	return o.ds.GetContext(ctx, user, sql, user.Email)
 }
 
+// CreateAPIToken stores a new named API token, which must be unique by name for the user.
+func (o *orm) CreateAPIToken(ctx context.Context, token *sessions.APIToken) error {
+	sql := `INSERT INTO user_api_tokens (user_email, name, scope, token_key, token_salt, token_hashed_secret, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, now()) RETURNING id, created_at`
+	err := o.ds.QueryRowxContext(ctx, sql, token.UserEmail, token.Name, token.Scope, token.TokenKey, token.TokenSalt,
+		token.TokenHashedSecret, token.ExpiresAt).Scan(&token.ID, &token.CreatedAt)
+	return pkgerrors.Wrap(err, "failed to create API token")
+}
+
+// ListAPITokens returns the named API tokens of a user, including expired ones.
+func (o *orm) ListAPITokens(ctx context.Context, email string) (tokens []sessions.APIToken, err error) {
+	sql := "SELECT * FROM user_api_tokens WHERE lower(user_email) = lower($1) ORDER BY name"
+	err = o.ds.SelectContext(ctx, &tokens, sql, email)
+	return
+}
+
+// DeleteAPIToken revokes a named API token of a user.
+func (o *orm) DeleteAPIToken(ctx context.Context, email, name string) error {
+	result, err := o.ds.ExecContext(ctx, "DELETE FROM user_api_tokens WHERE lower(user_email) = lower($1) AND name = $2", email, name)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// FindUserByNamedAPIToken returns an unexpired named API token by its key, along with its user.
+func (o *orm) FindUserByNamedAPIToken(ctx context.Context, apiToken string) (user sessions.User, token sessions.APIToken, err error) {
+	if err = o.ds.GetContext(ctx, &token, "SELECT * FROM user_api_tokens WHERE token_key = $1 AND expires_at > now()", apiToken); err != nil {
+		return
+	}
+	err = o.ds.GetContext(ctx, &user, "SELECT * FROM users WHERE email = $1", token.UserEmail)
+	return
+}
+
 // SaveWebAuthn saves new WebAuthn token information.
 func (o *orm) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error {
 	sql := "INSERT INTO web_authns (email, public_key_data) VALUES ($1, $2)"
@@ -357,6 +401,36 @@ func (o *orm) Sessions(ctx context.Context, offset, limit int) (sessions []sessi
 	return
 }
 
+// ListSessions returns the unexpired sessions of a user, or of all users if email is empty, most recently used first.
+func (o *orm) ListSessions(ctx context.Context, email string) (ss []sessions.Session, err error) {
+	sql := `SELECT * FROM sessions WHERE last_used + $1 >= now() AND ($2::text = '' OR lower(email) = lower($2))
+	ORDER BY last_used DESC, id`
+	err = o.ds.SelectContext(ctx, &ss, sql, o.sessionDuration, email)
+	return
+}
+
+// RevokeSession deletes a session by its public ID, see sessions.Session.PublicID.
+func (o *orm) RevokeSession(ctx context.Context, publicID string) error {
+	result, err := o.ds.ExecContext(ctx, "DELETE FROM sessions WHERE "+sessions.PublicSessionIDSQL+" = $1", publicID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// RevokeUserSessions deletes all sessions of a user.
+func (o *orm) RevokeUserSessions(ctx context.Context, email string) error {
+	_, err := o.ds.ExecContext(ctx, "DELETE FROM sessions WHERE lower(email) = lower($1)", email)
+	return err
+}
+
 // NOTE: this is duplicated from the bridges ORM to appease the AuthStorer interface
 func (o *orm) FindExternalInitiator(
 	ctx context.Context,
@@ -1,6 +1,7 @@
 package localauth_test
 
 import (
+	"database/sql"
 	"encoding/json"
 	"testing"
 	"time"
@@ -28,7 +29,7 @@ func setupORM(t *testing.T) (*sqlx.DB, sessions.AuthenticationProvider) {
 	t.Helper()
 
 	db := pgtest.NewSqlxDB(t)
-	orm := localauth.NewORM(db, time.Minute, logger.TestLogger(t), &audit.AuditLoggerService{})
+	orm := localauth.NewORM(db, time.Minute, sessions.Roles{}, logger.TestLogger(t), &audit.AuditLoggerService{})
 
 	return db, orm
 }
@@ -71,7 +72,7 @@ func TestORM_AuthorizedUserWithSession(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			ctx := testutils.Context(t)
 			db := pgtest.NewSqlxDB(t)
-			orm := localauth.NewORM(db, test.sessionDuration, logger.TestLogger(t), &audit.AuditLoggerService{})
+			orm := localauth.NewORM(db, test.sessionDuration, sessions.Roles{}, logger.TestLogger(t), &audit.AuditLoggerService{})
 
 			user := cltest.MustRandomUser(t)
 			require.NoError(t, orm.CreateUser(ctx, &user))
@@ -158,6 +159,48 @@ func TestORM_DeleteUserCascade(t *testing.T) {
 	require.Empty(t, sessions)
 }
 
+func TestORM_ListAndRevokeSessions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db, orm := setupORM(t)
+	u1 := cltest.MustRandomUser(t)
+	u2 := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(ctx, &u1))
+	require.NoError(t, orm.CreateUser(ctx, &u2))
+
+	id1, err := orm.CreateSession(ctx, sessions.SessionRequest{Email: u1.Email, Password: cltest.Password, IPAddress: "10.0.0.1", UserAgent: "curl/8.0"})
+	require.NoError(t, err)
+	_, err = orm.CreateSession(ctx, sessions.SessionRequest{Email: u2.Email, Password: cltest.Password})
+	require.NoError(t, err)
+	_, err = orm.CreateSession(ctx, sessions.SessionRequest{Email: u2.Email, Password: cltest.Password})
+	require.NoError(t, err)
+	expired := sessions.NewSession()
+	_, err = db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now() - interval '1 hour', now())", expired.ID, u1.Email)
+	require.NoError(t, err)
+
+	all, err := orm.ListSessions(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	ss, err := orm.ListSessions(ctx, u1.Email)
+	require.NoError(t, err)
+	require.Len(t, ss, 1)
+	assert.Equal(t, id1, ss[0].ID)
+	assert.Equal(t, "10.0.0.1", ss[0].IPAddress)
+	assert.Equal(t, "curl/8.0", ss[0].UserAgent)
+
+	require.NoError(t, orm.RevokeSession(ctx, ss[0].PublicID()))
+	require.ErrorIs(t, orm.RevokeSession(ctx, ss[0].PublicID()), sql.ErrNoRows)
+	_, err = orm.AuthorizedUserWithSession(ctx, id1)
+	require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+
+	require.NoError(t, orm.RevokeUserSessions(ctx, u2.Email))
+	ss, err = orm.ListSessions(ctx, u2.Email)
+	require.NoError(t, err)
+	assert.Empty(t, ss)
+}
+
 func TestORM_CreateSession(t *testing.T) {
 	t.Parallel()
 
@@ -309,3 +352,40 @@ func TestOrm_GenerateAuthToken(t *testing.T) {
 	assert.Empty(t, dbUser.TokenSalt.ValueOrZero())
 	assert.Empty(t, dbUser.TokenHashedSecret.ValueOrZero())
 }
+
+func TestORM_APITokens(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	_, orm := setupORM(t)
+
+	u := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(ctx, &u))
+
+	apiToken, token, err := sessions.NewAPIToken(u.Email, sessions.CreateAPITokenRequest{
+		Name:      "ci",
+		Scope:     []string{"jobs:view", "jobs:run:42"},
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateAPIToken(ctx, &apiToken))
+	assert.NotZero(t, apiToken.ID)
+	require.Error(t, orm.CreateAPIToken(ctx, &apiToken), "names are unique per user")
+
+	user, found, err := orm.FindUserByNamedAPIToken(ctx, token.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, u.Email, user.Email)
+	assert.Equal(t, apiToken.Scope, found.Scope)
+	ok, err := found.Authenticate(token)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	tokens, err := orm.ListAPITokens(ctx, u.Email)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "ci", tokens[0].Name)
+
+	require.NoError(t, orm.DeleteAPIToken(ctx, u.Email, "ci"))
+	require.ErrorIs(t, orm.DeleteAPIToken(ctx, u.Email, "ci"), sql.ErrNoRows)
+	_, _, err = orm.FindUserByNamedAPIToken(ctx, token.AccessKey)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -132,6 +132,53 @@ func (_c *AuthenticationProvider_ClearNonCurrentSessions_Call) RunAndReturn(run
 	return _c
 }
 
+// CreateAPIToken provides a mock function with given fields: ctx, token
+func (_m *AuthenticationProvider) CreateAPIToken(ctx context.Context, token *sessions.APIToken) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sessions.APIToken) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationProvider_CreateAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIToken'
+type AuthenticationProvider_CreateAPIToken_Call struct {
+	*mock.Call
+}
+
+// CreateAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *sessions.APIToken
+func (_e *AuthenticationProvider_Expecter) CreateAPIToken(ctx interface{}, token interface{}) *AuthenticationProvider_CreateAPIToken_Call {
+	return &AuthenticationProvider_CreateAPIToken_Call{Call: _e.mock.On("CreateAPIToken", ctx, token)}
+}
+
+func (_c *AuthenticationProvider_CreateAPIToken_Call) Run(run func(ctx context.Context, token *sessions.APIToken)) *AuthenticationProvider_CreateAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*sessions.APIToken))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_CreateAPIToken_Call) Return(_a0 error) *AuthenticationProvider_CreateAPIToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthenticationProvider_CreateAPIToken_Call) RunAndReturn(run func(context.Context, *sessions.APIToken) error) *AuthenticationProvider_CreateAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAndSetAuthToken provides a mock function with given fields: ctx, user
 func (_m *AuthenticationProvider) CreateAndSetAuthToken(ctx context.Context, user *sessions.User) (*auth.Token, error) {
 	ret := _m.Called(ctx, user)
@@ -295,6 +342,54 @@ func (_c *AuthenticationProvider_CreateUser_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// DeleteAPIToken provides a mock function with given fields: ctx, email, name
+func (_m *AuthenticationProvider) DeleteAPIToken(ctx context.Context, email string, name string) error {
+	ret := _m.Called(ctx, email, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAPIToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, email, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationProvider_DeleteAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAPIToken'
+type AuthenticationProvider_DeleteAPIToken_Call struct {
+	*mock.Call
+}
+
+// DeleteAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - name string
+func (_e *AuthenticationProvider_Expecter) DeleteAPIToken(ctx interface{}, email interface{}, name interface{}) *AuthenticationProvider_DeleteAPIToken_Call {
+	return &AuthenticationProvider_DeleteAPIToken_Call{Call: _e.mock.On("DeleteAPIToken", ctx, email, name)}
+}
+
+func (_c *AuthenticationProvider_DeleteAPIToken_Call) Run(run func(ctx context.Context, email string, name string)) *AuthenticationProvider_DeleteAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_DeleteAPIToken_Call) Return(_a0 error) *AuthenticationProvider_DeleteAPIToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthenticationProvider_DeleteAPIToken_Call) RunAndReturn(run func(context.Context, string, string) error) *AuthenticationProvider_DeleteAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteAuthToken provides a mock function with given fields: ctx, user
 func (_m *AuthenticationProvider) DeleteAuthToken(ctx context.Context, user *sessions.User) error {
 	ret := _m.Called(ctx, user)
@@ -655,6 +750,70 @@ func (_c *AuthenticationProvider_FindUserByAPIToken_Call) RunAndReturn(run func(
 	return _c
 }
 
+// FindUserByNamedAPIToken provides a mock function with given fields: ctx, apiToken
+func (_m *AuthenticationProvider) FindUserByNamedAPIToken(ctx context.Context, apiToken string) (sessions.User, sessions.APIToken, error) {
+	ret := _m.Called(ctx, apiToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserByNamedAPIToken")
+	}
+
+	var r0 sessions.User
+	var r1 sessions.APIToken
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (sessions.User, sessions.APIToken, error)); ok {
+		return rf(ctx, apiToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) sessions.User); ok {
+		r0 = rf(ctx, apiToken)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) sessions.APIToken); ok {
+		r1 = rf(ctx, apiToken)
+	} else {
+		r1 = ret.Get(1).(sessions.APIToken)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, apiToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// AuthenticationProvider_FindUserByNamedAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByNamedAPIToken'
+type AuthenticationProvider_FindUserByNamedAPIToken_Call struct {
+	*mock.Call
+}
+
+// FindUserByNamedAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiToken string
+func (_e *AuthenticationProvider_Expecter) FindUserByNamedAPIToken(ctx interface{}, apiToken interface{}) *AuthenticationProvider_FindUserByNamedAPIToken_Call {
+	return &AuthenticationProvider_FindUserByNamedAPIToken_Call{Call: _e.mock.On("FindUserByNamedAPIToken", ctx, apiToken)}
+}
+
+func (_c *AuthenticationProvider_FindUserByNamedAPIToken_Call) Run(run func(ctx context.Context, apiToken string)) *AuthenticationProvider_FindUserByNamedAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_FindUserByNamedAPIToken_Call) Return(_a0 sessions.User, _a1 sessions.APIToken, _a2 error) *AuthenticationProvider_FindUserByNamedAPIToken_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *AuthenticationProvider_FindUserByNamedAPIToken_Call) RunAndReturn(run func(context.Context, string) (sessions.User, sessions.APIToken, error)) *AuthenticationProvider_FindUserByNamedAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserWebAuthn provides a mock function with given fields: ctx, email
 func (_m *AuthenticationProvider) GetUserWebAuthn(ctx context.Context, email string) ([]sessions.WebAuthn, error) {
 	ret := _m.Called(ctx, email)
@@ -714,6 +873,124 @@ func (_c *AuthenticationProvider_GetUserWebAuthn_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ListAPITokens provides a mock function with given fields: ctx, email
+func (_m *AuthenticationProvider) ListAPITokens(ctx context.Context, email string) ([]sessions.APIToken, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPITokens")
+	}
+
+	var r0 []sessions.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]sessions.APIToken, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []sessions.APIToken); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationProvider_ListAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPITokens'
+type AuthenticationProvider_ListAPITokens_Call struct {
+	*mock.Call
+}
+
+// ListAPITokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *AuthenticationProvider_Expecter) ListAPITokens(ctx interface{}, email interface{}) *AuthenticationProvider_ListAPITokens_Call {
+	return &AuthenticationProvider_ListAPITokens_Call{Call: _e.mock.On("ListAPITokens", ctx, email)}
+}
+
+func (_c *AuthenticationProvider_ListAPITokens_Call) Run(run func(ctx context.Context, email string)) *AuthenticationProvider_ListAPITokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_ListAPITokens_Call) Return(_a0 []sessions.APIToken, _a1 error) *AuthenticationProvider_ListAPITokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationProvider_ListAPITokens_Call) RunAndReturn(run func(context.Context, string) ([]sessions.APIToken, error)) *AuthenticationProvider_ListAPITokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function with given fields: ctx, email
+func (_m *AuthenticationProvider) ListSessions(ctx context.Context, email string) ([]sessions.Session, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []sessions.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]sessions.Session, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []sessions.Session); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationProvider_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type AuthenticationProvider_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *AuthenticationProvider_Expecter) ListSessions(ctx interface{}, email interface{}) *AuthenticationProvider_ListSessions_Call {
+	return &AuthenticationProvider_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, email)}
+}
+
+func (_c *AuthenticationProvider_ListSessions_Call) Run(run func(ctx context.Context, email string)) *AuthenticationProvider_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_ListSessions_Call) Return(_a0 []sessions.Session, _a1 error) *AuthenticationProvider_ListSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationProvider_ListSessions_Call) RunAndReturn(run func(context.Context, string) ([]sessions.Session, error)) *AuthenticationProvider_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUsers provides a mock function with given fields: ctx
 func (_m *AuthenticationProvider) ListUsers(ctx context.Context) ([]sessions.User, error) {
 	ret := _m.Called(ctx)
@@ -772,6 +1049,100 @@ func (_c *AuthenticationProvider_ListUsers_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// RevokeSession provides a mock function with given fields: ctx, publicID
+func (_m *AuthenticationProvider) RevokeSession(ctx context.Context, publicID string) error {
+	ret := _m.Called(ctx, publicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, publicID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationProvider_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type AuthenticationProvider_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - publicID string
+func (_e *AuthenticationProvider_Expecter) RevokeSession(ctx interface{}, publicID interface{}) *AuthenticationProvider_RevokeSession_Call {
+	return &AuthenticationProvider_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, publicID)}
+}
+
+func (_c *AuthenticationProvider_RevokeSession_Call) Run(run func(ctx context.Context, publicID string)) *AuthenticationProvider_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_RevokeSession_Call) Return(_a0 error) *AuthenticationProvider_RevokeSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthenticationProvider_RevokeSession_Call) RunAndReturn(run func(context.Context, string) error) *AuthenticationProvider_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserSessions provides a mock function with given fields: ctx, email
+func (_m *AuthenticationProvider) RevokeUserSessions(ctx context.Context, email string) error {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationProvider_RevokeUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserSessions'
+type AuthenticationProvider_RevokeUserSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeUserSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *AuthenticationProvider_Expecter) RevokeUserSessions(ctx interface{}, email interface{}) *AuthenticationProvider_RevokeUserSessions_Call {
+	return &AuthenticationProvider_RevokeUserSessions_Call{Call: _e.mock.On("RevokeUserSessions", ctx, email)}
+}
+
+func (_c *AuthenticationProvider_RevokeUserSessions_Call) Run(run func(ctx context.Context, email string)) *AuthenticationProvider_RevokeUserSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_RevokeUserSessions_Call) Return(_a0 error) *AuthenticationProvider_RevokeUserSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthenticationProvider_RevokeUserSessions_Call) RunAndReturn(run func(context.Context, string) error) *AuthenticationProvider_RevokeUserSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveWebAuthn provides a mock function with given fields: ctx, token
 func (_m *AuthenticationProvider) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error {
 	ret := _m.Called(ctx, token)
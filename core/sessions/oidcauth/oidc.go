@@ -15,7 +15,6 @@ import (
 	"io"
 	"log"
 	"net/http"
-	"slices"
 	"strings"
 
 	"github.com/coreos/go-oidc/v3/oidc"
@@ -44,6 +43,7 @@ var ErrUserNoOIDCGroups = errors.New("user claims response from identity server
 type oidcAuthenticator struct {
 	ds           sqlutil.DataSource
 	config       config.OIDC
+	roles        clsessions.Roles
 	provider     *oidc.Provider
 	oidcConfig   *oidc.Config
 	oauth2Config *oauth2.Config
@@ -69,6 +69,7 @@ var _ clsessions.AuthenticationProvider = (*oidcAuthenticator)(nil)
 func NewOIDCAuthenticator(
 	ds sqlutil.DataSource,
 	oidcCfg config.OIDC,
+	roles clsessions.Roles,
 	lggr logger.Logger,
 	auditLogger audit.AuditLogger,
 ) (*oidcAuthenticator, error) {
@@ -121,6 +122,7 @@ func NewOIDCAuthenticator(
 	oidcAuth := oidcAuthenticator{
 		ds:           ds,
 		config:       oidcCfg,
+		roles:        roles,
 		provider:     provider,
 		oidcConfig:   oidcConfig,
 		oauth2Config: oauth2Config,
@@ -249,10 +251,12 @@ func (oi *oidcAuthenticator) handleTokenExchange(c *gin.Context) {
 	clSession := clsessions.NewSession()
 	_, err = oi.ds.ExecContext(
 		ctx,
-		"INSERT INTO oidc_sessions (id, user_email, user_role, created_at) VALUES ($1, $2, $3, now())",
+		"INSERT INTO oidc_sessions (id, user_email, user_role, ip_address, user_agent, created_at) VALUES ($1, $2, $3, $4, $5, now())",
 		clSession.ID,
 		strings.ToLower(email),
 		role,
+		c.ClientIP(),
+		c.Request.UserAgent(),
 	)
 	if err != nil {
 		oi.lggr.Errorf("unable to create new session in oidc_sessions table %v", err)
@@ -377,7 +381,8 @@ func (oi *oidcAuthenticator) AuthorizedUserWithSession(ctx context.Context, sess
 			Email: foundSession.UserEmail,
 			Role:  foundSession.UserRole,
 		}
-		return nil
+		_, err := tx.ExecContext(ctx, "UPDATE oidc_sessions SET last_used = now() WHERE id = $1", sessionID)
+		return err
 	})
 	if err != nil {
 		if errors.Is(err, clsessions.ErrUserSessionExpired) {
@@ -423,10 +428,12 @@ func (oi *oidcAuthenticator) CreateSession(ctx context.Context, sr clsessions.Se
 	// Sessions are set to expire after the duration + creation date elapsed
 	session := clsessions.NewSession()
 	_, err = oi.ds.ExecContext(ctx,
-		"INSERT INTO oidc_sessions (id, user_email, user_role, created_at) VALUES ($1, $2, $3, now())",
+		"INSERT INTO oidc_sessions (id, user_email, user_role, ip_address, user_agent, created_at) VALUES ($1, $2, $3, $4, $5, now())",
 		session.ID,
 		strings.ToLower(sr.Email),
 		foundUser.Role,
+		sr.IPAddress,
+		sr.UserAgent,
 	)
 	if err != nil {
 		oi.lggr.Errorf("unable to create new session in oidc_sessions table %v", err)
@@ -549,6 +556,26 @@ func (oi *oidcAuthenticator) DeleteAuthToken(ctx context.Context, user *clsessio
 	return err
 }
 
+// CreateAPIToken is not supported, as named API tokens are stored with local users
+func (oi *oidcAuthenticator) CreateAPIToken(ctx context.Context, token *clsessions.APIToken) error {
+	return clsessions.ErrNotSupported
+}
+
+// ListAPITokens is not supported, as named API tokens are stored with local users
+func (oi *oidcAuthenticator) ListAPITokens(ctx context.Context, email string) ([]clsessions.APIToken, error) {
+	return nil, clsessions.ErrNotSupported
+}
+
+// DeleteAPIToken is not supported, as named API tokens are stored with local users
+func (oi *oidcAuthenticator) DeleteAPIToken(ctx context.Context, email, name string) error {
+	return clsessions.ErrNotSupported
+}
+
+// FindUserByNamedAPIToken is not supported, as named API tokens are stored with local users
+func (oi *oidcAuthenticator) FindUserByNamedAPIToken(ctx context.Context, apiToken string) (clsessions.User, clsessions.APIToken, error) {
+	return clsessions.User{}, clsessions.APIToken{}, clsessions.ErrNotSupported
+}
+
 // SaveWebAuthn is not supported for read only OIDC
 func (oi *oidcAuthenticator) SaveWebAuthn(ctx context.Context, token *clsessions.WebAuthn) error {
 	return clsessions.ErrNotSupported
@@ -557,13 +584,45 @@ func (oi *oidcAuthenticator) SaveWebAuthn(ctx context.Context, token *clsessions
 // Sessions returns all sessions limited by the parameters.
 func (oi *oidcAuthenticator) Sessions(ctx context.Context, offset, limit int) ([]clsessions.Session, error) {
 	var sessions []clsessions.Session
-	sql := `SELECT * FROM oidc_sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
+	sql := `SELECT id, user_email AS email, ip_address, user_agent, last_used, created_at FROM oidc_sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
 	if err := oi.ds.SelectContext(ctx, &sessions, sql, limit, offset); err != nil {
 		return sessions, nil
 	}
 	return sessions, nil
 }
 
+// ListSessions returns the unexpired sessions of a user, or of all users if email is empty, most recently used first.
+func (oi *oidcAuthenticator) ListSessions(ctx context.Context, email string) ([]clsessions.Session, error) {
+	var ss []clsessions.Session
+	sql := `SELECT id, user_email AS email, ip_address, user_agent, last_used, created_at FROM oidc_sessions
+	WHERE created_at + $1 >= now() AND ($2::text = '' OR user_email = lower($2))
+	ORDER BY last_used DESC, id`
+	err := oi.ds.SelectContext(ctx, &ss, sql, oi.config.SessionTimeout().Duration(), email)
+	return ss, err
+}
+
+// RevokeSession deletes an oidc_sessions entry by its public ID, see sessions.Session.PublicID.
+func (oi *oidcAuthenticator) RevokeSession(ctx context.Context, publicID string) error {
+	result, err := oi.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE "+clsessions.PublicSessionIDSQL+" = $1", publicID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// RevokeUserSessions deletes all oidc_sessions entries of a user.
+func (oi *oidcAuthenticator) RevokeUserSessions(ctx context.Context, email string) error {
+	_, err := oi.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE user_email = lower($1)", email)
+	return err
+}
+
 // FindExternalInitiator supports the 'Run' role external intiator header auth functionality
 func (oi *oidcAuthenticator) FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error) {
 	exi := &bridges.ExternalInitiator{}
@@ -593,24 +652,13 @@ func (oi *oidcAuthenticator) localLoginFallback(ctx context.Context, sr clsessio
 }
 
 func (oi *oidcAuthenticator) IDClaimsToUserRole(idClaims []string, adminClaim string, editClaim string, runClaim string, readClaim string) (clsessions.UserRole, error) {
-	// If defined Admin group name is present in id claims, return UserRoleAdmin
-	if slices.Contains(idClaims, adminClaim) {
-		return clsessions.UserRoleAdmin, nil
-	}
-	// Check edit role
-	if slices.Contains(idClaims, editClaim) {
-		return clsessions.UserRoleEdit, nil
-	}
-	// Check run role
-	if slices.Contains(idClaims, runClaim) {
-		return clsessions.UserRoleRun, nil
-	}
-	// Check view role
-	if slices.Contains(idClaims, readClaim) {
-		return clsessions.UserRoleView, nil
+	// Take the highest built-in role, or else the first matching custom role, before falling back to view
+	role, ok := oi.roles.FindRole(idClaims, adminClaim, editClaim, runClaim, readClaim, func(r clsessions.Role) string { return r.OIDCClaim })
+	if !ok {
+		// No role group found, error
+		return clsessions.UserRoleView, ErrUserNoOIDCGroups
 	}
-	// No role group found, error
-	return clsessions.UserRoleView, ErrUserNoOIDCGroups
+	return role, nil
 }
 
 // extractIDClaimValues extracts groups from the claims using the specified key
@@ -0,0 +1,279 @@
+package sessions
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+// Resource is a kind of node object which permissions are granted on.
+type Resource string
+
+const (
+	// ResourceAll matches every resource, including ones without a name of their own.
+	ResourceAll                Resource = "*"
+	ResourceBridges            Resource = "bridges"
+	ResourceChains             Resource = "chains"
+	ResourceConfig             Resource = "config"
+	ResourceExternalInitiators Resource = "external_initiators"
+	ResourceFeeds              Resource = "feeds"
+	ResourceJobs               Resource = "jobs"
+	ResourceKeys               Resource = "keys"
+	ResourceTransfers          Resource = "transfers"
+	ResourceUsers              Resource = "users"
+)
+
+var resources = []Resource{
+	ResourceAll,
+	ResourceBridges,
+	ResourceChains,
+	ResourceConfig,
+	ResourceExternalInitiators,
+	ResourceFeeds,
+	ResourceJobs,
+	ResourceKeys,
+	ResourceTransfers,
+	ResourceUsers,
+}
+
+// Action is what a permission allows on its resource. Actions form the same
+// ladder as the built-in roles: admin implies edit, which implies run, which
+// implies view.
+type Action string
+
+const (
+	ActionView  Action = "view"
+	ActionRun   Action = "run"
+	ActionEdit  Action = "edit"
+	ActionAdmin Action = "admin"
+)
+
+func (a Action) level() int {
+	switch a {
+	case ActionView:
+		return 1
+	case ActionRun:
+		return 2
+	case ActionEdit:
+		return 3
+	case ActionAdmin:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// Permission allows an action on a resource, or on a single object of it
+// when ID is set.
+type Permission struct {
+	Resource Resource
+	Action   Action
+	ID       string
+}
+
+// ParsePermission parses a permission of the form `<resource>:<action>` or
+// `<resource>:<action>:<id>`, e.g. `bridges:edit` or `jobs:run:42`.
+func ParsePermission(s string) (Permission, error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) < 2 {
+		return Permission{}, pkgerrors.Errorf("invalid permission %q: expected <resource>:<action>[:<id>]", s)
+	}
+	p := Permission{Resource: Resource(parts[0]), Action: Action(parts[1])}
+	if len(parts) == 3 {
+		if parts[2] == "" {
+			return Permission{}, pkgerrors.Errorf("invalid permission %q: empty id", s)
+		}
+		p.ID = parts[2]
+	}
+	if !slices.Contains(resources, p.Resource) {
+		return Permission{}, pkgerrors.Errorf("invalid permission %q: unknown resource %q, expected one of %v", s, p.Resource, resources)
+	}
+	if p.Action.level() == 0 {
+		return Permission{}, pkgerrors.Errorf("invalid permission %q: unknown action %q, expected one of %v", s, p.Action, []Action{ActionView, ActionRun, ActionEdit, ActionAdmin})
+	}
+	if p.Resource == ResourceAll && p.ID != "" {
+		return Permission{}, pkgerrors.Errorf("invalid permission %q: an id requires a resource", s)
+	}
+	return p, nil
+}
+
+func (p Permission) String() string {
+	if p.ID != "" {
+		return fmt.Sprintf("%s:%s:%s", p.Resource, p.Action, p.ID)
+	}
+	return fmt.Sprintf("%s:%s", p.Resource, p.Action)
+}
+
+// Allows reports whether p grants action on the object id of resource. An
+// empty id stands for the resource as a whole, e.g. creating a new object,
+// which only permissions without an ID grant.
+func (p Permission) Allows(resource Resource, id string, action Action) bool {
+	if p.Resource != ResourceAll && p.Resource != resource {
+		return false
+	}
+	if p.ID != "" && p.ID != id {
+		return false
+	}
+	return p.Action.level() >= action.level()
+}
+
+// Role is a custom role: a named set of permissions, optionally assigned to
+// the members of an LDAP group or the holders of an OIDC claim.
+type Role struct {
+	Name        UserRole
+	Permissions []Permission
+	LDAPGroupCN string
+	OIDCClaim   string
+}
+
+// Roles resolves the permissions of the built-in roles and of the custom
+// roles configured in WebServer.Roles. Every role may view every resource.
+type Roles struct {
+	custom []Role
+}
+
+// NewRoles validates and loads the configured custom roles.
+func NewRoles(cfg []config.WebServerRole) (Roles, error) {
+	var roles Roles
+	for _, c := range cfg {
+		name := UserRole(c.Name())
+		if name == "" {
+			return Roles{}, pkgerrors.New("role name must not be empty")
+		}
+		if _, err := GetUserRole(string(name)); err == nil {
+			return Roles{}, pkgerrors.Errorf("role %s: can not redefine a built-in role", name)
+		}
+		if _, ok := roles.find(name); ok {
+			return Roles{}, pkgerrors.Errorf("role %s: defined more than once", name)
+		}
+		role := Role{Name: name, LDAPGroupCN: c.LDAPGroupCN(), OIDCClaim: c.OIDCClaim()}
+		for _, s := range c.Permissions() {
+			p, err := ParsePermission(s)
+			if err != nil {
+				return Roles{}, pkgerrors.Wrapf(err, "role %s", name)
+			}
+			role.Permissions = append(role.Permissions, p)
+		}
+		roles.custom = append(roles.custom, role)
+	}
+	return roles, nil
+}
+
+// Custom returns the custom roles, in configuration order.
+func (r Roles) Custom() []Role {
+	return r.custom
+}
+
+func (r Roles) find(name UserRole) (Role, bool) {
+	for _, role := range r.custom {
+		if role.Name == name {
+			return role, true
+		}
+	}
+	return Role{}, false
+}
+
+// GetUserRole maps a role string to a built-in or custom UserRole.
+func (r Roles) GetUserRole(role string) (UserRole, error) {
+	if _, ok := r.find(UserRole(role)); ok {
+		return UserRole(role), nil
+	}
+	userRole, err := GetUserRole(role)
+	if err != nil && len(r.custom) > 0 {
+		names := make([]string, len(r.custom))
+		for i, c := range r.custom {
+			names[i] = string(c.Name)
+		}
+		return "", pkgerrors.Errorf("%s Custom roles: '%s'.", err, strings.Join(names, "', '"))
+	}
+	return userRole, err
+}
+
+// Allowed reports whether role may perform action on the object id of
+// resource. An empty id stands for the resource as a whole.
+func (r Roles) Allowed(role UserRole, resource Resource, id string, action Action) bool {
+	switch role {
+	case UserRoleAdmin:
+		return true
+	case UserRoleEdit:
+		return action.level() <= ActionEdit.level()
+	case UserRoleRun:
+		return action.level() <= ActionRun.level()
+	case UserRoleView:
+		return action.level() <= ActionView.level()
+	}
+	custom, ok := r.find(role)
+	if !ok {
+		return false
+	}
+	if action == ActionView {
+		return true
+	}
+	for _, p := range custom.Permissions {
+		if p.Allows(resource, id, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanGrant reports whether a user with role may assign granted to a user,
+// which is the case if granted permits nothing that role does not. Only the
+// built-in admin role can grant admin.
+func (r Roles) CanGrant(role, granted UserRole) bool {
+	if role == UserRoleAdmin {
+		return true
+	}
+	var permissions []Permission
+	switch granted {
+	case UserRoleAdmin:
+		return false
+	case UserRoleEdit:
+		permissions = []Permission{{Resource: ResourceAll, Action: ActionEdit}}
+	case UserRoleRun:
+		permissions = []Permission{{Resource: ResourceAll, Action: ActionRun}}
+	case UserRoleView:
+		return true
+	default:
+		custom, ok := r.find(granted)
+		if !ok {
+			return false
+		}
+		permissions = custom.Permissions
+	}
+	for _, p := range permissions {
+		if !r.Allowed(role, p.Resource, p.ID, p.Action) {
+			return false
+		}
+	}
+	return true
+}
+
+// FindRole returns the role of a user belonging to groups, given the groups
+// mapped to the built-in roles and a function returning the group mapped to
+// a custom role. The admin, edit and run roles take precedence over custom
+// roles, in configuration order, and those over view. It returns false if
+// none of the groups is mapped to a role.
+func (r Roles) FindRole(groups []string, adminGroup, editGroup, runGroup, viewGroup string, customGroup func(Role) string) (UserRole, bool) {
+	switch {
+	case slices.Contains(groups, adminGroup):
+		return UserRoleAdmin, true
+	case slices.Contains(groups, editGroup):
+		return UserRoleEdit, true
+	case slices.Contains(groups, runGroup):
+		return UserRoleRun, true
+	}
+	for _, custom := range r.custom {
+		if group := customGroup(custom); group != "" && slices.Contains(groups, group) {
+			return custom.Name, true
+		}
+	}
+	if slices.Contains(groups, viewGroup) {
+		return UserRoleView, true
+	}
+	return UserRoleView, false
+}
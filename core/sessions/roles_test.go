@@ -0,0 +1,181 @@
+package sessions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+type testRole struct {
+	name        string
+	permissions []string
+	ldapGroupCN string
+	oidcClaim   string
+}
+
+func (r testRole) Name() string          { return r.name }
+func (r testRole) Permissions() []string { return r.permissions }
+func (r testRole) LDAPGroupCN() string   { return r.ldapGroupCN }
+func (r testRole) OIDCClaim() string     { return r.oidcClaim }
+
+func TestParsePermission(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		in      string
+		want    sessions.Permission
+		wantErr string
+	}{
+		{"bridges:edit", sessions.Permission{Resource: sessions.ResourceBridges, Action: sessions.ActionEdit}, ""},
+		{"jobs:run:42", sessions.Permission{Resource: sessions.ResourceJobs, Action: sessions.ActionRun, ID: "42"}, ""},
+		{"*:admin", sessions.Permission{Resource: sessions.ResourceAll, Action: sessions.ActionAdmin}, ""},
+		{"jobs:view", sessions.Permission{Resource: sessions.ResourceJobs, Action: sessions.ActionView}, ""},
+		{"bridges", sessions.Permission{}, "expected <resource>:<action>[:<id>]"},
+		{"bridges:write", sessions.Permission{}, `unknown action "write"`},
+		{"wallets:edit", sessions.Permission{}, `unknown resource "wallets"`},
+		{"jobs:run:", sessions.Permission{}, "empty id"},
+		{"*:run:42", sessions.Permission{}, "an id requires a resource"},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := sessions.ParsePermission(tt.in)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.in, got.String())
+		})
+	}
+}
+
+func TestNewRoles(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name    string
+		cfg     []config.WebServerRole
+		wantErr string
+	}{
+		{"empty name", []config.WebServerRole{testRole{}}, "role name must not be empty"},
+		{"built-in", []config.WebServerRole{testRole{name: "edit"}}, "can not redefine a built-in role"},
+		{"duplicate", []config.WebServerRole{testRole{name: "ops"}, testRole{name: "ops"}}, "defined more than once"},
+		{"bad permission", []config.WebServerRole{testRole{name: "ops", permissions: []string{"keys"}}}, "role ops: invalid permission"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sessions.NewRoles(tt.cfg)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRoles_Allowed(t *testing.T) {
+	t.Parallel()
+
+	roles, err := sessions.NewRoles([]config.WebServerRole{
+		testRole{name: "bridge-operator", permissions: []string{"bridges:edit"}},
+		testRole{name: "job-42-runner", permissions: []string{"jobs:run:42"}},
+	})
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		role     sessions.UserRole
+		resource sessions.Resource
+		id       string
+		action   sessions.Action
+		want     bool
+	}{
+		{sessions.UserRoleAdmin, sessions.ResourceKeys, "", sessions.ActionAdmin, true},
+		{sessions.UserRoleEdit, sessions.ResourceKeys, "", sessions.ActionEdit, true},
+		{sessions.UserRoleEdit, sessions.ResourceKeys, "", sessions.ActionAdmin, false},
+		{sessions.UserRoleRun, sessions.ResourceJobs, "1", sessions.ActionRun, true},
+		{sessions.UserRoleRun, sessions.ResourceJobs, "", sessions.ActionEdit, false},
+		{sessions.UserRoleView, sessions.ResourceJobs, "1", sessions.ActionRun, false},
+		{sessions.UserRoleView, sessions.ResourceJobs, "1", sessions.ActionView, true},
+		{"bridge-operator", sessions.ResourceKeys, "", sessions.ActionView, true},
+		{"bridge-operator", sessions.ResourceBridges, "", sessions.ActionEdit, true},
+		{"bridge-operator", sessions.ResourceBridges, "my-bridge", sessions.ActionRun, true},
+		{"bridge-operator", sessions.ResourceBridges, "", sessions.ActionAdmin, false},
+		{"bridge-operator", sessions.ResourceKeys, "", sessions.ActionEdit, false},
+		{"job-42-runner", sessions.ResourceJobs, "42", sessions.ActionRun, true},
+		{"job-42-runner", sessions.ResourceJobs, "43", sessions.ActionRun, false},
+		{"job-42-runner", sessions.ResourceJobs, "", sessions.ActionRun, false},
+		{"job-42-runner", sessions.ResourceJobs, "42", sessions.ActionEdit, false},
+		{"unknown", sessions.ResourceJobs, "42", sessions.ActionRun, false},
+		{"unknown", sessions.ResourceJobs, "42", sessions.ActionView, false},
+	} {
+		assert.Equal(t, tt.want, roles.Allowed(tt.role, tt.resource, tt.id, tt.action), "%s %s:%s:%s", tt.role, tt.resource, tt.action, tt.id)
+	}
+
+	userRole, err := roles.GetUserRole("bridge-operator")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRole("bridge-operator"), userRole)
+	_, err = roles.GetUserRole("unknown")
+	require.ErrorContains(t, err, "Custom roles: 'bridge-operator', 'job-42-runner'.")
+}
+
+func TestRoles_CanGrant(t *testing.T) {
+	t.Parallel()
+
+	roles, err := sessions.NewRoles([]config.WebServerRole{
+		testRole{name: "user-admin", permissions: []string{"users:admin"}},
+		testRole{name: "operator", permissions: []string{"users:admin", "*:edit"}},
+		testRole{name: "bridge-operator", permissions: []string{"bridges:edit"}},
+	})
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		role    sessions.UserRole
+		granted sessions.UserRole
+		want    bool
+	}{
+		{sessions.UserRoleAdmin, sessions.UserRoleAdmin, true},
+		{sessions.UserRoleAdmin, "user-admin", true},
+		{"user-admin", sessions.UserRoleAdmin, false},
+		{"user-admin", sessions.UserRoleEdit, false},
+		{"user-admin", sessions.UserRoleView, true},
+		{"user-admin", "user-admin", true},
+		{"user-admin", "bridge-operator", false},
+		{"user-admin", "operator", false},
+		{"operator", sessions.UserRoleAdmin, false},
+		{"operator", sessions.UserRoleEdit, true},
+		{"operator", sessions.UserRoleRun, true},
+		{"operator", "bridge-operator", true},
+		{"operator", "user-admin", true},
+		{"bridge-operator", "unknown", false},
+	} {
+		assert.Equal(t, tt.want, roles.CanGrant(tt.role, tt.granted), "%s granting %s", tt.role, tt.granted)
+	}
+}
+
+func TestRoles_FindRole(t *testing.T) {
+	t.Parallel()
+
+	roles, err := sessions.NewRoles([]config.WebServerRole{
+		testRole{name: "bridge-operator", ldapGroupCN: "BridgeOperators"},
+		testRole{name: "job-runner", ldapGroupCN: "JobRunners"},
+	})
+	require.NoError(t, err)
+	ldapGroup := func(r sessions.Role) string { return r.LDAPGroupCN }
+
+	for _, tt := range []struct {
+		groups []string
+		want   sessions.UserRole
+		found  bool
+	}{
+		{[]string{"Admins", "BridgeOperators"}, sessions.UserRoleAdmin, true},
+		{[]string{"Runners", "BridgeOperators"}, sessions.UserRoleRun, true},
+		{[]string{"Viewers", "JobRunners", "BridgeOperators"}, "bridge-operator", true},
+		{[]string{"Viewers", "JobRunners"}, "job-runner", true},
+		{[]string{"Viewers"}, sessions.UserRoleView, true},
+		{[]string{"Others"}, sessions.UserRoleView, false},
+	} {
+		role, found := roles.FindRole(tt.groups, "Admins", "Editors", "Runners", "Viewers", ldapGroup)
+		assert.Equal(t, tt.want, role, tt.groups)
+		assert.Equal(t, tt.found, found, tt.groups)
+	}
+}
@@ -1,7 +1,9 @@
 package sessions
 
 import (
+	"crypto/sha256"
 	"crypto/subtle"
+	"encoding/hex"
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
@@ -19,16 +21,32 @@ type SessionRequest struct {
 	WebAuthnData   string `json:"webauthndata"`
 	WebAuthnConfig WebAuthnConfiguration
 	SessionStore   *WebAuthnSessionStore
+	// IPAddress and UserAgent of the client are recorded with the session.
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
 }
 
 // Session holds the unique id for the authenticated session.
 type Session struct {
 	ID        string    `json:"id"`
 	Email     string    `json:"email"`
+	IPAddress string    `json:"ipAddress"`
+	UserAgent string    `json:"userAgent"`
 	LastUsed  time.Time `json:"lastUsed"`
 	CreatedAt time.Time `json:"createdAt"`
 }
 
+// PublicSessionIDSQL is the SQL expression of Session.PublicID, for the id
+// column of a sessions table.
+const PublicSessionIDSQL = "encode(sha256(convert_to(id, 'UTF8')), 'hex')"
+
+// PublicID returns the ID by which administrators list and revoke the
+// session. Unlike the session ID itself, it can not be used to authenticate.
+func (s Session) PublicID() string {
+	sum := sha256.Sum256([]byte(s.ID))
+	return hex.EncodeToString(sum[:])
+}
+
 // NewSession returns a session instance with ID set to a random ID and
 // LastUsed to now.
 func NewSession() Session {
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pipeline_task_runs ADD COLUMN attempts jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pipeline_task_runs DROP COLUMN attempts;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE pipeline_cache_entries (
+    key text PRIMARY KEY,
+    value jsonb NOT NULL,
+    stored_at timestamp with time zone NOT NULL,
+    expires_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX idx_pipeline_cache_entries_expires_at ON pipeline_cache_entries (expires_at);
+
+-- +goose Down
+DROP TABLE pipeline_cache_entries;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Revisions are keyed by external job ID rather than job ID, since updating a
+-- job deletes and re-creates it, and outlive the job so that they can be audited.
+CREATE TABLE job_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    external_job_id uuid NOT NULL,
+    revision integer NOT NULL,
+    job_id integer NOT NULL,
+    toml text NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    UNIQUE (external_job_id, revision)
+);
+
+-- +goose Down
+DROP TABLE job_revisions;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- Custom roles are defined in the node config, so role columns hold any role name
+ALTER TABLE users ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE users ALTER COLUMN role TYPE text USING role::text;
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'view';
+ALTER TABLE ldap_sessions ALTER COLUMN user_role TYPE text USING user_role::text;
+ALTER TABLE ldap_user_api_tokens ALTER COLUMN user_role TYPE text USING user_role::text;
+ALTER TABLE oidc_sessions ALTER COLUMN user_role TYPE text USING user_role::text;
+ALTER TABLE oidc_user_api_tokens ALTER COLUMN user_role TYPE text USING user_role::text;
+
+-- +goose Down
+-- Users with a custom role fall back to view, and sessions and tokens with one are dropped
+UPDATE users SET role = 'view' WHERE role NOT IN ('admin', 'edit', 'run', 'view');
+DELETE FROM ldap_sessions WHERE user_role NOT IN ('admin', 'edit', 'run', 'view');
+DELETE FROM ldap_user_api_tokens WHERE user_role NOT IN ('admin', 'edit', 'run', 'view');
+DELETE FROM oidc_sessions WHERE user_role NOT IN ('admin', 'edit', 'run', 'view');
+DELETE FROM oidc_user_api_tokens WHERE user_role NOT IN ('admin', 'edit', 'run', 'view');
+ALTER TABLE users ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE users ALTER COLUMN role TYPE user_roles USING role::user_roles;
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'view';
+ALTER TABLE ldap_sessions ALTER COLUMN user_role TYPE user_roles USING user_role::user_roles;
+ALTER TABLE ldap_user_api_tokens ALTER COLUMN user_role TYPE user_roles USING user_role::user_roles;
+ALTER TABLE oidc_sessions ALTER COLUMN user_role TYPE user_roles USING user_role::user_roles;
+ALTER TABLE oidc_user_api_tokens ALTER COLUMN user_role TYPE user_roles USING user_role::user_roles;
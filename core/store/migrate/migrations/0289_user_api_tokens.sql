@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE user_api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_email text NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    name text NOT NULL,
+    scope text[] NOT NULL DEFAULT '{}',
+    token_key text UNIQUE NOT NULL,
+    token_salt text NOT NULL,
+    token_hashed_secret text NOT NULL,
+    expires_at timestamp WITH time zone NOT NULL,
+    created_at timestamp WITH time zone NOT NULL,
+    UNIQUE (user_email, name)
+);
+
+-- +goose Down
+DROP TABLE user_api_tokens;
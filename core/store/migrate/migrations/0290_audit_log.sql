@@ -0,0 +1,51 @@
+-- +goose Up
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    event_id text NOT NULL,
+    user_email text NOT NULL,
+    data text NOT NULL,
+    prev_hash bytea,
+    hash bytea NOT NULL,
+    created_at timestamp WITH time zone NOT NULL
+);
+CREATE INDEX idx_audit_log_created_at ON audit_log (created_at);
+CREATE INDEX idx_audit_log_event_id ON audit_log (event_id);
+
+-- The log is append-only
+-- +goose StatementBegin
+CREATE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+CREATE TRIGGER audit_log_append_only BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+
+-- The single row head of the chain, locked by writers and moved forward on
+-- every append, so that records removed from the end of the log are detected
+CREATE TABLE audit_log_head (
+    id boolean PRIMARY KEY DEFAULT TRUE CHECK (id),
+    record_id bigint NOT NULL,
+    hash bytea
+);
+INSERT INTO audit_log_head (record_id, hash) VALUES (0, NULL);
+
+-- +goose StatementBegin
+CREATE FUNCTION audit_log_head_forward_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP <> 'UPDATE' OR NEW.record_id <= OLD.record_id THEN
+        RAISE EXCEPTION 'audit_log_head only moves forward';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+CREATE TRIGGER audit_log_head_forward_only BEFORE UPDATE OR DELETE ON audit_log_head
+    FOR EACH ROW EXECUTE FUNCTION audit_log_head_forward_only();
+
+-- +goose Down
+DROP TABLE audit_log_head;
+DROP FUNCTION audit_log_head_forward_only;
+DROP TABLE audit_log;
+DROP FUNCTION audit_log_append_only;
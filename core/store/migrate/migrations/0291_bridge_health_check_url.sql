@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE bridge_types ADD COLUMN health_check_url text;
+
+-- +goose Down
+ALTER TABLE bridge_types DROP COLUMN health_check_url;
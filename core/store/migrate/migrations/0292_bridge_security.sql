@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN client_certificate text NOT NULL DEFAULT '',
+    ADD COLUMN client_key text NOT NULL DEFAULT '',
+    ADD COLUMN ca_bundle text NOT NULL DEFAULT '',
+    ADD COLUMN request_signing_secret text NOT NULL DEFAULT '',
+    ADD COLUMN response_verification_key text NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP COLUMN client_certificate,
+    DROP COLUMN client_key,
+    DROP COLUMN ca_bundle,
+    DROP COLUMN request_signing_secret,
+    DROP COLUMN response_verification_key;
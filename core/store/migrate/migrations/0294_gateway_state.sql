@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE gateway_state (
+    key text PRIMARY KEY,
+    value text NOT NULL,
+    expires_at timestamp with time zone NOT NULL
+);
+CREATE INDEX idx_gateway_state_expires_at ON gateway_state (expires_at);
+
+-- +goose Down
+DROP TABLE gateway_state;
@@ -0,0 +1,68 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+var errLocalAuditLogDisabled = errors.New("the local audit log is disabled, see AuditLogger.LocalEnabled")
+
+// AuditController queries the local audit log.
+type AuditController struct {
+	App chainlink.Application
+}
+
+func (ac *AuditController) localEnabled() bool {
+	cfg := ac.App.GetConfig().AuditLogger()
+	return cfg.Enabled() && cfg.LocalEnabled()
+}
+
+// Index lists the records of the local audit log, newest first, optionally
+// filtered by the time, as RFC3339, since which they were logged, their event
+// and their user.
+// Example:
+// "GET <application>/audit?since=2024-01-02T15:04:05Z&event=JOB_CREATED&user=a@b.c"
+func (ac *AuditController) Index(c *gin.Context, size, page, offset int) {
+	if !ac.localEnabled() {
+		jsonAPIError(c, http.StatusBadRequest, errLocalAuditLogDisabled)
+		return
+	}
+	filter := audit.Filter{
+		EventID: audit.EventID(c.Query("event")),
+		User:    c.Query("user"),
+	}
+	if since := c.Query("since"); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid since"))
+			return
+		}
+		filter.Since = t
+	}
+
+	records, count, err := audit.NewORM(ac.App.GetDB()).List(c.Request.Context(), filter, offset, size)
+	paginatedResponse(c, "audit_records", size, page, presenters.NewAuditRecordResources(records), count, err)
+}
+
+// Verify checks the hash chain of the local audit log, from its first record.
+// Example:
+// "GET <application>/audit/verify"
+func (ac *AuditController) Verify(c *gin.Context) {
+	if !ac.localEnabled() {
+		jsonAPIError(c, http.StatusBadRequest, errLocalAuditLogDisabled)
+		return
+	}
+	checked, err := audit.NewORM(ac.App.GetDB()).Verify(c.Request.Context())
+	if err != nil && !errors.Is(err, audit.ErrChainBroken) {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAuditVerificationResource(checked, err), "audit_verifications")
+}
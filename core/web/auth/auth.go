@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"strings"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 )
@@ -33,6 +35,9 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// SessionAPITokenKey is the named API token key in the session map
+	SessionAPITokenKey = "api_token"
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -42,6 +47,7 @@ type Authenticator interface {
 	FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error)
 	FindUser(ctx context.Context, email string) (clsessions.User, error)
 	FindUserByAPIToken(ctx context.Context, apiToken string) (clsessions.User, error)
+	FindUserByNamedAPIToken(ctx context.Context, apiToken string) (clsessions.User, clsessions.APIToken, error)
 }
 
 // authMethod defines a method which can be used to authenticate a request. This
@@ -91,8 +97,11 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 
 	// We need to first load the user row so we can compare tokens using the stored salt
 	user, err := authr.FindUserByAPIToken(ctx, token.AccessKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return authenticateByNamedToken(c, authr, token)
+	}
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, clsessions.ErrUserSessionExpired) {
+		if errors.Is(err, clsessions.ErrUserSessionExpired) {
 			return auth.ErrorAuthFailed
 		}
 		return err
@@ -107,12 +116,55 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 	}
 
 	c.Set(SessionUserKey, &user)
+	setAuditToken(c, user.Email, clsessions.DefaultAPITokenName)
 
 	return nil
 }
 
 var _ authMethod = AuthenticateByToken
 
+// authenticateByNamedToken authenticates a User by one of their named API
+// tokens, and checks the token's scope permits at least viewing, or for
+// requests other than GET, running the route's resource.
+func authenticateByNamedToken(c *gin.Context, authr Authenticator, token *auth.Token) error {
+	user, apiToken, err := authr.FindUserByNamedAPIToken(c.Request.Context(), token.AccessKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, clsessions.ErrNotSupported) {
+			return auth.ErrorAuthFailed
+		}
+		return err
+	}
+
+	ok, err := apiToken.Authenticate(token)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return auth.ErrorAuthFailed
+	}
+
+	action := clsessions.ActionRun
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+		action = clsessions.ActionView
+	}
+	if resource, id := routeResource(c); !apiToken.Scope.Allows(resource, id, action) {
+		return errors.Errorf("API token %s is not permitted to %s this resource", apiToken.Name, action)
+	}
+
+	c.Set(SessionUserKey, &user)
+	c.Set(SessionAPITokenKey, &apiToken)
+	setAuditToken(c, user.Email, apiToken.Name)
+
+	return nil
+}
+
+// setAuditToken attributes the audit events of the request to the API token
+// it was made with.
+func setAuditToken(c *gin.Context, email, name string) {
+	ctx := audit.ContextWithData(c.Request.Context(), audit.Data{"user": email, "apiToken": name})
+	c.Request = c.Request.WithContext(ctx)
+}
+
 // AuthenticateExternalInitiator authenticates an external initiator request.
 //
 // Implements authMethod
@@ -186,6 +238,19 @@ func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	return user, ok
 }
 
+// GetAuthenticatedAPIToken extracts the named API token the request was
+// authenticated with from the context.
+func GetAuthenticatedAPIToken(c *gin.Context) (*clsessions.APIToken, bool) {
+	obj, ok := c.Get(SessionAPITokenKey)
+	if !ok {
+		return nil, false
+	}
+
+	token, ok := obj.(*clsessions.APIToken)
+
+	return token, ok
+}
+
 // GetAuthenticatedExternalInitiator extracts the external initiator from the
 // context.
 func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiator, bool) {
@@ -197,28 +262,84 @@ func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiat
 	return obj.(*bridges.ExternalInitiator), ok
 }
 
-// RequiresRunRole extracts the user object from the context, and asserts the user's role is at least
-// 'run'
-func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
+type rolesKey struct{}
+
+// WithRoles is middleware which sets the node's roles on the request context,
+// so that custom roles are resolved by the RequiresXRole wrappers and the GQL
+// resolvers.
+func WithRoles(roles clsessions.Roles) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
+		c.Request = c.Request.WithContext(ContextWithRoles(c.Request.Context(), roles))
+	}
+}
+
+// ContextWithRoles sets the node's roles in the context
+func ContextWithRoles(ctx context.Context, roles clsessions.Roles) context.Context {
+	return context.WithValue(ctx, rolesKey{}, roles)
+}
+
+// GetRoles extracts the node's roles from the context. Without any, only the
+// built-in roles are known.
+func GetRoles(ctx context.Context) clsessions.Roles {
+	roles, _ := ctx.Value(rolesKey{}).(clsessions.Roles)
+	return roles
+}
+
+// routeResources maps the first segment of a /v2 route to the resource it
+// acts on. Routes not listed are only permitted to roles with `*` permissions.
+var routeResources = map[string]clsessions.Resource{
+	"bridge_types":        clsessions.ResourceBridges,
+	"chains":              clsessions.ResourceChains,
+	"nodes":               clsessions.ResourceChains,
+	"replay_from_block":   clsessions.ResourceChains,
+	"find_lca":            clsessions.ResourceChains,
+	"log":                 clsessions.ResourceConfig,
+	"external_initiators": clsessions.ResourceExternalInitiators,
+	"gateways":            clsessions.ResourceJobs,
+	"jobs":                clsessions.ResourceJobs,
+	"pipeline":            clsessions.ResourceJobs,
+	"execute_capability":  clsessions.ResourceJobs,
+	"keys":                clsessions.ResourceKeys,
+	"keystore":            clsessions.ResourceKeys,
+	"sessions":            clsessions.ResourceUsers,
+	"transfers":           clsessions.ResourceTransfers,
+	"users":               clsessions.ResourceUsers,
+}
+
+// routeIDParams are the route parameters naming the object a request acts on
+var routeIDParams = []string{"ID", "keyID", "address", "BridgeName", "Name", "fwdID", "email"}
+
+// wholeResourceRoutes lists the first segments of /v2 routes whose parameters
+// name objects other than those of their resource, such as the pipeline runs
+// and job spec errors of the pipeline routes. Those routes act on the resource
+// as a whole, so that a permission on one job can't reach the run or spec
+// error which happens to share its ID.
+var wholeResourceRoutes = map[string]bool{
+	"pipeline": true,
+}
+
+// routeResource returns the resource and object ID which the request's route
+// acts on. The ID is empty for routes acting on the resource as a whole.
+func routeResource(c *gin.Context) (resource clsessions.Resource, id string) {
+	segments := strings.Split(strings.TrimPrefix(c.FullPath(), "/"), "/")
+	if len(segments) > 1 && segments[0] == "v2" {
+		resource = routeResources[segments[1]]
+		if wholeResourceRoutes[segments[1]] {
+			return resource, ""
 		}
-		if user.Role == clsessions.UserRoleView {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
-			return
+	}
+	for _, param := range routeIDParams {
+		if id = c.Param(param); id != "" {
+			break
 		}
-		handler(c)
 	}
+	return resource, id
 }
 
-// RequiresEditRole extracts the user object from the context, and asserts the user's role is at least
-// 'edit'
-func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
+// requiresAction extracts the user object from the context, and asserts the
+// user's role, and the scope of the API token used if any, permit action on
+// the resource of the route.
+func requiresAction(action clsessions.Action, handler func(*gin.Context)) func(*gin.Context) {
 	return func(c *gin.Context) {
 		user, ok := GetAuthenticatedUser(c)
 		if !ok {
@@ -226,8 +347,18 @@ func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
-		if user.Role == clsessions.UserRoleView || user.Role == clsessions.UserRoleRun {
+		resource, id := routeResource(c)
+		allowed := GetRoles(c.Request.Context()).Allowed(user.Role, resource, id, action)
+		if token, ok := GetAuthenticatedAPIToken(c); ok && !token.Scope.Allows(resource, id, action) {
+			allowed = false
+		}
+		if !allowed {
 			c.Abort()
+			if action == clsessions.ActionAdmin {
+				addForbiddenErrorHeaders(c, "admin", string(user.Role), user.Email)
+				jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
+				return
+			}
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
 			return
 		}
@@ -235,21 +366,20 @@ func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
 	}
 }
 
-// RequiresAdminRole extracts the user object from the context, and asserts the user's role is 'admin'
+// RequiresRunRole extracts the user object from the context, and asserts the user's role is at least
+// 'run', or a custom role with run permission on the route's resource
+func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
+	return requiresAction(clsessions.ActionRun, handler)
+}
+
+// RequiresEditRole extracts the user object from the context, and asserts the user's role is at least
+// 'edit', or a custom role with edit permission on the route's resource
+func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
+	return requiresAction(clsessions.ActionEdit, handler)
+}
+
+// RequiresAdminRole extracts the user object from the context, and asserts the user's role is 'admin',
+// or a custom role with admin permission on the route's resource
 func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
-	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
-		}
-		if user.Role != clsessions.UserRoleAdmin {
-			c.Abort()
-			addForbiddenErrorHeaders(c, "admin", string(user.Role), user.Email)
-			jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
-			return
-		}
-		handler(c)
-	}
+	return requiresAction(clsessions.ActionAdmin, handler)
 }
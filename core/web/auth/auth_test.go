@@ -2,11 +2,13 @@ package auth_test
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -15,8 +17,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
@@ -47,6 +53,27 @@ func (u userFindFailer) FindUserByAPIToken(ctx context.Context, token string) (s
 	return sessions.User{}, u.err
 }
 
+func (u userFindFailer) FindUserByNamedAPIToken(ctx context.Context, token string) (sessions.User, sessions.APIToken, error) {
+	return sessions.User{}, sessions.APIToken{}, u.err
+}
+
+type namedTokenFinder struct {
+	sessions.AuthenticationProvider
+	user  sessions.User
+	token sessions.APIToken
+}
+
+func (n namedTokenFinder) FindUserByAPIToken(ctx context.Context, token string) (sessions.User, error) {
+	return sessions.User{}, sql.ErrNoRows
+}
+
+func (n namedTokenFinder) FindUserByNamedAPIToken(ctx context.Context, token string) (sessions.User, sessions.APIToken, error) {
+	if token != n.token.TokenKey {
+		return sessions.User{}, sessions.APIToken{}, sql.ErrNoRows
+	}
+	return n.user, n.token, nil
+}
+
 type userFindSuccesser struct {
 	sessions.AuthenticationProvider
 	user sessions.User
@@ -310,10 +337,13 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/jobs", true, true, true},
 	{"GET", "/v2/jobs/MOCK", true, true, true},
 	{"POST", "/v2/jobs", false, false, true},
+	{"POST", "/v2/jobs/simulate", false, false, true},
+	{"POST", "/v2/jobs/import", false, false, false},
 	{"DELETE", "/v2/jobs/MOCK", false, false, true},
 	{"GET", "/v2/pipeline/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs/MOCK", true, true, true},
+	{"POST", "/v2/pipeline/runs/MOCK/fixture/live", false, true, true},
 	{"GET", "/v2/features", true, true, true},
 	{"DELETE", "/v2/pipeline/job_spec_errors/MOCK", false, false, true},
 	{"GET", "/v2/log", true, true, true},
@@ -521,6 +551,134 @@ func TestRBAC_Routemap_ViewOnly(t *testing.T) {
 	}
 }
 
+func TestRBAC_CustomRoles(t *testing.T) {
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.WebServer.Roles = []toml.WebServerRole{
+			{Name: testutils.Ptr("operator"), Permissions: []string{"bridges:edit", "jobs:run:42", "jobs:edit:7"}},
+		}
+	})
+	roles, err := sessions.NewRoles(cfg.WebServer().Roles())
+	require.NoError(t, err)
+
+	user := cltest.MustRandomUser(t)
+	user.Role = "operator"
+	key, secret := uuid.New().String(), uuid.New().String()
+	require.NoError(t, user.SetAuthToken(&auth.Token{AccessKey: key, Secret: secret}))
+
+	router := gin.New()
+	router.Use(webauth.WithRoles(roles), webauth.Authenticate(userFindSuccesser{user: user}, webauth.AuthenticateByToken))
+	ok := func(c *gin.Context) { c.String(http.StatusOK, "") }
+	router.POST("/v2/bridge_types", webauth.RequiresEditRole(ok))
+	router.DELETE("/v2/bridge_types/:BridgeName", webauth.RequiresAdminRole(ok))
+	router.POST("/v2/keys/eth", webauth.RequiresEditRole(ok))
+	router.POST("/v2/jobs/:ID/runs", webauth.RequiresRunRole(ok))
+	router.DELETE("/v2/jobs/:ID", webauth.RequiresEditRole(ok))
+	router.POST("/v2/pipeline/runs/:runID/fixture/live", webauth.RequiresRunRole(ok))
+	router.DELETE("/v2/pipeline/job_spec_errors/:ID", webauth.RequiresEditRole(ok))
+
+	for _, tt := range []struct {
+		verb, path string
+		want       int
+	}{
+		{"POST", "/v2/bridge_types", http.StatusOK},
+		{"DELETE", "/v2/bridge_types/MOCK", http.StatusForbidden},
+		{"POST", "/v2/keys/eth", http.StatusUnauthorized},
+		{"POST", "/v2/jobs/42/runs", http.StatusOK},
+		{"POST", "/v2/jobs/43/runs", http.StatusUnauthorized},
+		{"DELETE", "/v2/jobs/42", http.StatusUnauthorized},
+		{"DELETE", "/v2/jobs/7", http.StatusOK},
+		// run and spec error IDs are not job IDs, so pipeline routes require permissions on all jobs
+		{"POST", "/v2/pipeline/runs/42/fixture/live", http.StatusUnauthorized},
+		{"DELETE", "/v2/pipeline/job_spec_errors/7", http.StatusUnauthorized},
+	} {
+		t.Run(tt.verb+" "+tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := mustRequest(t, tt.verb, tt.path, nil)
+			req.Header.Set(webauth.APIKey, key)
+			req.Header.Set(webauth.APISecret, secret)
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
+
+type recordingAuditLogger struct {
+	audit.AuditLogger
+	events []audit.Data
+}
+
+func (r *recordingAuditLogger) Audit(_ audit.EventID, data audit.Data) {
+	r.events = append(r.events, data)
+}
+
+func TestAuthenticateByToken_NamedToken(t *testing.T) {
+	user := cltest.MustRandomUser(t)
+	user.Role = sessions.UserRoleEdit
+	apiToken, token, err := sessions.NewAPIToken(user.Email, sessions.CreateAPITokenRequest{
+		Name:      "ci",
+		Scope:     []string{"jobs:run:42"},
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	auditLogger := &recordingAuditLogger{}
+	router := gin.New()
+	router.Use(webauth.Authenticate(namedTokenFinder{user: user, token: apiToken}, webauth.AuthenticateByToken))
+	ok := func(c *gin.Context) {
+		audit.FromContext(c.Request.Context(), auditLogger).Audit(audit.JobCreated, audit.Data{})
+		c.String(http.StatusOK, "")
+	}
+	router.GET("/v2/jobs/:ID", ok)
+	router.GET("/v2/keys/eth", ok)
+	router.POST("/v2/jobs/:ID/runs", webauth.RequiresRunRole(ok))
+	router.DELETE("/v2/jobs/:ID", webauth.RequiresEditRole(ok))
+
+	for _, tt := range []struct {
+		verb, path string
+		want       int
+	}{
+		{"GET", "/v2/jobs/42", http.StatusOK},
+		{"GET", "/v2/keys/eth", http.StatusUnauthorized},
+		{"POST", "/v2/jobs/42/runs", http.StatusOK},
+		{"POST", "/v2/jobs/43/runs", http.StatusUnauthorized},
+		{"DELETE", "/v2/jobs/42", http.StatusUnauthorized},
+	} {
+		t.Run(tt.verb+" "+tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := mustRequest(t, tt.verb, tt.path, nil)
+			req.Header.Set(webauth.APIKey, token.AccessKey)
+			req.Header.Set(webauth.APISecret, token.Secret)
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+	require.Len(t, auditLogger.events, 2)
+	assert.Equal(t, audit.Data{"user": user.Email, "apiToken": "ci"}, auditLogger.events[0])
+
+	t.Run("wrong secret", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := mustRequest(t, "GET", "/v2/jobs/42", nil)
+		req.Header.Set(webauth.APIKey, token.AccessKey)
+		req.Header.Set(webauth.APISecret, "wrong")
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		expired := apiToken
+		expired.ExpiresAt = time.Now().Add(-time.Minute)
+		router := gin.New()
+		router.Use(webauth.Authenticate(namedTokenFinder{user: user, token: expired}, webauth.AuthenticateByToken))
+		router.GET("/v2/jobs/:ID", ok)
+		w := httptest.NewRecorder()
+		req := mustRequest(t, "GET", "/v2/jobs/42", nil)
+		req.Header.Set(webauth.APIKey, token.AccessKey)
+		req.Header.Set(webauth.APISecret, token.Secret)
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
 func mustRequest(t *testing.T, method, url string, body io.Reader) *http.Request {
 	ctx := testutils.Context(t)
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
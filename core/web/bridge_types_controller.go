@@ -50,6 +50,12 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if hc := bt.HealthCheck(); hc != nil && ((hc.Scheme != "http" && hc.Scheme != "https") || hc.Host == "") {
+		fe.Add("HealthCheckURL must be an absolute http or https URL")
+	}
+	if err := bt.BridgeSecurity.Validate(); err != nil {
+		fe.Merge(err)
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -99,11 +105,12 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 	resource := presenters.NewBridgeResource(*bt)
 	resource.IncomingToken = bta.IncomingToken
 
-	btc.App.GetAuditLogger().Audit(audit.BridgeCreated, map[string]any{
+	audit.FromContext(ctx, btc.App.GetAuditLogger()).Audit(audit.BridgeCreated, map[string]any{
 		"bridgeName":                   bta.Name,
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
 		"bridgeURL":                    bta.URL,
+		"bridgeHealthCheckURL":         bt.HealthCheckURL,
 	})
 
 	jsonAPIResponse(c, resource, "bridge")
@@ -143,7 +150,11 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	resource := presenters.NewBridgeResource(bt)
+	if health := btc.App.BridgeHealth(); health != nil {
+		resource.Health = presenters.NewBridgeHealth(health.Health(bt.Name))
+	}
+	jsonAPIResponse(c, resource, "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
@@ -173,7 +184,8 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
-	if err := ValidateBridgeType(btr); err != nil {
+	updated := btr.WithStored(bt)
+	if err := ValidateBridgeType(&updated); err != nil {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
@@ -182,11 +194,12 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		return
 	}
 
-	btc.App.GetAuditLogger().Audit(audit.BridgeUpdated, map[string]any{
+	audit.FromContext(ctx, btc.App.GetAuditLogger()).Audit(audit.BridgeUpdated, map[string]any{
 		"bridgeName":                   bt.Name,
 		"bridgeConfirmations":          bt.Confirmations,
 		"bridgeMinimumContractPayment": bt.MinimumContractPayment,
 		"bridgeURL":                    bt.URL,
+		"bridgeHealthCheckURL":         bt.HealthCheckURL,
 	})
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
@@ -227,7 +240,7 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 		return
 	}
 
-	btc.App.GetAuditLogger().Audit(audit.BridgeDeleted, map[string]any{"name": name})
+	audit.FromContext(ctx, btc.App.GetAuditLogger()).Audit(audit.BridgeDeleted, map[string]any{"name": name})
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
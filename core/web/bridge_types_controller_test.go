@@ -2,6 +2,7 @@ package web_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -85,6 +86,51 @@ func TestValidateBridgeType(t *testing.T) {
 			},
 			models.NewJSONAPIErrorsWith("MinimumContractPayment must be positive"),
 		},
+		{
+			"valid HealthCheckURL",
+			bridges.BridgeTypeRequest{
+				Name:           "adapterwithhealthcheck",
+				URL:            cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				HealthCheckURL: ptr(cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080/health")),
+			},
+			nil,
+		},
+		{
+			"blank HealthCheckURL",
+			bridges.BridgeTypeRequest{
+				Name:           "adapterwithhealthcheck",
+				URL:            cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				HealthCheckURL: ptr(cltest.WebURL(t, "")),
+			},
+			nil,
+		},
+		{
+			"invalid relative HealthCheckURL",
+			bridges.BridgeTypeRequest{
+				Name:           "adapterwithhealthcheck",
+				URL:            cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				HealthCheckURL: ptr(cltest.WebURL(t, "/health")),
+			},
+			models.NewJSONAPIErrorsWith("HealthCheckURL must be an absolute http or https URL"),
+		},
+		{
+			"short request signing secret",
+			bridges.BridgeTypeRequest{
+				Name:           "adapterwithsigning",
+				URL:            cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				BridgeSecurity: bridges.BridgeSecurity{RequestSigningSecret: "secret"},
+			},
+			models.NewJSONAPIErrorsWith("request signing secret must be at least 32 characters"),
+		},
+		{
+			"invalid response verification key",
+			bridges.BridgeTypeRequest{
+				Name:           "adapterwithsigning",
+				URL:            cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				BridgeSecurity: bridges.BridgeSecurity{ResponseVerificationKey: "0xdeadbeef"},
+			},
+			models.NewJSONAPIErrorsWith("response verification key must be a hex encoded 32 byte ed25519 public key"),
+		},
 		{
 			"existing core adapter (no longer fails since core adapters no longer exist)",
 			bridges.BridgeTypeRequest{
@@ -275,13 +321,16 @@ func TestBridgeController_Show(t *testing.T) {
 
 	client := app.NewHTTPClient(nil)
 
+	healthCheckURL := cltest.WebURL(t, "https://testing.com/health")
 	bt := &bridges.BridgeType{
-		Name:          bridges.MustParseBridgeName(testutils.RandomizeName("showbridge")),
-		URL:           cltest.WebURL(t, "https://testing.com/bridges"),
-		Confirmations: 0,
+		Name:           bridges.MustParseBridgeName(testutils.RandomizeName("showbridge")),
+		URL:            cltest.WebURL(t, "https://testing.com/bridges"),
+		Confirmations:  0,
+		HealthCheckURL: &healthCheckURL,
 	}
 	ctx := testutils.Context(t)
 	require.NoError(t, app.BridgeORM().CreateBridgeType(ctx, bt))
+	app.BridgeHealth().RecordCheck(bt.Name, errors.New("503 Service Unavailable"))
 
 	resp, cleanup := client.Get("/v2/bridge_types/" + bt.Name.String())
 	t.Cleanup(cleanup)
@@ -292,6 +341,11 @@ func TestBridgeController_Show(t *testing.T) {
 	assert.Equal(t, bt.Name.String(), resource.Name, "should have the same name")
 	assert.Equal(t, bt.URL.String(), resource.URL, "should have the same URL")
 	assert.Equal(t, bt.Confirmations, resource.Confirmations, "should have the same Confirmations")
+	assert.Equal(t, healthCheckURL.String(), resource.HealthCheckURL)
+	require.NotNil(t, resource.Health)
+	assert.Equal(t, "unhealthy", resource.Health.Status)
+	assert.Equal(t, "open", resource.Health.CircuitBreaker)
+	assert.Equal(t, "503 Service Unavailable", resource.Health.LastError)
 
 	resp, cleanup = client.Get("/v2/bridge_types/nosuchbridge")
 	t.Cleanup(cleanup)
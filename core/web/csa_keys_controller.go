@@ -46,7 +46,7 @@ func (ctrl *CSAKeysController) Create(c *gin.Context) {
 		return
 	}
 
-	ctrl.App.GetAuditLogger().Audit(audit.CSAKeyCreated, map[string]any{
+	audit.FromContext(ctx, ctrl.App.GetAuditLogger()).Audit(audit.CSAKeyCreated, map[string]any{
 		"CSAPublicKey": key.PublicKey,
 		"CSVersion":    key.Version,
 	})
@@ -54,6 +54,31 @@ func (ctrl *CSAKeysController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewCSAKeyResource(key), "csaKeys")
 }
 
+// AddExternal adds a CSA key held by the external signer
+// Example:
+// "POST <application>/keys/csa/external?handle=csa"
+func (ctrl *CSAKeysController) AddExternal(c *gin.Context) {
+	ctx := c.Request.Context()
+	key, err := ctrl.App.GetKeyStore().CSA().AddExternal(ctx, c.Query("handle"))
+	if err != nil {
+		if errors.Is(err, keystore.ErrCSAKeyExists) || errors.Is(err, keystore.ErrNoExternalSigner) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	audit.FromContext(ctx, ctrl.App.GetAuditLogger()).Audit(audit.CSAKeyCreated, map[string]any{
+		"CSAPublicKey": key.PublicKey,
+		"CSVersion":    key.Version,
+		"external":     true,
+	})
+
+	jsonAPIResponse(c, presenters.NewCSAKeyResource(key), "csaKeys")
+}
+
 // Import imports a CSA key
 func (ctrl *CSAKeysController) Import(c *gin.Context) {
 	defer ctrl.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Import request body")
@@ -71,7 +96,7 @@ func (ctrl *CSAKeysController) Import(c *gin.Context) {
 		return
 	}
 
-	ctrl.App.GetAuditLogger().Audit(audit.CSAKeyImported, map[string]any{
+	audit.FromContext(ctx, ctrl.App.GetAuditLogger()).Audit(audit.CSAKeyImported, map[string]any{
 		"CSAPublicKey": key.PublicKey,
 		"CSVersion":    key.Version,
 	})
@@ -92,6 +117,6 @@ func (ctrl *CSAKeysController) Export(c *gin.Context) {
 		return
 	}
 
-	ctrl.App.GetAuditLogger().Audit(audit.CSAKeyExported, map[string]any{"keyID": keyID})
+	audit.FromContext(c.Request.Context(), ctrl.App.GetAuditLogger()).Audit(audit.CSAKeyExported, map[string]any{"keyID": keyID})
 	c.Data(http.StatusOK, MediaType, bytes)
 }
@@ -150,12 +150,50 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 	c.Set("key", key)
 	c.Set("state", state)
 
-	ekc.app.GetAuditLogger().Audit(audit.KeyCreated, map[string]any{
+	audit.FromContext(c.Request.Context(), ekc.app.GetAuditLogger()).Audit(audit.KeyCreated, map[string]any{
 		"type": "ethereum",
 		"id":   key.ID(),
 	})
 }
 
+// AddExternal adds an ETH key held by the external signer
+// Example:
+// "POST <application>/keys/evm/external?handle=eth&evmChainID=1"
+func (ekc *ETHKeysController) AddExternal(c *gin.Context) {
+	ethKeyStore := ekc.app.GetKeyStore().Eth()
+
+	cid := c.Query("evmChainID")
+	chain, ok := ekc.getChain(c, cid)
+	if !ok {
+		return
+	}
+
+	key, err := ethKeyStore.AddExternal(c.Request.Context(), c.Query("handle"), chain.ID())
+	if err != nil {
+		if errors.Is(err, keystore.ErrKeyExists) || errors.Is(err, keystore.ErrNoExternalSigner) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	state, err := ethKeyStore.GetState(c.Request.Context(), key.ID(), chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Set("key", key)
+	c.Set("state", state)
+
+	audit.FromContext(c.Request.Context(), ekc.app.GetAuditLogger()).Audit(audit.KeyCreated, map[string]any{
+		"type":     "ethereum",
+		"id":       key.ID(),
+		"external": true,
+	})
+}
+
 // Delete an ETH key bundle (irreversible!)
 // Example:
 // "DELETE <application>/keys/eth/:keyID"
@@ -193,7 +231,7 @@ func (ekc *ETHKeysController) Delete(c *gin.Context) {
 	c.Set("key", key)
 	c.Set("state", state)
 
-	ekc.app.GetAuditLogger().Audit(audit.KeyDeleted, map[string]any{
+	audit.FromContext(c.Request.Context(), ekc.app.GetAuditLogger()).Audit(audit.KeyDeleted, map[string]any{
 		"type": "ethereum",
 		"id":   keyID,
 	})
@@ -232,7 +270,7 @@ func (ekc *ETHKeysController) Import(c *gin.Context) {
 	c.Set("state", state)
 	c.Status(http.StatusCreated)
 
-	ekc.app.GetAuditLogger().Audit(audit.KeyImported, map[string]any{
+	audit.FromContext(c.Request.Context(), ekc.app.GetAuditLogger()).Audit(audit.KeyImported, map[string]any{
 		"type": "ethereum",
 		"id":   key.ID(),
 	})
@@ -250,7 +288,7 @@ func (ekc *ETHKeysController) Export(c *gin.Context) {
 		return
 	}
 
-	ekc.app.GetAuditLogger().Audit(audit.KeyExported, map[string]any{
+	audit.FromContext(c.Request.Context(), ekc.app.GetAuditLogger()).Audit(audit.KeyExported, map[string]any{
 		"type": "ethereum",
 		"id":   id,
 	})
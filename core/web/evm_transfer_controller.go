@@ -67,7 +67,7 @@ func (tc *EVMTransfersController) Create(c *gin.Context) {
 		return
 	}
 
-	tc.App.GetAuditLogger().Audit(audit.EthTransactionCreated, map[string]any{
+	audit.FromContext(c.Request.Context(), tc.App.GetAuditLogger()).Audit(audit.EthTransactionCreated, map[string]any{
 		"ethTX": etx,
 	})
 
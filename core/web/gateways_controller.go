@@ -0,0 +1,93 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// GatewaysController lets admins inspect the gateways of the running gateway
+// jobs, and disconnect their nodes.
+type GatewaysController struct {
+	App chainlink.Application
+}
+
+// Index lists the status of all running gateways.
+// Example:
+// "GET <application>/gateways"
+func (gc *GatewaysController) Index(c *gin.Context) {
+	registry := gc.App.GatewayRegistry()
+	resources := []presenters.GatewayResource{}
+	for _, jobID := range registry.JobIDs() {
+		// the job may have stopped since it was listed
+		if gw, ok := registry.Get(jobID); ok {
+			resources = append(resources, *presenters.NewGatewayResource(jobID, gw.Status()))
+		}
+	}
+	jsonAPIResponse(c, resources, "gateways")
+}
+
+// Show returns the status of the gateway of a job, whose ID is given.
+// Example:
+// "GET <application>/gateways/:ID"
+func (gc *GatewaysController) Show(c *gin.Context) {
+	jobID, gw, ok := gc.findGateway(c)
+	if !ok {
+		return
+	}
+	jsonAPIResponse(c, presenters.NewGatewayResource(jobID, gw.Status()), "gateways")
+}
+
+// DisconnectNode closes the connection of a node of a DON to a gateway. The
+// node is free to reconnect.
+// Example:
+// "DELETE <application>/gateways/:ID/dons/:donID/nodes/:address"
+func (gc *GatewaysController) DisconnectNode(c *gin.Context) {
+	jobID, gw, ok := gc.findGateway(c)
+	if !ok {
+		return
+	}
+	donID, address := c.Param("donID"), c.Param("address")
+	if err := gw.DisconnectNode(donID, address); err != nil {
+		switch {
+		case errors.Is(err, gateway.ErrNodeNotFound):
+			jsonAPIError(c, http.StatusNotFound, err)
+		case errors.Is(err, gateway.ErrNodeNotConnected):
+			jsonAPIError(c, http.StatusConflict, err)
+		default:
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	audit.FromContext(c.Request.Context(), gc.App.GetAuditLogger()).Audit(audit.GatewayNodeDisconnected, map[string]any{
+		"jobID":       jobID,
+		"donID":       donID,
+		"nodeAddress": address,
+	})
+	jsonAPIResponseWithStatus(c, nil, "gateways", http.StatusNoContent)
+}
+
+// findGateway returns the running gateway of the job given by the ID param,
+// or responds with an error.
+func (gc *GatewaysController) findGateway(c *gin.Context) (int32, gateway.Gateway, bool) {
+	jobID, err := strconv.ParseInt(c.Param("ID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid job ID: %w", err))
+		return 0, nil, false
+	}
+	gw, ok := gc.App.GatewayRegistry().Get(int32(jobID))
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, fmt.Errorf("no gateway running for job %d", jobID))
+		return 0, nil, false
+	}
+	return int32(jobID), gw, true
+}
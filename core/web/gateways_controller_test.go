@@ -0,0 +1,120 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/settings/limits"
+
+	appmocks "github.com/smartcontractkit/chainlink/v2/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+)
+
+const gatewayNodeAddress = "0x0001020304050607080900010203040506070809"
+
+func newGatewaysController(t *testing.T) *web.GatewaysController {
+	var cfg config.GatewayConfig
+	require.NoError(t, toml.Unmarshal([]byte(`
+[nodeServerConfig]
+Path = "/node"
+[userServerConfig]
+Path = "/user"
+[[dons]]
+DonId = "my_don"
+HandlerName = "dummy"
+[[dons.Members]]
+Name = "node one"
+Address = "`+gatewayNodeAddress+`"
+`), &cfg))
+	lggr := logger.Test(t)
+	handlerFactory := gateway.NewHandlerFactory(nil, nil, nil, nil, nil, nil, lggr, limits.Factory{Logger: lggr})
+	gw, err := gateway.NewGatewayFromConfig(&cfg, handlerFactory, nil, lggr, limits.Factory{Logger: lggr})
+	require.NoError(t, err)
+
+	registry := gateway.NewRegistry()
+	registry.Add(7, gw)
+	app := appmocks.NewApplication(t)
+	app.EXPECT().GatewayRegistry().Return(registry).Maybe()
+	return &web.GatewaysController{App: app}
+}
+
+func serveGateways(t *testing.T, handler gin.HandlerFunc, method string, path string, params gin.Params) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	var err error
+	c.Request, err = http.NewRequestWithContext(t.Context(), method, path, nil)
+	require.NoError(t, err)
+	c.Params = params
+	handler(c)
+	return w
+}
+
+func TestGatewaysController_Index(t *testing.T) {
+	controller := newGatewaysController(t)
+
+	w := serveGateways(t, controller.Index, http.MethodGet, "/v2/gateways", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var document struct {
+		Data []struct {
+			ID         string `json:"id"`
+			Attributes struct {
+				DONs []struct {
+					DonID string `json:"donID"`
+					Nodes []struct {
+						Address   string `json:"address"`
+						Connected bool   `json:"connected"`
+					} `json:"nodes"`
+				} `json:"dons"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &document))
+	require.Len(t, document.Data, 1)
+	assert.Equal(t, "7", document.Data[0].ID)
+	require.Len(t, document.Data[0].Attributes.DONs, 1)
+	don := document.Data[0].Attributes.DONs[0]
+	assert.Equal(t, "my_don", don.DonID)
+	require.Len(t, don.Nodes, 1)
+	assert.Equal(t, gatewayNodeAddress, don.Nodes[0].Address)
+	assert.False(t, don.Nodes[0].Connected)
+}
+
+func TestGatewaysController_Show(t *testing.T) {
+	controller := newGatewaysController(t)
+
+	w := serveGateways(t, controller.Show, http.MethodGet, "/v2/gateways/7", gin.Params{{Key: "ID", Value: "7"}})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serveGateways(t, controller.Show, http.MethodGet, "/v2/gateways/8", gin.Params{{Key: "ID", Value: "8"}})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = serveGateways(t, controller.Show, http.MethodGet, "/v2/gateways/x", gin.Params{{Key: "ID", Value: "x"}})
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestGatewaysController_DisconnectNode(t *testing.T) {
+	controller := newGatewaysController(t)
+	params := func(donID string, address string) gin.Params {
+		return gin.Params{{Key: "ID", Value: "7"}, {Key: "donID", Value: donID}, {Key: "address", Value: address}}
+	}
+
+	w := serveGateways(t, controller.DisconnectNode, http.MethodDelete, "/v2/gateways/7/dons/my_don/nodes/"+gatewayNodeAddress, params("my_don", gatewayNodeAddress))
+	assert.Equal(t, http.StatusConflict, w.Code, "node is not connected")
+
+	w = serveGateways(t, controller.DisconnectNode, http.MethodDelete, "/v2/gateways/7/dons/other_don/nodes/"+gatewayNodeAddress, params("other_don", gatewayNodeAddress))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = serveGateways(t, controller.DisconnectNode, http.MethodDelete, "/v2/gateways/7/dons/my_don/nodes/0x01", params("my_don", "0x01"))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -1,10 +1,13 @@
 package web
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +15,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	ccip "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
@@ -30,6 +36,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -70,29 +77,96 @@ func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 // Example:
 // "GET <application>/jobs/:ID"
 func (jc *JobsController) Show(c *gin.Context) {
+	jobSpec, status, err := jc.findJob(c.Request.Context(), c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+}
+
+// Graph renders the parsed pipeline of a job, including the implicit edges between tasks, as dot, mermaid or json
+// (default). If runID is given, the timings and errors of the tasks in that run are overlaid on the graph.
+// :ID could be both job ID and external job ID
+// Example:
+// "GET <application>/jobs/:ID/graph?format=dot&runID=1"
+func (jc *JobsController) Graph(c *gin.Context) {
 	ctx := c.Request.Context()
-	var err error
-	jobSpec := job.Job{}
-	if externalJobID, pErr := uuid.Parse(c.Param("ID")); pErr == nil {
+	jobSpec, status, err := jc.findJob(ctx, c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+	if jobSpec.PipelineSpec == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("job has no pipeline"))
+		return
+	}
+	dotDagSource := jobSpec.PipelineSpec.DotDagSource
+
+	var run *pipeline.Run
+	if runIDStr := c.Query("runID"); runIDStr != "" {
+		run = &pipeline.Run{}
+		if err = run.SetID(runIDStr); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		*run, err = jc.App.PipelineORM().FindRun(ctx, run.ID)
+		if errors.Is(err, sql.ErrNoRows) || (err == nil && run.PipelineSpec.JobID != jobSpec.ID) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+			return
+		} else if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		// render the pipeline the run was executed with, which may predate an update of the job
+		dotDagSource = run.PipelineSpec.DotDagSource
+	}
+
+	export, err := pipeline.NewGraphExport(dotDagSource)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if run != nil {
+		export.OverlayRun(*run)
+	}
+
+	format := pipeline.GraphFormat(c.DefaultQuery("format", string(pipeline.GraphFormatJSON)))
+	rendered, err := export.Render(format)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	contentType := "text/plain; charset=utf-8"
+	switch format {
+	case pipeline.GraphFormatDOT:
+		contentType = "text/vnd.graphviz; charset=utf-8"
+	case pipeline.GraphFormatJSON:
+		contentType = "application/json; charset=utf-8"
+	}
+	c.Data(http.StatusOK, contentType, rendered)
+}
+
+// findJob looks a job up by job ID or external job ID, returning the status to respond with if it fails.
+func (jc *JobsController) findJob(ctx context.Context, id string) (jb job.Job, statusCode int, err error) {
+	if externalJobID, pErr := uuid.Parse(id); pErr == nil {
 		// Find a job by external job ID
-		jobSpec, err = jc.App.JobORM().FindJobByExternalJobID(ctx, externalJobID)
-	} else if pErr = jobSpec.SetID(c.Param("ID")); pErr == nil {
+		jb, err = jc.App.JobORM().FindJobByExternalJobID(ctx, externalJobID)
+	} else if pErr = jb.SetID(id); pErr == nil {
 		// Find a job by job ID
-		jobSpec, err = jc.App.JobORM().FindJob(ctx, jobSpec.ID)
+		jb, err = jc.App.JobORM().FindJob(ctx, jb.ID)
 	} else {
-		jsonAPIError(c, http.StatusUnprocessableEntity, pErr)
-		return
+		return jb, http.StatusUnprocessableEntity, pErr
 	}
 	if err != nil {
 		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
-			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
-		} else {
-			jsonAPIError(c, http.StatusInternalServerError, err)
+			return jb, http.StatusNotFound, errors.New("job not found")
 		}
-		return
+		return jb, http.StatusInternalServerError, err
 	}
-
-	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+	return jb, http.StatusOK, nil
 }
 
 // CreateJobRequest represents a request to create and start a job (V2).
@@ -130,7 +204,7 @@ func (jc *JobsController) Create(c *gin.Context) {
 
 	jbj, err := json.Marshal(jb)
 	if err == nil {
-		jc.App.GetAuditLogger().Audit(audit.JobCreated, map[string]any{"job": string(jbj)})
+		audit.FromContext(c.Request.Context(), jc.App.GetAuditLogger()).Audit(audit.JobCreated, map[string]any{"job": string(jbj)})
 	} else {
 		jc.App.GetLogger().Errorw("Could not send audit log for JobCreation", "err", err)
 	}
@@ -160,10 +234,166 @@ func (jc *JobsController) Delete(c *gin.Context) {
 		return
 	}
 
-	jc.App.GetAuditLogger().Audit(audit.JobDeleted, map[string]any{"id": j.ID})
+	audit.FromContext(c.Request.Context(), jc.App.GetAuditLogger()).Audit(audit.JobDeleted, map[string]any{"id": j.ID})
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
 
+// PatchJobRequest represents a request to change the state of a job without replacing its spec.
+type PatchJobRequest struct {
+	Paused *bool `json:"paused"`
+}
+
+// Patch pauses or resumes a job. A paused job keeps its spec, keys and run
+// history, but its services are stopped until it is resumed.
+// Example:
+// "PATCH <application>/jobs/:ID"
+func (jc *JobsController) Patch(c *gin.Context) {
+	request := PatchJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Paused == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("paused must be set"))
+		return
+	}
+
+	jb, status, err := jc.findJob(c.Request.Context(), c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	event := audit.JobResumed
+	if *request.Paused {
+		event = audit.JobPaused
+		err = jc.App.PauseJob(c.Request.Context(), jb.ID)
+	} else {
+		err = jc.App.ResumeJob(c.Request.Context(), jb.ID)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jb.Paused = *request.Paused
+
+	audit.FromContext(c.Request.Context(), jc.App.GetAuditLogger()).Audit(event, map[string]any{"id": jb.ID})
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// Export returns a tar bundle of the given jobs, or of all jobs if none is
+// given, with the bridges and external initiators they reference. Jobs created
+// before their specs were recorded can't be exported, and fail the export with
+// an error naming them.
+// Example:
+// "GET <application>/jobs/export?id=1&id=2"
+func (jc *JobsController) Export(c *gin.Context) {
+	ctx := c.Request.Context()
+	var jobs []job.Job
+	if ids := c.QueryArray("id"); len(ids) > 0 {
+		for _, id := range ids {
+			jb, status, err := jc.findJob(ctx, id)
+			if err != nil {
+				jsonAPIError(c, status, errors.Wrapf(err, "job %s", id))
+				return
+			}
+			jobs = append(jobs, jb)
+		}
+	} else {
+		var err error
+		jobs, _, err = jc.App.JobORM().FindJobs(ctx, 0, math.MaxUint32)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	bundle, err := job.NewBundle(ctx, jc.App.JobORM(), jc.App.BridgeORM(), jobs)
+	var unrecordedErr *job.UnrecordedJobsError
+	if errors.As(err, &unrecordedErr) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	var buf bytes.Buffer
+	if err = bundle.Write(&buf); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	audit.FromContext(ctx, jc.App.GetAuditLogger()).Audit(audit.JobsExported, map[string]any{"jobs": len(bundle.Specs)})
+	c.Data(http.StatusOK, "application/x-tar", buf.Bytes())
+}
+
+// Import creates the jobs of a bundle written by Export, along with the
+// bridges and external initiators they reference that don't exist yet. Either
+// every job is created or none is.
+// Example:
+// "POST <application>/jobs/import"
+func (jc *JobsController) Import(c *gin.Context) {
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, jc.App.GetConfig().WebServer().HTTPMaxSize())
+	bundle, err := job.ReadBundle(body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			jsonAPIError(c, http.StatusRequestEntityTooLarge, err)
+			return
+		}
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	for i, spec := range bundle.Specs {
+		if _, err = job.ValidateSpec(spec); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, &job.BundleJobError{Index: i, Err: errors.Wrap(err, "failed to parse TOML")})
+			return
+		}
+	}
+
+	jobs, err := jc.App.ImportJobs(c.Request.Context(), bundle, func(ctx context.Context, ds sqlutil.DataSource, spec string) (job.Job, error) {
+		eiManager := txExternalInitiatorManager{jc.App.GetExternalInitiatorManager(), jc.App.BridgeORM().WithDataSource(ds)}
+		jb, _, err := jc.validateJobSpecWith(ctx, spec, eiManager)
+		return jb, err
+	})
+	if err != nil {
+		var bundleErr *job.BundleJobError
+		if errors.As(err, &bundleErr) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := make([]presenters.JobResource, 0, len(jobs))
+	for _, jb := range jobs {
+		jbj, err := json.Marshal(jb)
+		if err == nil {
+			audit.FromContext(c.Request.Context(), jc.App.GetAuditLogger()).Audit(audit.JobCreated, map[string]any{"job": string(jbj)})
+		} else {
+			jc.App.GetLogger().Errorw("Could not send audit log for JobCreation", "err", err)
+		}
+		resources = append(resources, *presenters.NewJobResource(jb))
+	}
+	jsonAPIResponse(c, resources, "jobs")
+}
+
+// txExternalInitiatorManager finds external initiators within the import
+// transaction, so that webhook specs can refer to initiators imported with them.
+type txExternalInitiatorManager struct {
+	webhook.ExternalInitiatorManager
+	orm bridges.ORM
+}
+
+func (m txExternalInitiatorManager) FindExternalInitiatorByName(ctx context.Context, name string) (bridges.ExternalInitiator, error) {
+	return m.orm.FindExternalInitiatorByName(ctx, name)
+}
+
 // UpdateJobRequest represents a request to update a job with new toml and start a job (V2).
 type UpdateJobRequest struct {
 	TOML string `json:"toml"`
@@ -191,23 +421,39 @@ func (jc *JobsController) Update(c *gin.Context) {
 		return
 	}
 
+	jc.replaceJob(c, &jb)
+}
+
+// replaceJob replaces the job with the ID of jb by jb, either in place or by stopping and deleting it, then saving and
+// starting jb. jb keeps the external job ID of the job it replaces unless its spec sets one, so that its revisions
+// continue the same history.
+func (jc *JobsController) replaceJob(c *gin.Context, jb *job.Job) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
-	// If the provided job id is not matching any job, delete will fail with 404 leaving state unchanged.
-	err = jc.App.DeleteJob(ctx, jb.ID)
-	// Error can be either come from ORM or from the activeJobs map.
+	existing, err := jc.App.JobORM().FindJob(ctx, jb.ID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) || strings.Contains(err.Error(), "job not found") {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
 			jsonAPIError(c, http.StatusNotFound, errors.Wrap(err, "failed to update job"))
 			return
 		}
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	if jb.ExternalJobID == (uuid.UUID{}) {
+		jb.ExternalJobID = existing.ExternalJobID
+	}
+	// A paused job stays paused when its spec is replaced.
+	jb.Paused = existing.Paused
 
-	err = jc.App.AddJobV2(ctx, &jb)
+	// If the provided job id is not matching any job, the update will fail with 404 leaving state unchanged.
+	err = jc.App.UpdateJob(ctx, existing.ID, jb)
 	if err != nil {
+		// Error can be either come from ORM or from the activeJobs map.
+		if errors.Is(err, sql.ErrNoRows) || strings.Contains(err.Error(), "job not found") {
+			jsonAPIError(c, http.StatusNotFound, errors.Wrap(err, "failed to update job"))
+			return
+		}
 		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
 			jsonAPIError(c, http.StatusBadRequest, err)
 			return
@@ -216,10 +462,166 @@ func (jc *JobsController) Update(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+	jsonAPIResponse(c, presenters.NewJobResource(*jb), jb.Type.String())
+}
+
+// Revisions lists the recorded revisions of a job's spec, oldest first.
+// :ID could be both job ID and external job ID
+// Example:
+// "GET <application>/jobs/:ID/revisions"
+func (jc *JobsController) Revisions(c *gin.Context) {
+	ctx := c.Request.Context()
+	jb, status, err := jc.findJob(ctx, c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	revisions, err := jc.App.JobORM().FindJobRevisions(ctx, jb.ExternalJobID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobRevisionResources(revisions), "jobRevisions")
+}
+
+// RevisionsDiff returns the unified diff between two revisions of a job's spec. to defaults to the latest revision
+// and from to the one before it.
+// :ID could be both job ID and external job ID
+// Example:
+// "GET <application>/jobs/:ID/revisions/diff?from=1&to=3"
+func (jc *JobsController) RevisionsDiff(c *gin.Context) {
+	ctx := c.Request.Context()
+	jb, status, err := jc.findJob(ctx, c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	revisions, err := jc.App.JobORM().FindJobRevisions(ctx, jb.ExternalJobID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(revisions) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job has no revisions"))
+		return
+	}
+
+	findRevision := func(param string, dflt int32) (job.JobRevision, bool) {
+		rev := dflt
+		if s := c.Query(param); s != "" {
+			parsed, perr := strconv.ParseInt(s, 10, 32)
+			if perr != nil {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrapf(perr, "invalid %s revision", param))
+				return job.JobRevision{}, false
+			}
+			rev = int32(parsed)
+		}
+		for _, r := range revisions {
+			if r.Revision == rev {
+				return r, true
+			}
+		}
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("revision %d not found", rev))
+		return job.JobRevision{}, false
+	}
+	to, ok := findRevision("to", revisions[len(revisions)-1].Revision)
+	if !ok {
+		return
+	}
+	from, ok := findRevision("from", max(to.Revision-1, 1))
+	if !ok {
+		return
+	}
+
+	diff, err := job.DiffJobRevisions(from, to)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(diff))
+}
+
+// Rollback replaces a job with the spec of one of its earlier revisions, which is recorded as a new revision.
+// Example:
+// "POST <application>/jobs/:ID/rollback/:rev"
+func (jc *JobsController) Rollback(c *gin.Context) {
+	ctx := c.Request.Context()
+	current, status, err := jc.findJob(ctx, c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	rev, err := strconv.ParseInt(c.Param("rev"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid revision"))
+		return
+	}
+	revision, err := jc.App.JobORM().FindJobRevision(ctx, current.ExternalJobID, int32(rev))
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("revision %d not found", rev))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(ctx, revision.TOML)
+	if err != nil {
+		jsonAPIError(c, status, errors.Wrapf(err, "revision %d is no longer valid", rev))
+		return
+	}
+	jb.ID = current.ID
+	jb.ExternalJobID = current.ExternalJobID
+
+	jc.replaceJob(c, &jb)
+}
+
+// SimulateJobRequest represents a request to execute a job's pipeline without creating the job.
+type SimulateJobRequest struct {
+	TOML string `json:"toml"`
+	// Vars are the pipeline variables the run is started with, e.g. jobRun.requestBody for a webhook job.
+	Vars map[string]any `json:"vars"`
+	// Stubs are the responses returned in place of executing bridge, cache, http, ethcall,
+	// estimategaslimit and ethtx tasks, keyed by dot ID.
+	Stubs pipeline.TaskStubs `json:"stubs"`
+}
+
+// Simulate validates a job spec and executes its pipeline in memory, without saving the job, persisting the run or
+// reaching any adapter, URL or chain.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	run, _, err := jc.App.SimulateJobV2(c.Request.Context(), &jb, request.Vars, request.Stubs)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineRunResource(*run, jc.App.GetLogger()), "pipelineRun")
 }
 
 func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string) (jb job.Job, statusCode int, err error) {
+	return jc.validateJobSpecWith(ctx, tomlString, jc.App.GetExternalInitiatorManager())
+}
+
+func (jc *JobsController) validateJobSpecWith(ctx context.Context, tomlString string, eiManager webhook.ExternalInitiatorManager) (jb job.Job, statusCode int, err error) {
 	jobType, err := job.ValidateSpec(tomlString)
 	if err != nil {
 		return jb, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
@@ -249,7 +651,7 @@ func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string
 	case job.VRF:
 		jb, err = vrfcommon.ValidatedVRFSpec(tomlString)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(ctx, tomlString, jc.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(ctx, tomlString, eiManager)
 	case job.BlockhashStore:
 		jb, err = blockhashstore.ValidatedSpec(tomlString)
 	case job.BlockHeaderFeeder:
@@ -277,5 +679,6 @@ func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string
 	if err != nil {
 		return jb, http.StatusBadRequest, err
 	}
+	jb.TOML = tomlString
 	return jb, 0, nil
 }
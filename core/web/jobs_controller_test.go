@@ -2,6 +2,7 @@ package web_test
 
 import (
 	"bytes"
+	"database/sql"
 	_ "embed"
 	"encoding/hex"
 	"encoding/json"
@@ -11,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -31,6 +33,7 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
 	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -39,6 +42,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -535,6 +540,54 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 //go:embed webhook-spec-template.yml
 var webhookSpecTemplate string
 
+func TestJobsController_Simulate_WebhookSpec(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+
+	client := app.NewHTTPClient(nil)
+
+	tomlStr := testspecs.GetWebhookSpecNoBody(uuid.New(), fetchBridge.Name.String(), submitBridge.Name.String())
+	body, _ := json.Marshal(web.SimulateJobRequest{
+		TOML: tomlStr,
+		Stubs: pipeline.TaskStubs{
+			"fetch":  {Value: `{"data":{"result":"1.23"}}`},
+			"submit": {Value: "ok"},
+		},
+	})
+	response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	resource := presenters.PipelineRunResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	require.Len(t, resource.TaskRuns, 4)
+	assert.Empty(t, resource.AllErrors)
+	require.Len(t, resource.Outputs, 1)
+	assert.Equal(t, "ok", *resource.Outputs[0])
+
+	// neither the job nor the run is persisted
+	jobs, count, err := app.JobORM().FindJobs(testutils.Context(t), 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+	assert.Zero(t, count)
+	runs, count, err := app.JobORM().PipelineRuns(testutils.Context(t), nil, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+	assert.Zero(t, count)
+
+	// stubs may only replace side-effecting tasks
+	body, _ = json.Marshal(web.SimulateJobRequest{
+		TOML:  tomlStr,
+		Stubs: pipeline.TaskStubs{"multiply": {Value: "1"}},
+	})
+	response, cleanup = client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	defer cleanup()
+	assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+}
+
 func TestJobsController_FailToCreate_EmptyJsonAttribute(t *testing.T) {
 	app := cltest.NewApplicationEVMDisabled(t)
 	require.NoError(t, app.Start(testutils.Context(t)))
@@ -638,6 +691,48 @@ func TestJobsController_Show_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_Graph(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+	graphURL := "/v2/jobs/" + strconv.Itoa(int(jobID)) + "/graph"
+
+	response, cleanup := client.Get(graphURL)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var export pipeline.GraphExport
+	require.NoError(t, json.Unmarshal(cltest.ParseResponseBody(t, response), &export))
+	require.Len(t, export.Nodes, 8)
+	require.Len(t, export.Edges, 7)
+	for _, node := range export.Nodes {
+		assert.Nil(t, node.Run)
+	}
+
+	response, cleanup = client.Get(graphURL + "?format=dot&runID=" + strconv.FormatInt(runIDs[0], 10))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	assert.Equal(t, "text/vnd.graphviz; charset=utf-8", response.Header.Get("Content-Type"))
+	dot := string(cltest.ParseResponseBody(t, response))
+	assert.Contains(t, dot, `"ds1" -> "ds1_parse";`)
+	assert.Contains(t, dot, `error: uh oh" color=red];`)
+
+	response, cleanup = client.Get(graphURL + "?format=mermaid")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	assert.True(t, strings.HasPrefix(string(cltest.ParseResponseBody(t, response)), "flowchart TD\n"))
+
+	response, cleanup = client.Get(graphURL + "?format=svg")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get(graphURL + "?runID=999999")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Get("/v2/jobs/999999999/graph")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func TestJobsController_Update_HappyPath(t *testing.T) {
 	ctx := testutils.Context(t)
 	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
@@ -704,6 +799,270 @@ func TestJobsController_Update_HappyPath(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusOK)
 }
 
+func TestJobsController_RevisionsAndRollback(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	webhookSpec := func(name string) string {
+		return fmt.Sprintf(`
+type              = "webhook"
+schemaVersion     = 1
+name              = "%s"
+observationSource = """
+answer [type=memo value="1"];
+"""
+`, name)
+	}
+
+	body, _ := json.Marshal(web.CreateJobRequest{TOML: webhookSpec("original")})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	created := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &created))
+	jobURL := "/v2/jobs/" + created.ID
+
+	body, _ = json.Marshal(web.UpdateJobRequest{TOML: webhookSpec("updated")})
+	response, cleanup = client.Put(jobURL, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	updated := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &updated))
+	// the external job ID is kept across the update, since the spec doesn't set one
+	assert.Equal(t, created.ExternalJobID, updated.ExternalJobID)
+
+	response, cleanup = client.Get(jobURL + "/revisions")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var revisions []presenters.JobRevisionResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &revisions))
+	require.Len(t, revisions, 2)
+	assert.Equal(t, "1", revisions[0].ID)
+	assert.Equal(t, webhookSpec("original"), revisions[0].TOML)
+	assert.Equal(t, "2", revisions[1].ID)
+	assert.Equal(t, webhookSpec("updated"), revisions[1].TOML)
+
+	response, cleanup = client.Get(jobURL + "/revisions/diff")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	diff := string(cltest.ParseResponseBody(t, response))
+	assert.Contains(t, diff, "--- revision 1\n+++ revision 2\n")
+	assert.Contains(t, diff, `-name              = "original"`)
+	assert.Contains(t, diff, `+name              = "updated"`)
+
+	response, cleanup = client.Get(jobURL + "/revisions/diff?from=3")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Post(jobURL+"/rollback/1", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	rolledBack := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &rolledBack))
+	assert.Equal(t, created.ID, rolledBack.ID)
+	assert.Equal(t, "original", rolledBack.Name)
+
+	jobRevisions, err := app.JobORM().FindJobRevisions(testutils.Context(t), created.ExternalJobID)
+	require.NoError(t, err)
+	require.Len(t, jobRevisions, 3)
+	assert.Equal(t, webhookSpec("original"), jobRevisions[2].TOML)
+
+	response, cleanup = client.Post(jobURL+"/rollback/7", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestJobsController_Revisions_CreatedWithGraphQL(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	spec := `
+type              = "webhook"
+schemaVersion     = 1
+name              = "graphql"
+observationSource = """
+answer [type=memo value="1"];
+"""
+`
+	body, _ := json.Marshal(map[string]any{
+		"query":     `mutation CreateJob($input: CreateJobInput!) { createJob(input: $input) { ... on CreateJobSuccess { job { id } } } }`,
+		"variables": map[string]any{"input": map[string]any{"TOML": spec}},
+	})
+	response, cleanup := client.Post("/query", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var created struct {
+		Data struct {
+			CreateJob struct {
+				Job struct {
+					ID string `json:"id"`
+				} `json:"job"`
+			} `json:"createJob"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(cltest.ParseResponseBody(t, response), &created))
+	require.NotEmpty(t, created.Data.CreateJob.Job.ID)
+
+	response, cleanup = client.Get("/v2/jobs/" + created.Data.CreateJob.Job.ID + "/revisions")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var revisions []presenters.JobRevisionResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &revisions))
+	require.Len(t, revisions, 1)
+	assert.Equal(t, "1", revisions[0].ID)
+	assert.Equal(t, spec, revisions[0].TOML)
+}
+
+func TestJobsController_PauseResume(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	spec := `
+type              = "webhook"
+schemaVersion     = 1
+observationSource = """
+answer [type=memo value="1"];
+"""
+`
+	body, _ := json.Marshal(web.CreateJobRequest{TOML: spec})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	created := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &created))
+	assert.False(t, created.Paused)
+	jobURL := "/v2/jobs/" + created.ID
+	id, err := strconv.ParseInt(created.ID, 10, 32)
+	require.NoError(t, err)
+	jobID := int32(id)
+
+	patch := func(t *testing.T, url string, body string, status int) presenters.JobResource {
+		response, cleanup := client.Patch(url, strings.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, status)
+		resource := presenters.JobResource{}
+		if status == http.StatusOK {
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		}
+		return resource
+	}
+
+	paused := patch(t, jobURL, `{"paused": true}`, http.StatusOK)
+	assert.True(t, paused.Paused)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	// the paused state is kept when the spec is replaced
+	body, _ = json.Marshal(web.UpdateJobRequest{TOML: spec})
+	response, cleanup = client.Put(jobURL, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	resumed := patch(t, jobURL, `{"paused": false}`, http.StatusOK)
+	assert.False(t, resumed.Paused)
+	assert.Contains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	patch(t, jobURL, `{}`, http.StatusUnprocessableEntity)
+	patch(t, "/v2/jobs/999999", `{"paused": true}`, http.StatusNotFound)
+}
+
+func TestJobsController_ExportImport(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	_, bridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	spec := fmt.Sprintf(`
+type              = "webhook"
+schemaVersion     = 1
+observationSource = """
+fetch [type=bridge name="%s"];
+"""
+`, bridge.Name)
+	body, _ := json.Marshal(web.CreateJobRequest{TOML: spec})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	created := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &created))
+
+	response, cleanup = client.Get("/v2/jobs/export?id=" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	assert.Equal(t, "application/x-tar", response.Header.Get("Content-Type"))
+	exported := cltest.ParseResponseBody(t, response)
+	bundle, err := job.ReadBundle(bytes.NewReader(exported))
+	require.NoError(t, err)
+	assert.Equal(t, []string{fmt.Sprintf("externalJobID = %q\n", created.ExternalJobID) + spec}, bundle.Specs)
+	require.Len(t, bundle.Bridges, 1)
+	assert.Equal(t, bridge.Name, bundle.Bridges[0].Name)
+
+	t.Run("fails for jobs without a recorded spec", func(t *testing.T) {
+		unrecorded, err := webhook.ValidatedWebhookSpec(ctx, spec, nil)
+		require.NoError(t, err)
+		require.NoError(t, app.JobORM().CreateJob(ctx, &unrecorded))
+
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/export?id=%s&id=%d", created.ID, unrecorded.ID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+		assert.Contains(t, string(cltest.ParseResponseBody(t, response)), fmt.Sprintf("jobs %d were created before their specs were recorded", unrecorded.ID))
+
+		response, cleanup = client.Delete(fmt.Sprintf("/v2/jobs/%d", unrecorded.ID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNoContent)
+	})
+
+	// move the job to a "new" node by deleting it and its bridge first
+	response, cleanup = client.Delete("/v2/jobs/" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNoContent)
+	require.NoError(t, app.BridgeORM().DeleteBridgeType(ctx, bridge))
+
+	response, cleanup = client.Post("/v2/jobs/import", bytes.NewReader(exported))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var imported []presenters.JobResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &imported))
+	require.Len(t, imported, 1)
+	assert.Equal(t, created.ExternalJobID, imported[0].ExternalJobID)
+	restored, err := app.BridgeORM().FindBridge(ctx, bridge.Name)
+	require.NoError(t, err)
+	assert.Equal(t, bridge.OutgoingToken, restored.OutgoingToken)
+	assert.Equal(t, bridge.IncomingTokenHash, restored.IncomingTokenHash)
+
+	t.Run("applies nothing if any spec fails", func(t *testing.T) {
+		_, unused := cltest.NewBridgeType(t, cltest.BridgeOpts{})
+		var buf bytes.Buffer
+		missingInitiator := `
+type               = "webhook"
+schemaVersion      = 1
+externalInitiators = [{ name = "missing", spec = "{}" }]
+observationSource  = "answer [type=memo value=1];"
+`
+		require.NoError(t, job.Bundle{
+			Specs:   []string{spec, missingInitiator},
+			Bridges: []bridges.BridgeType{*unused},
+		}.Write(&buf))
+
+		_, before, err := app.JobORM().FindJobs(ctx, 0, 100)
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/import", &buf)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+		assert.Contains(t, string(cltest.ParseResponseBody(t, response)), "job 2 of bundle")
+
+		_, after, err := app.JobORM().FindJobs(ctx, 0, 100)
+		require.NoError(t, err)
+		assert.Equal(t, before, after)
+		_, err = app.BridgeORM().FindBridge(ctx, unused.Name)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
 func TestJobsController_Update_NonExistentID(t *testing.T) {
 	ctx := testutils.Context(t)
 	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
@@ -81,7 +81,7 @@ func (kc *keysController[K, R]) Create(c *gin.Context) {
 		return
 	}
 
-	kc.auditLogger.Audit(audit.KeyCreated, map[string]any{
+	audit.FromContext(ctx, kc.auditLogger).Audit(audit.KeyCreated, map[string]any{
 		"type": kc.typ,
 		"id":   key.ID(),
 	})
@@ -103,7 +103,7 @@ func (kc *keysController[K, R]) Delete(c *gin.Context) {
 		return
 	}
 
-	kc.auditLogger.Audit(audit.KeyDeleted, map[string]any{
+	audit.FromContext(ctx, kc.auditLogger).Audit(audit.KeyDeleted, map[string]any{
 		"type": kc.typ,
 		"id":   key.ID(),
 	})
@@ -127,7 +127,7 @@ func (kc *keysController[K, R]) Import(c *gin.Context) {
 		return
 	}
 
-	kc.auditLogger.Audit(audit.KeyImported, map[string]any{
+	audit.FromContext(ctx, kc.auditLogger).Audit(audit.KeyImported, map[string]any{
 		"type": kc.typ,
 		"id":   key.ID(),
 	})
@@ -138,6 +138,8 @@ func (kc *keysController[K, R]) Import(c *gin.Context) {
 func (kc *keysController[K, R]) Export(c *gin.Context) {
 	defer kc.lggr.ErrorIfFn(c.Request.Body.Close, "Error closing Export request body")
 
+	ctx := c.Request.Context()
+
 	keyID := c.Param("ID")
 	newPassword := c.Query("newpassword")
 	bytes, err := kc.ks.Export(keyID, newPassword)
@@ -146,7 +148,7 @@ func (kc *keysController[K, R]) Export(c *gin.Context) {
 		return
 	}
 
-	kc.auditLogger.Audit(audit.KeyExported, map[string]any{
+	audit.FromContext(ctx, kc.auditLogger).Audit(audit.KeyExported, map[string]any{
 		"type": kc.typ,
 		"id":   keyID,
 	})
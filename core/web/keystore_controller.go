@@ -0,0 +1,145 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeystoreController manages the keystore as a whole
+type KeystoreController struct {
+	App chainlink.Application
+}
+
+// RotateKeystorePasswordRequest defines the request to re-encrypt the
+// keystore under a new password. ScryptN and ScryptP default to the node's
+// configured scrypt parameters, and can't be lower than them.
+type RotateKeystorePasswordRequest struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+	ScryptN     int    `json:"scryptN,omitempty"`
+	ScryptP     int    `json:"scryptP,omitempty"`
+}
+
+// RotatePassword re-encrypts the keystore under a new password
+// Example:
+// "POST <application>/keystore/password"
+func (ctrl *KeystoreController) RotatePassword(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request RotateKeystorePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := utils.VerifyPasswordComplexity(request.NewPassword); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	scryptParams := utils.GetScryptParams(ctrl.App.GetConfig())
+	if request.ScryptN != 0 {
+		scryptParams.N = request.ScryptN
+	}
+	if request.ScryptP != 0 {
+		scryptParams.P = request.ScryptP
+	}
+
+	err := ctrl.App.GetKeyStore().RotatePassword(ctx, request.OldPassword, request.NewPassword, scryptParams)
+	if err != nil {
+		if errors.Is(err, keystore.ErrKeystorePasswordMismatch) {
+			audit.FromContext(ctx, ctrl.App.GetAuditLogger()).Audit(audit.KeystorePasswordRotationFailedMismatch, map[string]any{})
+			jsonAPIError(c, http.StatusConflict, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	audit.FromContext(ctx, ctrl.App.GetAuditLogger()).Audit(audit.KeystorePasswordRotated, map[string]any{
+		"scryptN": scryptParams.N,
+		"scryptP": scryptParams.P,
+	})
+
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
+
+// KeystoreBackupRequest defines the request to back up the keystore.
+type KeystoreBackupRequest struct {
+	Passphrase string `json:"passphrase"`
+}
+
+// KeystoreRestoreRequest defines the request to restore a keystore backup,
+// as returned by Backup.
+type KeystoreRestoreRequest struct {
+	Passphrase string          `json:"passphrase"`
+	Backup     json.RawMessage `json:"backup"`
+}
+
+// Backup returns the whole keystore encrypted under a backup passphrase, which has to be as complex as a keystore
+// password
+// Example:
+// "POST <application>/keystore/backup"
+func (ctrl *KeystoreController) Backup(c *gin.Context) {
+	defer ctrl.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Backup request body")
+
+	var request KeystoreBackupRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := utils.VerifyPasswordComplexity(request.Passphrase); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	bytes, err := ctrl.App.GetKeyStore().Backup(request.Passphrase)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	audit.FromContext(c.Request.Context(), ctrl.App.GetAuditLogger()).Audit(audit.KeystoreBackedUp, map[string]any{})
+	c.Data(http.StatusOK, MediaType, bytes)
+}
+
+// Restore adds the keys in a keystore backup which are missing from the keystore
+// Example:
+// "POST <application>/keystore/restore"
+func (ctrl *KeystoreController) Restore(c *gin.Context) {
+	defer ctrl.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Restore request body")
+	ctx := c.Request.Context()
+
+	var request KeystoreRestoreRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	added, err := ctrl.App.GetKeyStore().Restore(ctx, request.Backup, request.Passphrase)
+	if err != nil {
+		if errors.Is(err, keystore.ErrBackupPassphraseMismatch) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, keystore.ErrBackupManifestMismatch) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	audit.FromContext(ctx, ctrl.App.GetAuditLogger()).Audit(audit.KeystoreRestored, map[string]any{
+		"backupSHA256": added.SHA256,
+		"keys":         added.Keys,
+		"ethKeyStates": added.EthKeyStates,
+	})
+
+	jsonAPIResponse(c, presenters.NewKeystoreRestoreResource(added), "keystoreRestore")
+}
@@ -0,0 +1,138 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeystoreController_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	key, err := app.KeyStore.P2P().Create(ctx)
+	require.NoError(t, err)
+
+	const newPassword = "rotatedKeystorePassword123!"
+
+	testCases := []struct {
+		name           string
+		reqBody        string
+		wantStatusCode int
+		wantErrMessage string
+	}{
+		{
+			name:           "Invalid request",
+			reqBody:        "",
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Weak new password",
+			reqBody:        fmt.Sprintf(`{"oldPassword": %q, "newPassword": "foo"}`, cltest.Password),
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantErrMessage: fmt.Sprintf("%s	%s\n", utils.ErrMsgHeader, "password is less than 16 characters long"),
+		},
+		{
+			name:           "Incorrect old password",
+			reqBody:        fmt.Sprintf(`{"oldPassword": "wrong password", "newPassword": %q}`, newPassword),
+			wantStatusCode: http.StatusConflict,
+			wantErrMessage: "old keystore password did not match",
+		},
+		{
+			name:           "Invalid scrypt parameters",
+			reqBody:        fmt.Sprintf(`{"oldPassword": %q, "newPassword": %q, "scryptN": 3}`, cltest.Password, newPassword),
+			wantStatusCode: http.StatusInternalServerError,
+			wantErrMessage: "scrypt N must be a power of two greater than 1, got 3",
+		},
+		{
+			name:           "Success",
+			reqBody:        fmt.Sprintf(`{"oldPassword": %q, "newPassword": %q, "scryptN": 4}`, cltest.Password, newPassword),
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/keystore/password", bytes.NewBufferString(tc.reqBody))
+			t.Cleanup(cleanup)
+
+			require.Equal(t, tc.wantStatusCode, resp.StatusCode)
+			if tc.wantErrMessage != "" {
+				errors := cltest.ParseJSONAPIErrors(t, resp.Body)
+				require.Len(t, errors.Errors, 1)
+				assert.Equal(t, tc.wantErrMessage, errors.Errors[0].Detail)
+			}
+		})
+	}
+
+	// keys survive rotation, and the keystore accepts only the new password
+	_, err = app.KeyStore.P2P().Get(key.PeerID())
+	require.NoError(t, err)
+	require.Error(t, app.KeyStore.Unlock(ctx, cltest.Password))
+	require.NoError(t, app.KeyStore.Unlock(ctx, newPassword))
+}
+
+func TestKeystoreController_BackupRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	key, err := app.KeyStore.P2P().Create(ctx)
+	require.NoError(t, err)
+
+	resp, cleanup := client.Post("/v2/keystore/backup", bytes.NewBufferString(`{"passphrase": "backup"}`))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	weak, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(weak), "password is less than 16 characters long")
+
+	resp, cleanup = client.Post("/v2/keystore/backup", bytes.NewBufferString(`{"passphrase": "backup passphrase"}`))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	backup, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	_, err = app.KeyStore.P2P().Delete(ctx, key.PeerID())
+	require.NoError(t, err)
+
+	restore := func(passphrase string) (*http.Response, func()) {
+		body, err := json.Marshal(web.KeystoreRestoreRequest{Passphrase: passphrase, Backup: backup})
+		require.NoError(t, err)
+		return client.Post("/v2/keystore/restore", bytes.NewReader(body))
+	}
+
+	resp, cleanup = restore("wrong")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, cleanup = restore("backup passphrase")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var restored presenters.KeystoreRestoreResource
+	cltest.ParseJSONAPIResponse(t, resp, &restored)
+	assert.Equal(t, []string{key.ID()}, restored.Keys["P2P"])
+
+	_, err = app.KeyStore.P2P().Get(key.PeerID())
+	require.NoError(t, err)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -46,7 +47,7 @@ func (ocr2kc *OCR2KeysController) Create(c *gin.Context) {
 		return
 	}
 
-	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleCreated, map[string]any{
+	audit.FromContext(ctx, ocr2kc.App.GetAuditLogger()).Audit(audit.OCR2KeyBundleCreated, map[string]any{
 		"ocr2KeyID":                        key.ID(),
 		"ocr2KeyChainType":                 key.ChainType(),
 		"ocr2KeyConfigEncryptionPublicKey": key.ConfigEncryptionPublicKey(),
@@ -57,6 +58,34 @@ func (ocr2kc *OCR2KeysController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResource(key), "offChainReporting2KeyBundle")
 }
 
+// AddExternal adds an OCR2 key bundle whose signing keys are held by the external signer
+// Example:
+// "POST <application>/keys/ocr2/:chainType/external?onchainHandle=onchain&offchainHandle=offchain"
+func (ocr2kc *OCR2KeysController) AddExternal(c *gin.Context) {
+	ctx := c.Request.Context()
+	chainType := chaintype.ChainType(c.Param("chainType"))
+	key, err := ocr2kc.App.GetKeyStore().OCR2().AddExternal(ctx, chainType, c.Query("onchainHandle"), c.Query("offchainHandle"))
+	if errors.Is(err, keystore.ErrNoExternalSigner) {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	audit.FromContext(ctx, ocr2kc.App.GetAuditLogger()).Audit(audit.OCR2KeyBundleCreated, map[string]any{
+		"ocr2KeyID":                        key.ID(),
+		"ocr2KeyChainType":                 key.ChainType(),
+		"ocr2KeyConfigEncryptionPublicKey": key.ConfigEncryptionPublicKey(),
+		"ocr2KeyOffchainPublicKey":         key.OffchainPublicKey(),
+		"ocr2KeyMaxSignatureLength":        key.MaxSignatureLength(),
+		"ocr2KeyPublicKey":                 key.PublicKey(),
+		"external":                         true,
+	})
+	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResource(key), "offChainReporting2KeyBundle")
+}
+
 // Delete an OCR2 key bundle
 // Example:
 // "DELETE <application>/keys/ocr/:keyID"
@@ -74,7 +103,7 @@ func (ocr2kc *OCR2KeysController) Delete(c *gin.Context) {
 		return
 	}
 
-	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleDeleted, map[string]any{"id": id})
+	audit.FromContext(ctx, ocr2kc.App.GetAuditLogger()).Audit(audit.OCR2KeyBundleDeleted, map[string]any{"id": id})
 	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResource(key), "offChainReporting2KeyBundle")
 }
 
@@ -97,7 +126,7 @@ func (ocr2kc *OCR2KeysController) Import(c *gin.Context) {
 		return
 	}
 
-	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleImported, map[string]any{
+	audit.FromContext(ctx, ocr2kc.App.GetAuditLogger()).Audit(audit.OCR2KeyBundleImported, map[string]any{
 		"ocr2KeyID":                        keyBundle.ID(),
 		"ocr2KeyChainType":                 keyBundle.ChainType(),
 		"ocr2KeyConfigEncryptionPublicKey": keyBundle.ConfigEncryptionPublicKey(),
@@ -123,6 +152,6 @@ func (ocr2kc *OCR2KeysController) Export(c *gin.Context) {
 		return
 	}
 
-	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleExported, map[string]any{"keyID": stringID})
+	audit.FromContext(c.Request.Context(), ocr2kc.App.GetAuditLogger()).Audit(audit.OCR2KeyBundleExported, map[string]any{"keyID": stringID})
 	c.Data(http.StatusOK, MediaType, bytes)
 }
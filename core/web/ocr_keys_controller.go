@@ -39,7 +39,7 @@ func (ocrkc *OCRKeysController) Create(c *gin.Context) {
 		return
 	}
 
-	ocrkc.App.GetAuditLogger().Audit(audit.OCRKeyBundleCreated, map[string]any{
+	audit.FromContext(ctx, ocrkc.App.GetAuditLogger()).Audit(audit.OCRKeyBundleCreated, map[string]any{
 		"ocrKeyBundleID":                      key.ID(),
 		"ocrKeyBundlePublicKeyAddressOnChain": key.PublicKeyAddressOnChain(),
 	})
@@ -64,7 +64,7 @@ func (ocrkc *OCRKeysController) Delete(c *gin.Context) {
 		return
 	}
 
-	ocrkc.App.GetAuditLogger().Audit(audit.OCRKeyBundleDeleted, map[string]any{"id": id})
+	audit.FromContext(ctx, ocrkc.App.GetAuditLogger()).Audit(audit.OCRKeyBundleDeleted, map[string]any{"id": id})
 	jsonAPIResponse(c, presenters.NewOCRKeysBundleResource(key), "offChainReportingKeyBundle")
 }
 
@@ -87,7 +87,7 @@ func (ocrkc *OCRKeysController) Import(c *gin.Context) {
 		return
 	}
 
-	ocrkc.App.GetAuditLogger().Audit(audit.OCRKeyBundleImported, map[string]any{
+	audit.FromContext(ctx, ocrkc.App.GetAuditLogger()).Audit(audit.OCRKeyBundleImported, map[string]any{
 		"OCRID":                      encryptedOCRKeyBundle.GetID(),
 		"OCRPublicKeyAddressOnChain": encryptedOCRKeyBundle.PublicKeyAddressOnChain(),
 		"OCRPublicKeyOffChain":       encryptedOCRKeyBundle.PublicKeyOffChain(),
@@ -110,6 +110,6 @@ func (ocrkc *OCRKeysController) Export(c *gin.Context) {
 		return
 	}
 
-	ocrkc.App.GetAuditLogger().Audit(audit.OCRKeyBundleExported, map[string]any{"keyID": stringID})
+	audit.FromContext(c.Request.Context(), ocrkc.App.GetAuditLogger()).Audit(audit.OCRKeyBundleExported, map[string]any{"keyID": stringID})
 	c.Data(http.StatusOK, MediaType, bytes)
 }
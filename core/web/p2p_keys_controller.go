@@ -5,9 +5,11 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -42,7 +44,7 @@ func (p2pkc *P2PKeysController) Create(c *gin.Context) {
 		return
 	}
 
-	p2pkc.App.GetAuditLogger().Audit(audit.KeyCreated, map[string]any{
+	audit.FromContext(ctx, p2pkc.App.GetAuditLogger()).Audit(audit.KeyCreated, map[string]any{
 		"type":         "p2p",
 		"id":           key.ID(),
 		"p2pPublicKey": key.PublicKeyHex(),
@@ -52,6 +54,32 @@ func (p2pkc *P2PKeysController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewP2PKeyResource(key), "p2pKey")
 }
 
+// AddExternal adds a P2P key held by the external signer
+// Example:
+// "POST <application>/keys/p2p/external?handle=p2p"
+func (p2pkc *P2PKeysController) AddExternal(c *gin.Context) {
+	ctx := c.Request.Context()
+	key, err := p2pkc.App.GetKeyStore().P2P().AddExternal(ctx, c.Query("handle"))
+	if err != nil {
+		if errors.Is(err, keystore.ErrKeyExists) || errors.Is(err, keystore.ErrNoExternalSigner) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	audit.FromContext(ctx, p2pkc.App.GetAuditLogger()).Audit(audit.KeyCreated, map[string]any{
+		"type":         "p2p",
+		"id":           key.ID(),
+		"p2pPublicKey": key.PublicKeyHex(),
+		"p2pPeerID":    key.PeerID(),
+		"p2pType":      keyType,
+		"external":     true,
+	})
+	jsonAPIResponse(c, presenters.NewP2PKeyResource(key), "p2pKey")
+}
+
 // Delete a P2P key
 // Example:
 // "DELETE <application>/keys/p2p/:keyID"
@@ -74,7 +102,7 @@ func (p2pkc *P2PKeysController) Delete(c *gin.Context) {
 		return
 	}
 
-	p2pkc.App.GetAuditLogger().Audit(audit.KeyDeleted, map[string]any{
+	audit.FromContext(ctx, p2pkc.App.GetAuditLogger()).Audit(audit.KeyDeleted, map[string]any{
 		"type": "p2p",
 		"id":   keyID,
 	})
@@ -101,7 +129,7 @@ func (p2pkc *P2PKeysController) Import(c *gin.Context) {
 		return
 	}
 
-	p2pkc.App.GetAuditLogger().Audit(audit.KeyImported, map[string]any{
+	audit.FromContext(ctx, p2pkc.App.GetAuditLogger()).Audit(audit.KeyImported, map[string]any{
 		"type":         "p2p",
 		"id":           key.ID(),
 		"p2pPublicKey": key.PublicKeyHex(),
@@ -131,7 +159,7 @@ func (p2pkc *P2PKeysController) Export(c *gin.Context) {
 		return
 	}
 
-	p2pkc.App.GetAuditLogger().Audit(audit.KeyExported, map[string]any{
+	audit.FromContext(c.Request.Context(), p2pkc.App.GetAuditLogger()).Audit(audit.KeyExported, map[string]any{
 		"type": "p2p",
 		"id":   keyID,
 	})
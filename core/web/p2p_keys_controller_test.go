@@ -66,6 +66,16 @@ func TestP2PKeysController_Create_HappyPath(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestP2PKeysController_AddExternal_NoSigner(t *testing.T) {
+	t.Parallel()
+
+	client, _ := setupP2PKeysControllerTests(t)
+
+	response, cleanup := client.Post("/v2/keys/p2p/external?handle=p2p", nil)
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
+
 func TestP2PKeysController_Delete_NonExistentP2PKeyID(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -85,6 +86,91 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, res, "pipelineRun")
 }
 
+// Fixture records the persisted results of a finished run, including the responses of its bridge, http and ethtx
+// tasks, so that the run can be replayed offline.
+// Example:
+// "GET <application>/pipeline/runs/:runID/fixture"
+func (prc *PipelineRunsController) Fixture(c *gin.Context) {
+	pipelineRun, ok := prc.findFinishedRun(c)
+	if !ok {
+		return
+	}
+
+	fixture, err := pipeline.NewRunFixture(pipelineRun)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, fixture)
+}
+
+// RerecordFixtureLive re-executes a finished run in memory with its original inputs, sending the requests of its
+// bridge and http tasks to their live endpoints. Unlike Fixture, the returned fixture holds the full HTTP responses
+// and the task results of the re-execution, which may differ from those of the run.
+// Example:
+// "POST <application>/pipeline/runs/:runID/fixture/live"
+func (prc *PipelineRunsController) RerecordFixtureLive(c *gin.Context) {
+	pipelineRun, ok := prc.findFinishedRun(c)
+	if !ok {
+		return
+	}
+
+	fixture, err := prc.App.RerecordPipelineRunLiveV2(c.Request.Context(), pipelineRun)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, fixture)
+}
+
+func (prc *PipelineRunsController) findFinishedRun(c *gin.Context) (pipeline.Run, bool) {
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return pipeline.Run{}, false
+	}
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(c.Request.Context(), pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return pipeline.Run{}, false
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return pipeline.Run{}, false
+	}
+	return pipelineRun, true
+}
+
+// Replay re-executes a recorded run against its fixture, without persisting anything, and reports every task whose
+// result differs from the recorded one.
+// Example:
+// "POST <application>/pipeline/replay"
+func (prc *PipelineRunsController) Replay(c *gin.Context) {
+	fixture := pipeline.RunFixture{}
+	if err := c.ShouldBindJSON(&fixture); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	run, trrs, err := prc.App.ReplayPipelineRunV2(c.Request.Context(), fixture)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	diffs, err := fixture.Diff(trrs)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunReplayResource(fixture.RunID, *run, diffs, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRunReplay")
+}
+
 // Create triggers a pipeline run for a job.
 // Example:
 // "POST <application>/jobs/:ID/runs"
@@ -183,6 +269,6 @@ func (prc *PipelineRunsController) Resume(c *gin.Context) {
 		return
 	}
 
-	prc.App.GetAuditLogger().Audit(audit.UnauthedRunResumed, map[string]any{"runID": c.Param("runID")})
+	audit.FromContext(c.Request.Context(), prc.App.GetAuditLogger()).Audit(audit.UnauthedRunResumed, map[string]any{"runID": c.Param("runID")})
 	c.Status(http.StatusOK)
 }
@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -251,6 +253,43 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_FixtureAndReplay(t *testing.T) {
+	client, _, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Get("/v2/pipeline/runs/" + strconv.FormatInt(runIDs[0], 10) + "/fixture")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	fixtureBytes := cltest.ParseResponseBody(t, response)
+	var fixture pipeline.RunFixture
+	require.NoError(t, json.Unmarshal(fixtureBytes, &fixture))
+	assert.Equal(t, runIDs[0], fixture.RunID)
+	require.Len(t, fixture.TaskRuns, 8)
+
+	response, cleanup = client.Post("/v2/pipeline/replay", bytes.NewReader(fixtureBytes))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse presenters.PipelineRunReplayResource
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.FormatInt(runIDs[0], 10), parsedResponse.ID)
+	assert.Empty(t, parsedResponse.Diffs)
+	require.Len(t, parsedResponse.Run.TaskRuns, 8)
+
+	response, cleanup = client.Post("/v2/pipeline/runs/"+strconv.FormatInt(runIDs[0], 10)+"/fixture/live", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var rerecorded pipeline.RunFixture
+	require.NoError(t, json.Unmarshal(cltest.ParseResponseBody(t, response), &rerecorded))
+	assert.Equal(t, runIDs[0], rerecorded.RunID)
+	require.Len(t, rerecorded.TaskRuns, 8)
+
+	response, cleanup = client.Get("/v2/pipeline/runs/999999/fixture")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ctx := testutils.Context(t)
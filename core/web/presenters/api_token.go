@@ -0,0 +1,55 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// APITokenResource represents a named API token JSONAPI resource. The secret
+// is only known, and set, when the token is created.
+type APITokenResource struct {
+	JAID
+	Name      string    `json:"name"`
+	Scope     []string  `json:"scope"`
+	AccessKey string    `json:"accessKey"`
+	Secret    string    `json:"secret,omitempty"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r APITokenResource) GetName() string {
+	return "api_tokens"
+}
+
+// NewAPITokenResource constructs a new APITokenResource, identified by name
+// as tokens are unique by name for their user.
+func NewAPITokenResource(t sessions.APIToken) *APITokenResource {
+	return &APITokenResource{
+		JAID:      NewJAID(t.Name),
+		Name:      t.Name,
+		Scope:     t.Scope.Strings(),
+		AccessKey: t.TokenKey,
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+// NewCreatedAPITokenResource constructs an APITokenResource of a newly
+// created token, including its secret.
+func NewCreatedAPITokenResource(t sessions.APIToken, token *auth.Token) *APITokenResource {
+	r := NewAPITokenResource(t)
+	r.Secret = token.Secret
+	return r
+}
+
+// NewAPITokenResources constructs a slice of APITokenResources.
+func NewAPITokenResources(tokens []sessions.APIToken) []APITokenResource {
+	rs := []APITokenResource{}
+	for _, t := range tokens {
+		rs = append(rs, *NewAPITokenResource(t))
+	}
+	return rs
+}
@@ -0,0 +1,76 @@
+package presenters
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+// AuditRecordResource represents a record of the local audit log.
+type AuditRecordResource struct {
+	JAID
+	EventID   audit.EventID   `json:"eventID"`
+	User      string          `json:"user"`
+	Data      json.RawMessage `json:"data"`
+	PrevHash  string          `json:"prevHash"`
+	Hash      string          `json:"hash"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AuditRecordResource) GetName() string {
+	return "audit_records"
+}
+
+// NewAuditRecordResource constructs a new AuditRecordResource.
+func NewAuditRecordResource(r audit.Record) *AuditRecordResource {
+	return &AuditRecordResource{
+		JAID:      NewJAID(strconv.FormatInt(r.ID, 10)),
+		EventID:   r.EventID,
+		User:      r.UserEmail,
+		Data:      json.RawMessage(r.Data),
+		PrevHash:  hex.EncodeToString(r.PrevHash),
+		Hash:      hex.EncodeToString(r.Hash),
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+// NewAuditRecordResources constructs a slice of AuditRecordResources.
+func NewAuditRecordResources(records []audit.Record) []AuditRecordResource {
+	rs := []AuditRecordResource{}
+	for _, r := range records {
+		rs = append(rs, *NewAuditRecordResource(r))
+	}
+	return rs
+}
+
+// AuditVerificationResource is the result of checking the hash chain of the
+// local audit log.
+type AuditVerificationResource struct {
+	JAID
+	Checked int    `json:"checked"`
+	Valid   bool   `json:"valid"`
+	Error   string `json:"error,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AuditVerificationResource) GetName() string {
+	return "audit_verifications"
+}
+
+// NewAuditVerificationResource constructs a new AuditVerificationResource from
+// the result of audit.ORM.Verify.
+func NewAuditVerificationResource(checked int, err error) *AuditVerificationResource {
+	r := &AuditVerificationResource{
+		JAID:    NewJAID("audit_log"),
+		Checked: checked,
+		Valid:   err == nil,
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	return r
+}
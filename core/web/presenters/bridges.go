@@ -17,7 +17,39 @@ type BridgeResource struct {
 	IncomingToken          string       `json:"incomingToken,omitempty"`
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	HealthCheckURL         string       `json:"healthCheckURL,omitempty"`
+	// The client key and the request signing secret are never provided
+	ClientCertificate       string    `json:"clientCertificate,omitempty"`
+	CABundle                string    `json:"caBundle,omitempty"`
+	RequestSigning          bool      `json:"requestSigning,omitempty"`
+	ResponseVerificationKey string    `json:"responseVerificationKey,omitempty"`
+	CreatedAt               time.Time `json:"createdAt"`
+	// Health is only provided when showing a single Bridge
+	Health *BridgeHealth `json:"health,omitempty"`
+}
+
+// BridgeHealth is the health of a bridge, as seen by its health checks and by
+// the requests made to it.
+type BridgeHealth struct {
+	Status              string     `json:"status"`
+	CircuitBreaker      string     `json:"circuitBreaker"`
+	LastChecked         *time.Time `json:"lastChecked"`
+	LastError           string     `json:"lastError,omitempty"`
+	ConsecutiveFailures uint32     `json:"consecutiveFailures"`
+}
+
+// NewBridgeHealth constructs a new BridgeHealth
+func NewBridgeHealth(h bridges.Health) *BridgeHealth {
+	health := &BridgeHealth{
+		Status:              string(h.Status),
+		CircuitBreaker:      string(h.Circuit),
+		LastError:           h.LastError,
+		ConsecutiveFailures: h.ConsecutiveFailures,
+	}
+	if !h.LastChecked.IsZero() {
+		health.LastChecked = &h.LastChecked
+	}
+	return health
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -27,14 +59,22 @@ func (r BridgeResource) GetName() string {
 
 // NewBridgeResource constructs a new BridgeResource
 func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
-	return &BridgeResource{
+	r := &BridgeResource{
 		// Uses the name as the id...Should change this to the id
-		JAID:                   NewJAID(b.Name.String()),
-		Name:                   b.Name.String(),
-		URL:                    b.URL.String(),
-		Confirmations:          b.Confirmations,
-		OutgoingToken:          b.OutgoingToken,
-		MinimumContractPayment: b.MinimumContractPayment,
-		CreatedAt:              b.CreatedAt,
+		JAID:                    NewJAID(b.Name.String()),
+		Name:                    b.Name.String(),
+		URL:                     b.URL.String(),
+		Confirmations:           b.Confirmations,
+		OutgoingToken:           b.OutgoingToken,
+		MinimumContractPayment:  b.MinimumContractPayment,
+		ClientCertificate:       b.ClientCertificate,
+		CABundle:                b.CABundle,
+		RequestSigning:          b.RequestSigningSecret != "",
+		ResponseVerificationKey: b.ResponseVerificationKey,
+		CreatedAt:               b.CreatedAt,
+	}
+	if b.HealthCheckURL != nil {
+		r.HealthCheckURL = b.HealthCheckURL.String()
 	}
+	return r
 }
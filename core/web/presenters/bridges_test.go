@@ -75,6 +75,40 @@ func TestBridgeResource(t *testing.T) {
 		}
 	}
 }
+`
+
+	assert.JSONEq(t, expected, string(b))
+
+	// The client key and request signing secret are never provided
+	bridge.BridgeSecurity = bridges.BridgeSecurity{
+		ClientCertificate:       "certificate",
+		ClientKey:               "key",
+		CABundle:                "bundle",
+		RequestSigningSecret:    "secret",
+		ResponseVerificationKey: "verification-key",
+	}
+	b, err = jsonapi.Marshal(NewBridgeResource(bridge))
+	require.NoError(t, err)
+
+	expected = `
+{
+	"data": {
+		"type":"bridges",
+		"id":"test",
+		"attributes":{
+			"name":"test",
+			"url":"https://bridge.example.com/api",
+			"confirmations":1,
+			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
+			"minimumContractPayment":"1",
+			"clientCertificate":"certificate",
+			"caBundle":"bundle",
+			"requestSigning":true,
+			"responseVerificationKey":"verification-key",
+			"createdAt":"2000-01-01T00:00:00Z"
+		}
+	}
+}
 `
 
 	assert.JSONEq(t, expected, string(b))
@@ -0,0 +1,100 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+)
+
+// GatewayResource represents the status of a running gateway JSONAPI
+// resource. It is identified by the ID of the job of the gateway.
+type GatewayResource struct {
+	JAID
+	ReplicaID string               `json:"replicaID,omitempty"`
+	DONs      []GatewayDONResource `json:"dons"`
+}
+
+// GatewayDONResource is the state of the node connections and handlers of a
+// DON of a gateway.
+type GatewayDONResource struct {
+	DonID             string                   `json:"donID"`
+	Nodes             []GatewayNodeResource    `json:"nodes"`
+	PendingHandshakes int                      `json:"pendingHandshakes"`
+	Handlers          []GatewayHandlerResource `json:"handlers"`
+}
+
+// GatewayNodeResource is the state of the connection of a node to a gateway.
+type GatewayNodeResource struct {
+	Address       string     `json:"address"`
+	Name          string     `json:"name"`
+	Connected     bool       `json:"connected"`
+	ConnectedAt   *time.Time `json:"connectedAt"`
+	LastHeartbeat *time.Time `json:"lastHeartbeat"`
+}
+
+// GatewayHandlerResource is the state of a handler of a DON. Handlers which
+// do not report their state have no pending requests.
+type GatewayHandlerResource struct {
+	Name            string                     `json:"name"`
+	PendingRequests *int                       `json:"pendingRequests"`
+	ResponseCache   *GatewayCacheStatsResource `json:"responseCache,omitempty"`
+}
+
+// GatewayCacheStatsResource describes the usage of the response cache of a
+// handler.
+type GatewayCacheStatsResource struct {
+	Entries int    `json:"entries"`
+	Hits    uint64 `json:"hits"`
+	Misses  uint64 `json:"misses"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r GatewayResource) GetName() string {
+	return "gateways"
+}
+
+// NewGatewayResource constructs a new GatewayResource
+func NewGatewayResource(jobID int32, s gateway.Status) *GatewayResource {
+	r := &GatewayResource{
+		JAID:      NewJAID(strconv.Itoa(int(jobID))),
+		ReplicaID: s.ReplicaID,
+		DONs:      []GatewayDONResource{},
+	}
+	for _, don := range s.DONs {
+		donResource := GatewayDONResource{
+			DonID:             don.DonID,
+			Nodes:             []GatewayNodeResource{},
+			PendingHandshakes: don.PendingHandshakes,
+			Handlers:          []GatewayHandlerResource{},
+		}
+		for _, node := range don.Nodes {
+			donResource.Nodes = append(donResource.Nodes, GatewayNodeResource{
+				Address:       node.Address,
+				Name:          node.Name,
+				Connected:     node.Connected,
+				ConnectedAt:   timeOrNil(node.ConnectedAt),
+				LastHeartbeat: timeOrNil(node.LastHeartbeat),
+			})
+		}
+		for _, handler := range don.Handlers {
+			handlerResource := GatewayHandlerResource{Name: handler.Name}
+			if handler.Status != nil {
+				handlerResource.PendingRequests = &handler.Status.PendingRequests
+				if cache := handler.Status.ResponseCache; cache != nil {
+					handlerResource.ResponseCache = &GatewayCacheStatsResource{Entries: cache.Entries, Hits: cache.Hits, Misses: cache.Misses}
+				}
+			}
+			donResource.Handlers = append(donResource.Handlers, handlerResource)
+		}
+		r.DONs = append(r.DONs, donResource)
+	}
+	return r
+}
+
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
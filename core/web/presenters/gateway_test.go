@@ -0,0 +1,69 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+)
+
+func TestGatewayResource(t *testing.T) {
+	var (
+		ts = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	status := gateway.Status{
+		ReplicaID: "replica-1",
+		DONs: []gateway.DONStatus{{
+			DonID: "don-1",
+			Nodes: []gateway.NodeStatus{
+				{Address: "0x01", Name: "node one", Connected: true, ConnectedAt: ts, LastHeartbeat: ts},
+				{Address: "0x02", Name: "node two"},
+			},
+			PendingHandshakes: 1,
+			Handlers: []gateway.HandlerStatus{
+				{Name: "functions"},
+				{Name: "http-capabilities", Status: &handlers.Status{
+					PendingRequests: 2,
+					ResponseCache:   &handlers.CacheStats{Entries: 3, Hits: 4, Misses: 5},
+				}},
+			},
+		}},
+	}
+
+	r := NewGatewayResource(7, status)
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := `
+	{
+		"data":{
+			"type":"gateways",
+			"id":"7",
+			"attributes":{
+				"replicaID":"replica-1",
+				"dons":[{
+					"donID":"don-1",
+					"nodes":[
+						{"address":"0x01","name":"node one","connected":true,"connectedAt":"2000-01-01T00:00:00Z","lastHeartbeat":"2000-01-01T00:00:00Z"},
+						{"address":"0x02","name":"node two","connected":false,"connectedAt":null,"lastHeartbeat":null}
+					],
+					"pendingHandshakes":1,
+					"handlers":[
+						{"name":"functions","pendingRequests":null},
+						{"name":"http-capabilities","pendingRequests":2,"responseCache":{"entries":3,"hits":4,"misses":5}}
+					]
+				}]
+			}
+		}
+	}
+	`
+
+	assert.JSONEq(t, expected, string(b))
+}
@@ -567,6 +567,7 @@ type JobResource struct {
 	ForwardingAllowed        bool                      `json:"forwardingAllowed"`
 	MaxTaskDuration          sqlutil.Interval          `json:"maxTaskDuration"`
 	ExternalJobID            uuid.UUID                 `json:"externalJobID"`
+	Paused                   bool                      `json:"paused"`
 	DirectRequestSpec        *DirectRequestSpec        `json:"directRequestSpec"`
 	FluxMonitorSpec          *FluxMonitorSpec          `json:"fluxMonitorSpec"`
 	CRESettings              *CRESettingsSpec          `json:"creSettingsSpec"`
@@ -602,6 +603,7 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration:   j.MaxTaskDuration,
 		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:     j.ExternalJobID,
+		Paused:            j.Paused,
 	}
 
 	switch j.Type {
@@ -660,3 +662,37 @@ func NewJobResource(j job.Job) *JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobRevisionResource represents a recorded revision of a job's spec, identified by its revision number.
+type JobRevisionResource struct {
+	JAID
+	ExternalJobID uuid.UUID `json:"externalJobID"`
+	JobID         int32     `json:"jobID"`
+	TOML          string    `json:"toml"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// NewJobRevisionResource initializes a new JSONAPI job revision resource
+func NewJobRevisionResource(jr job.JobRevision) JobRevisionResource {
+	return JobRevisionResource{
+		JAID:          NewJAIDInt32(jr.Revision),
+		ExternalJobID: jr.ExternalJobID,
+		JobID:         jr.JobID,
+		TOML:          jr.TOML,
+		CreatedAt:     jr.CreatedAt,
+	}
+}
+
+// NewJobRevisionResources initializes a slice of JSONAPI job revision resources
+func NewJobRevisionResources(jrs []job.JobRevision) []JobRevisionResource {
+	rs := []JobRevisionResource{}
+	for _, jr := range jrs {
+		rs = append(rs, NewJobRevisionResource(jr))
+	}
+	return rs
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobRevisionResource) GetName() string {
+	return "jobRevisions"
+}
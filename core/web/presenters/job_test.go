@@ -101,6 +101,7 @@ func TestJob(t *testing.T) {
 						"type": "directrequest",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "paused": false,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
@@ -177,6 +178,7 @@ func TestJob(t *testing.T) {
 						"type": "fluxmonitor",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "paused": false,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
@@ -267,6 +269,7 @@ func TestJob(t *testing.T) {
 						"type": "offchainreporting",
 						"maxTaskDuration": "1m0s",
 					  "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					  "paused": false,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
@@ -346,6 +349,7 @@ func TestJob(t *testing.T) {
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "paused": false,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "",
@@ -414,6 +418,7 @@ func TestJob(t *testing.T) {
                         "type": "cron",
                         "maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "paused": false,
                         "pipelineSpec": {
                             "id": 1,
                             "dotDagSource": "",
@@ -478,6 +483,7 @@ func TestJob(t *testing.T) {
 						"type": "webhook",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "paused": false,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "",
@@ -552,6 +558,7 @@ func TestJob(t *testing.T) {
 						"schemaVersion": 1,
 						"maxTaskDuration": "0s",
 						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f47",
+						"paused": false,
 						"directRequestSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -642,6 +649,7 @@ func TestJob(t *testing.T) {
 						"schemaVersion": 1,
 						"maxTaskDuration": "0s",
 						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"paused": false,
 						"directRequestSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -728,6 +736,7 @@ func TestJob(t *testing.T) {
 						"schemaVersion": 1,
 						"maxTaskDuration": "0s",
 						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f47",
+						"paused": false,
 						"directRequestSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -804,6 +813,7 @@ func TestJob(t *testing.T) {
 						"schemaVersion": 1,
 						"maxTaskDuration": "0s",
 						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"paused": false,
 						"directRequestSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -874,6 +884,7 @@ func TestJob(t *testing.T) {
 						"schemaVersion": 1,
 						"maxTaskDuration": "0s",
 						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"paused": false,
 						"directRequestSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -942,6 +953,7 @@ func TestJob(t *testing.T) {
 						"schemaVersion": 1,
 						"maxTaskDuration": "0s",
 						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"paused": false,
 						"directRequestSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -1008,6 +1020,7 @@ func TestJob(t *testing.T) {
 						"schemaVersion": 1,
 						"maxTaskDuration": "0s",
 						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"paused": false,
 						"directRequestSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -1074,6 +1087,7 @@ func TestJob(t *testing.T) {
 						"schemaVersion": 1,
 						"maxTaskDuration": "0s",
 						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"paused": false,
 						"directRequestSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -1141,6 +1155,7 @@ func TestJob(t *testing.T) {
 						"schemaVersion": 1,
 						"maxTaskDuration": "0s",
 						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"paused": false,
 						"directRequestSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -1205,6 +1220,7 @@ func TestJob(t *testing.T) {
 						"schemaVersion": 1,
 						"maxTaskDuration": "0s",
 						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"paused": false,
 						"directRequestSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -1270,6 +1286,7 @@ func TestJob(t *testing.T) {
 						"maxTaskDuration": "0s",
 						"directRequestSpec": null,
 						"externalJobID": "00000000-0000-0000-0000-000000000000",
+						"paused": false,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
@@ -1346,6 +1363,7 @@ func TestJob(t *testing.T) {
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "paused": false,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "",
@@ -0,0 +1,26 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+// KeystoreRestoreResource represents the keys added by restoring a keystore
+// backup, identified by the backup's digest.
+type KeystoreRestoreResource struct {
+	JAID
+	Keys         map[string][]string `json:"keys"`
+	EthKeyStates int                 `json:"ethKeyStates"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (KeystoreRestoreResource) GetName() string {
+	return "keystoreRestores"
+}
+
+func NewKeystoreRestoreResource(manifest keystore.BackupManifest) *KeystoreRestoreResource {
+	return &KeystoreRestoreResource{
+		JAID:         NewJAID(manifest.SHA256),
+		Keys:         manifest.Keys,
+		EthKeyStates: manifest.EthKeyStates,
+	}
+}
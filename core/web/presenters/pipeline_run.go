@@ -67,6 +67,8 @@ type PipelineTaskRunResource struct {
 	Output     *string           `json:"output"`
 	Error      *string           `json:"error"`
 	DotID      string            `json:"dotId"`
+	// Attempts is only set for tasks configured with retries
+	Attempts pipeline.TaskRunAttempts `json:"attempts,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -92,6 +94,7 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		Output:     output,
 		Error:      errString,
 		DotID:      tr.GetDotID(),
+		Attempts:   tr.Attempts,
 	}
 }
 
@@ -104,3 +107,24 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineRunReplayResource is the result of re-executing a recorded run against its fixture.
+type PipelineRunReplayResource struct {
+	JAID
+	Run   PipelineRunResource    `json:"run"`
+	Diffs []pipeline.TaskRunDiff `json:"diffs"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineRunReplayResource) GetName() string {
+	return "pipelineRunReplay"
+}
+
+// NewPipelineRunReplayResource constructs a new PipelineRunReplayResource, identified by the ID of the recorded run.
+func NewPipelineRunReplayResource(recordedRunID int64, replayed pipeline.Run, diffs []pipeline.TaskRunDiff, lggr logger.Logger) PipelineRunReplayResource {
+	return PipelineRunReplayResource{
+		JAID:  NewJAIDInt64(recordedRunID),
+		Run:   NewPipelineRunResource(replayed, lggr),
+		Diffs: diffs,
+	}
+}
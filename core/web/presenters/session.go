@@ -0,0 +1,47 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// SessionResource represents an active session JSONAPI resource. It is
+// identified by the public ID of the session, never by the session ID itself.
+type SessionResource struct {
+	JAID
+	Email     string    `json:"email"`
+	IPAddress string    `json:"ipAddress"`
+	UserAgent string    `json:"userAgent"`
+	LastUsed  time.Time `json:"lastUsed"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Current is set for the session of the requesting user
+	Current bool `json:"current"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r SessionResource) GetName() string {
+	return "sessions"
+}
+
+// NewSessionResource constructs a new SessionResource
+func NewSessionResource(s sessions.Session, currentSessionID string) *SessionResource {
+	return &SessionResource{
+		JAID:      NewJAID(s.PublicID()),
+		Email:     s.Email,
+		IPAddress: s.IPAddress,
+		UserAgent: s.UserAgent,
+		LastUsed:  s.LastUsed,
+		CreatedAt: s.CreatedAt,
+		Current:   currentSessionID != "" && s.ID == currentSessionID,
+	}
+}
+
+// NewSessionResources constructs a slice of SessionResources
+func NewSessionResources(ss []sessions.Session, currentSessionID string) []SessionResource {
+	rs := []SessionResource{}
+	for _, s := range ss {
+		rs = append(rs, *NewSessionResource(s, currentSessionID))
+	}
+	return rs
+}
@@ -0,0 +1,53 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestSessionResource(t *testing.T) {
+	var (
+		ts = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	session := sessions.Session{
+		ID:        "d3f1a4b2c5e6",
+		Email:     "notreal@fakeemail.ch",
+		IPAddress: "10.0.0.1",
+		UserAgent: "curl/8.0",
+		LastUsed:  ts,
+		CreatedAt: ts,
+	}
+
+	r := NewSessionResource(session, session.ID)
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := `
+	{
+		"data":{
+			"type":"sessions",
+			"id":"` + session.PublicID() + `",
+			"attributes":{
+				"email":"notreal@fakeemail.ch",
+				"ipAddress":"10.0.0.1",
+				"userAgent":"curl/8.0",
+				"lastUsed":"2000-01-01T00:00:00Z",
+				"createdAt":"2000-01-01T00:00:00Z",
+				"current":true
+			}
+		}
+	}
+	`
+
+	assert.JSONEq(t, expected, string(b))
+	assert.NotContains(t, string(b), session.ID)
+	assert.False(t, NewSessionResource(session, "").Current)
+}
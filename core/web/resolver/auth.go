@@ -16,42 +16,32 @@ func authenticateUser(ctx context.Context) error {
 	return nil
 }
 
-// Authenticates the user from the session cookie and asserts at least 'run' role.
-func authenticateUserCanRun(ctx context.Context) error {
+// Authenticates the user from the session cookie and asserts the user's role permits action on the
+// resource object with the given id, or on the resource as a whole if id is empty.
+func authorizeUser(ctx context.Context, resource sessions.Resource, id string, action sessions.Action) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
-	if session.User.Role == sessions.UserRoleView {
+	if !auth.GetRoles(ctx).Allowed(session.User.Role, resource, id, action) {
 		return RoleNotPermittedErr{session.User.Role}
 	}
 	return nil
 }
 
-// Authenticates the user from the session cookie and asserts at least 'edit' role.
-func authenticateUserCanEdit(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
-	}
-	switch session.User.Role {
-	case sessions.UserRoleView, sessions.UserRoleRun:
-		return RoleNotPermittedErr{session.User.Role}
-	default:
-	}
-	return nil
+// Authenticates the user from the session cookie and asserts at least 'run' role, or run permission on the resource.
+func authenticateUserCanRun(ctx context.Context, resource sessions.Resource, id string) error {
+	return authorizeUser(ctx, resource, id, sessions.ActionRun)
 }
 
-// Authenticates the user from the session cookie and asserts has 'admin' role
-func authenticateUserIsAdmin(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
-	}
-	if session.User.Role != sessions.UserRoleAdmin {
-		return RoleNotPermittedErr{session.User.Role}
-	}
-	return nil
+// Authenticates the user from the session cookie and asserts at least 'edit' role, or edit permission on the resource.
+func authenticateUserCanEdit(ctx context.Context, resource sessions.Resource, id string) error {
+	return authorizeUser(ctx, resource, id, sessions.ActionEdit)
+}
+
+// Authenticates the user from the session cookie and asserts has 'admin' role, or admin permission on the resource.
+func authenticateUserIsAdmin(ctx context.Context, resource sessions.Resource, id string) error {
+	return authorizeUser(ctx, resource, id, sessions.ActionAdmin)
 }
 
 type unauthorizedError struct{}
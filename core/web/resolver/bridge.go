@@ -54,6 +54,15 @@ func (r *BridgeResolver) MinimumContractPayment() string {
 	return r.bridge.MinimumContractPayment.String()
 }
 
+// HealthCheckURL resolves the bridge's health check url.
+func (r *BridgeResolver) HealthCheckURL() *string {
+	if r.bridge.HealthCheckURL == nil {
+		return nil
+	}
+	u := r.bridge.HealthCheckURL.String()
+	return &u
+}
+
 // CreatedAt resolves the bridge's created at field.
 func (r *BridgeResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.bridge.CreatedAt}
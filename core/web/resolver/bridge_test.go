@@ -282,6 +282,9 @@ func Test_UpdateBridge(t *testing.T) {
 	newBridgeURL, err := url.Parse("https://external.adapter.new")
 	require.NoError(t, err)
 
+	healthCheckURL, err := url.Parse("https://external.adapter.new/health")
+	require.NoError(t, err)
+
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateBridge"),
 		{
@@ -338,6 +341,75 @@ func Test_UpdateBridge(t *testing.T) {
 				}
 			}`,
 		},
+		{
+			name:          "success with health check URL",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				bridge := bridges.BridgeType{
+					Name:                   name,
+					URL:                    models.WebURL(*bridgeURL),
+					Confirmations:          uint32(1),
+					OutgoingToken:          "outgoingToken",
+					MinimumContractPayment: assets.NewLinkFromJuels(1),
+					CreatedAt:              f.Timestamp(),
+				}
+
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.Mocks.bridgeORM.On("FindBridge", mock.Anything, name).Return(bridge, nil)
+
+				btr := &bridges.BridgeTypeRequest{
+					Name:                   bridges.BridgeName("bridge-updated"),
+					URL:                    models.WebURL(*newBridgeURL),
+					Confirmations:          2,
+					MinimumContractPayment: assets.NewLinkFromJuels(2),
+					HealthCheckURL:         (*models.WebURL)(healthCheckURL),
+				}
+
+				f.Mocks.bridgeORM.On("UpdateBridgeType", mock.Anything, mock.IsType(&bridges.BridgeType{}), btr).
+					Run(func(args mock.Arguments) {
+						arg := args.Get(1).(*bridges.BridgeType)
+						*arg = bridges.BridgeType{
+							Name:                   "bridge-updated",
+							URL:                    models.WebURL(*newBridgeURL),
+							Confirmations:          2,
+							OutgoingToken:          "outgoingToken",
+							MinimumContractPayment: assets.NewLinkFromJuels(2),
+							HealthCheckURL:         (*models.WebURL)(healthCheckURL),
+							CreatedAt:              f.Timestamp(),
+						}
+					}).
+					Return(nil)
+			},
+			query: `
+				mutation updateBridge($id: ID!, $input: UpdateBridgeInput!) {
+					updateBridge(id: $id, input: $input) {
+						... on UpdateBridgeSuccess {
+							bridge {
+								name
+								healthCheckURL
+							}
+						}
+					}
+				}`,
+			variables: map[string]any{
+				"id": "bridge1",
+				"input": map[string]any{
+					"name":                   "bridge-updated",
+					"url":                    "https://external.adapter.new",
+					"confirmations":          2,
+					"minimumContractPayment": "2",
+					"healthCheckURL":         "https://external.adapter.new/health",
+				},
+			},
+			result: `{
+				"updateBridge": {
+					"bridge": {
+						"name": "bridge-updated",
+						"healthCheckURL": "https://external.adapter.new/health"
+					}
+				}
+			}`,
+		},
 		{
 			name:          "not found",
 			authenticated: true,
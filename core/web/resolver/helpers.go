@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/graph-gophers/graphql-go"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 )
 
@@ -85,6 +87,25 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		return errors.New("MinimumContractPayment must be positive")
 	}
+	if hc := bt.HealthCheck(); hc != nil && ((hc.Scheme != "http" && hc.Scheme != "https") || hc.Host == "") {
+		return errors.New("healthCheckURL must be an absolute http or https URL")
+	}
 
 	return nil
 }
+
+// parseHealthCheckURL parses the optional health check URL of a bridge input.
+// An empty URL is kept as such, to remove the health check of a bridge.
+func parseHealthCheckURL(s *string) (*models.WebURL, error) {
+	if s == nil {
+		return nil, nil
+	}
+	if *s == "" {
+		return &models.WebURL{}, nil
+	}
+	u, err := url.ParseRequestURI(*s)
+	if err != nil {
+		return nil, err
+	}
+	return (*models.WebURL)(u), nil
+}
@@ -330,6 +330,8 @@ func TestResolver_CreateJob(t *testing.T) {
 	}
 	jb, err := directrequest.ValidatedDirectRequestSpec(spec)
 	assert.NoError(t, err)
+	// the spec is recorded as the first revision of the job
+	jb.TOML = spec
 
 	d, err := json.Marshal(map[string]any{
 		"createJob": map[string]any{
@@ -564,3 +566,100 @@ func TestResolver_DeleteJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_PauseResumeJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	variables := map[string]any{
+		"id": "123",
+	}
+	gError := errors.New("error")
+
+	for _, tc := range []struct {
+		field   string
+		appCall string
+		paused  bool
+	}{
+		{"pauseJob", "PauseJob", true},
+		{"resumeJob", "ResumeJob", false},
+	} {
+		mutation := fmt.Sprintf(`
+			mutation PauseResumeJob($id: ID!) {
+				%s(id: $id) {
+					... on %sSuccess {
+						job {
+							id
+							paused
+						}
+					}
+					... on NotFoundError {
+						code
+						message
+					}
+				}
+			}`, tc.field, tc.appCall)
+
+		d, err := json.Marshal(map[string]any{
+			tc.field: map[string]any{
+				"job": map[string]any{
+					"id":     "123",
+					"paused": tc.paused,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		testCases := []GQLTestCase{
+			unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, tc.field),
+			{
+				name:          tc.field + " success",
+				authenticated: true,
+				before: func(ctx context.Context, f *gqlTestFramework) {
+					f.App.On(tc.appCall, mock.Anything, id).Return(nil)
+					f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{ID: id, Paused: tc.paused}, nil)
+					f.App.On("JobORM").Return(f.Mocks.jobORM)
+				},
+				query:     mutation,
+				variables: variables,
+				result:    string(d),
+			},
+			{
+				name:          tc.field + " not found",
+				authenticated: true,
+				before: func(ctx context.Context, f *gqlTestFramework) {
+					f.App.On(tc.appCall, mock.Anything, id).Return(sql.ErrNoRows)
+				},
+				query:     mutation,
+				variables: variables,
+				result: fmt.Sprintf(`
+					{
+						"%s": {
+							"code": "NOT_FOUND",
+							"message": "job not found"
+						}
+					}`, tc.field),
+			},
+			{
+				name:          tc.field + " generic error",
+				authenticated: true,
+				before: func(ctx context.Context, f *gqlTestFramework) {
+					f.App.On(tc.appCall, mock.Anything, id).Return(gError)
+				},
+				query:     mutation,
+				variables: variables,
+				result:    `null`,
+				errors: []*gqlerrors.QueryError{
+					{
+						Extensions:    nil,
+						ResolverError: gError,
+						Path:          []any{tc.field},
+						Message:       gError.Error(),
+					},
+				},
+			},
+		}
+
+		RunGQLTests(t, testCases)
+	}
+}
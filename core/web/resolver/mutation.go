@@ -46,6 +46,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
@@ -62,11 +63,12 @@ type createBridgeInput struct {
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	HealthCheckURL         *string
 }
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceBridges, ""); err != nil {
 		return nil, err
 	}
 
@@ -82,12 +84,17 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 	if err := minContractPayment.UnmarshalText([]byte(args.Input.MinimumContractPayment)); err != nil {
 		return nil, err
 	}
+	healthCheckURL, err := parseHealthCheckURL(args.Input.HealthCheckURL)
+	if err != nil {
+		return nil, err
+	}
 
 	btr := &bridges.BridgeTypeRequest{
 		Name:                   bridges.BridgeName(args.Input.Name),
 		URL:                    webURL,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
+		HealthCheckURL:         healthCheckURL,
 	}
 
 	bta, bt, err := bridges.NewBridgeType(btr)
@@ -105,7 +112,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.BridgeCreated, map[string]any{
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.BridgeCreated, map[string]any{
 		"bridgeName":                   bta.Name,
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
@@ -116,7 +123,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys, ""); err != nil {
 		return nil, err
 	}
 
@@ -129,7 +136,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.CSAKeyCreated, map[string]any{
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.CSAKeyCreated, map[string]any{
 		"CSAPublicKey": key.PublicKey,
 		"CSVersion":    key.Version,
 	})
@@ -140,7 +147,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys, string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -153,7 +160,7 @@ func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.CSAKeyDeleted, map[string]any{"id": args.ID})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.CSAKeyDeleted, map[string]any{"id": args.ID})
 
 	return NewDeleteCSAKeyPayload(key, nil), nil
 }
@@ -183,7 +190,7 @@ type createFeedsManagerChainConfigInput struct {
 func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struct {
 	Input *createFeedsManagerChainConfigInput
 }) (*CreateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds, ""); err != nil {
 		return nil, err
 	}
 
@@ -260,7 +267,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 	}
 
 	fmj, _ := json.Marshal(ccfg)
-	r.App.GetAuditLogger().Audit(audit.FeedsManChainConfigCreated, map[string]any{"feedsManager": fmj})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.FeedsManChainConfigCreated, map[string]any{"feedsManager": fmj})
 
 	return NewCreateFeedsManagerChainConfigPayload(ccfg, nil, nil), nil
 }
@@ -268,7 +275,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struct {
 	ID string
 }) (*DeleteFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds, ""); err != nil {
 		return nil, err
 	}
 
@@ -296,7 +303,7 @@ func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struc
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.FeedsManChainConfigDeleted, map[string]any{"id": args.ID})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.FeedsManChainConfigDeleted, map[string]any{"id": args.ID})
 
 	return NewDeleteFeedsManagerChainConfigPayload(ccfg, nil), nil
 }
@@ -324,7 +331,7 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	ID    string
 	Input *updateFeedsManagerChainConfigInput
 }) (*UpdateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds, ""); err != nil {
 		return nil, err
 	}
 
@@ -394,7 +401,7 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	}
 
 	fmj, _ := json.Marshal(ccfg)
-	r.App.GetAuditLogger().Audit(audit.FeedsManChainConfigUpdated, map[string]any{"feedsManager": fmj})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.FeedsManChainConfigUpdated, map[string]any{"feedsManager": fmj})
 
 	return NewUpdateFeedsManagerChainConfigPayload(ccfg, nil, nil), nil
 }
@@ -408,7 +415,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds, ""); err != nil {
 		return nil, err
 	}
 
@@ -445,7 +452,7 @@ func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	}
 
 	mgrj, _ := json.Marshal(mgr)
-	r.App.GetAuditLogger().Audit(audit.FeedsManCreated, map[string]any{"mgrj": mgrj})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.FeedsManCreated, map[string]any{"mgrj": mgrj})
 
 	return NewCreateFeedsManagerPayload(mgr, nil, nil), nil
 }
@@ -455,13 +462,14 @@ type updateBridgeInput struct {
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	HealthCheckURL         *string
 }
 
 func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceBridges, string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -477,12 +485,17 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	if err := minContractPayment.UnmarshalText([]byte(args.Input.MinimumContractPayment)); err != nil {
 		return nil, err
 	}
+	healthCheckURL, err := parseHealthCheckURL(args.Input.HealthCheckURL)
+	if err != nil {
+		return nil, err
+	}
 
 	btr := &bridges.BridgeTypeRequest{
 		Name:                   bridges.BridgeName(args.Input.Name),
 		URL:                    webURL,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
+		HealthCheckURL:         healthCheckURL,
 	}
 
 	taskType, err := bridges.ParseBridgeName(string(args.ID))
@@ -500,8 +513,12 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	// The security settings are not managed through GraphQL
+	btr.BridgeSecurity = bridge.BridgeSecurity
+
 	// Update the bridge
-	if err := ValidateBridgeType(btr); err != nil {
+	updated := btr.WithStored(bridge)
+	if err := ValidateBridgeType(&updated); err != nil {
 		return nil, err
 	}
 
@@ -509,11 +526,12 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.BridgeUpdated, map[string]any{
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.BridgeUpdated, map[string]any{
 		"bridgeName":                   bridge.Name,
 		"bridgeConfirmations":          bridge.Confirmations,
 		"bridgeMinimumContractPayment": bridge.MinimumContractPayment,
 		"bridgeURL":                    bridge.URL,
+		"bridgeHealthCheckURL":         bridge.HealthCheckURL,
 	})
 
 	return NewUpdateBridgePayload(&bridge, nil), nil
@@ -529,7 +547,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds, string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -568,7 +586,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	}
 
 	mgrj, _ := json.Marshal(mgr)
-	r.App.GetAuditLogger().Audit(audit.FeedsManUpdated, map[string]any{"mgrj": mgrj})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.FeedsManUpdated, map[string]any{"mgrj": mgrj})
 
 	return NewUpdateFeedsManagerPayload(mgr, nil, nil), nil
 }
@@ -577,7 +595,7 @@ func (r *Resolver) EnableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*EnableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds, string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -591,7 +609,7 @@ func (r *Resolver) EnableFeedsManager(ctx context.Context, args struct {
 	mgr, err := feedsService.EnableManager(ctx, id)
 
 	mgrj, _ := json.Marshal(mgr)
-	r.App.GetAuditLogger().Audit(audit.FeedsManEnabled, map[string]any{"mgrj": mgrj})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.FeedsManEnabled, map[string]any{"mgrj": mgrj})
 	return NewEnableFeedsManagerPayload(mgr, err), nil
 }
 
@@ -599,7 +617,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*DisableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds, string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -613,12 +631,12 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 	mgr, err := feedsService.DisableManager(ctx, id)
 
 	mgrj, _ := json.Marshal(mgr)
-	r.App.GetAuditLogger().Audit(audit.FeedsManDisabled, map[string]any{"mgrj": mgrj})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.FeedsManDisabled, map[string]any{"mgrj": mgrj})
 	return NewDisableFeedsManagerPayload(mgr, err), nil
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys, ""); err != nil {
 		return nil, err
 	}
 
@@ -627,7 +645,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.OCRKeyBundleCreated, map[string]any{
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.OCRKeyBundleCreated, map[string]any{
 		"ocrKeyBundleID":                      key.ID(),
 		"ocrKeyBundlePublicKeyAddressOnChain": key.PublicKeyAddressOnChain(),
 	})
@@ -638,7 +656,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys, args.ID); err != nil {
 		return nil, err
 	}
 
@@ -650,14 +668,14 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.OCRKeyBundleDeleted, map[string]any{"id": args.ID})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.OCRKeyBundleDeleted, map[string]any{"id": args.ID})
 	return NewDeleteOCRKeyBundlePayloadResolver(deletedKey, nil), nil
 }
 
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceBridges, string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -688,12 +706,12 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.BridgeDeleted, map[string]any{"name": bt.Name})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.BridgeDeleted, map[string]any{"name": bt.Name})
 	return NewDeleteBridgePayload(&bt, nil), nil
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys, ""); err != nil {
 		return nil, err
 	}
 
@@ -703,7 +721,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 	}
 
 	const keyType = "Ed25519"
-	r.App.GetAuditLogger().Audit(audit.KeyCreated, map[string]any{
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.KeyCreated, map[string]any{
 		"type":         "p2p",
 		"id":           key.ID(),
 		"p2pPublicKey": key.PublicKeyHex(),
@@ -717,7 +735,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys, string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -734,7 +752,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.KeyDeleted, map[string]any{
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.KeyDeleted, map[string]any{
 		"type": "p2p",
 		"id":   args.ID,
 	})
@@ -743,7 +761,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys, ""); err != nil {
 		return nil, err
 	}
 
@@ -752,7 +770,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.KeyCreated, map[string]any{
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.KeyCreated, map[string]any{
 		"type":                "vrf",
 		"id":                  key.ID(),
 		"vrfPublicKey":        key.PublicKey,
@@ -765,7 +783,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys, string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -777,7 +795,7 @@ func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.KeyDeleted, map[string]any{
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.KeyDeleted, map[string]any{
 		"type": "vrf",
 		"id":   args.ID,
 	})
@@ -790,7 +808,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Force *bool
 }) (*ApproveJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs, ""); err != nil {
 		return nil, err
 	}
 
@@ -820,7 +838,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	}
 
 	specj, _ := json.Marshal(spec)
-	r.App.GetAuditLogger().Audit(audit.JobProposalSpecApproved, map[string]any{"spec": specj})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.JobProposalSpecApproved, map[string]any{"spec": specj})
 
 	return NewApproveJobProposalSpecPayload(spec, err), nil
 }
@@ -829,7 +847,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*CancelJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs, ""); err != nil {
 		return nil, err
 	}
 
@@ -855,7 +873,7 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	}
 
 	specj, _ := json.Marshal(spec)
-	r.App.GetAuditLogger().Audit(audit.JobProposalSpecCanceled, map[string]any{"spec": specj})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.JobProposalSpecCanceled, map[string]any{"spec": specj})
 
 	return NewCancelJobProposalSpecPayload(spec, err), nil
 }
@@ -864,7 +882,7 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs, ""); err != nil {
 		return nil, err
 	}
 
@@ -890,7 +908,7 @@ func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	}
 
 	specj, _ := json.Marshal(spec)
-	r.App.GetAuditLogger().Audit(audit.JobProposalSpecRejected, map[string]any{"spec": specj})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.JobProposalSpecRejected, map[string]any{"spec": specj})
 
 	return NewRejectJobProposalSpecPayload(spec, err), nil
 }
@@ -900,7 +918,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	ID    graphql.ID
 	Input *struct{ Definition string }
 }) (*UpdateJobProposalSpecDefinitionPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs, ""); err != nil {
 		return nil, err
 	}
 
@@ -928,7 +946,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	}
 
 	specj, _ := json.Marshal(spec)
-	r.App.GetAuditLogger().Audit(audit.JobProposalSpecUpdated, map[string]any{"spec": specj})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.JobProposalSpecUpdated, map[string]any{"spec": specj})
 
 	return NewUpdateJobProposalSpecDefinitionPayload(spec, err), nil
 }
@@ -951,7 +969,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 	}
 
 	if !utils.CheckPasswordHash(args.Input.OldPassword, dbUser.HashedPassword) {
-		r.App.GetAuditLogger().Audit(audit.PasswordResetAttemptFailedMismatch, map[string]any{"user": dbUser.Email})
+		audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.PasswordResetAttemptFailedMismatch, map[string]any{"user": dbUser.Email})
 
 		return NewUpdatePasswordPayload(nil, map[string]string{
 			"oldPassword": "old password does not match",
@@ -967,23 +985,23 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 		return nil, failedPasswordUpdateError{}
 	}
 
-	r.App.GetAuditLogger().Audit(audit.PasswordResetSuccess, map[string]any{"user": dbUser.Email})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.PasswordResetSuccess, map[string]any{"user": dbUser.Email})
 	return NewUpdatePasswordPayload(session.User, nil), nil
 }
 
 func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	Input struct{ Enabled bool }
 }) (*SetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceConfig, ""); err != nil {
 		return nil, err
 	}
 
 	r.App.GetConfig().SetLogSQL(args.Input.Enabled)
 
 	if args.Input.Enabled {
-		r.App.GetAuditLogger().Audit(audit.ConfigSqlLoggingEnabled, map[string]any{})
+		audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.ConfigSqlLoggingEnabled, map[string]any{})
 	} else {
-		r.App.GetAuditLogger().Audit(audit.ConfigSqlLoggingDisabled, map[string]any{})
+		audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.ConfigSqlLoggingDisabled, map[string]any{})
 	}
 
 	return NewSetSQLLoggingPayload(args.Input.Enabled), nil
@@ -1007,7 +1025,7 @@ func (r *Resolver) CreateAPIToken(ctx context.Context, args struct {
 
 	err = r.App.AuthenticationProvider().TestPassword(ctx, dbUser.Email, args.Input.Password)
 	if err != nil {
-		r.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]any{"user": dbUser.Email})
+		audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]any{"user": dbUser.Email})
 
 		return NewCreateAPITokenPayload(nil, map[string]string{
 			"password": "incorrect password",
@@ -1019,7 +1037,7 @@ func (r *Resolver) CreateAPIToken(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]any{"user": dbUser.Email})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.APITokenCreated, map[string]any{"user": dbUser.Email})
 	return NewCreateAPITokenPayload(newToken, nil), nil
 }
 
@@ -1041,7 +1059,7 @@ func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 
 	err = r.App.AuthenticationProvider().TestPassword(ctx, dbUser.Email, args.Input.Password)
 	if err != nil {
-		r.App.GetAuditLogger().Audit(audit.APITokenDeleteAttemptPasswordMismatch, map[string]any{"user": dbUser.Email})
+		audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.APITokenDeleteAttemptPasswordMismatch, map[string]any{"user": dbUser.Email})
 
 		return NewDeleteAPITokenPayload(nil, map[string]string{
 			"password": "incorrect password",
@@ -1053,7 +1071,7 @@ func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.APITokenDeleted, map[string]any{"user": dbUser.Email})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.APITokenDeleted, map[string]any{"user": dbUser.Email})
 
 	return NewDeleteAPITokenPayload(&auth.Token{
 		AccessKey: dbUser.TokenKey.String,
@@ -1065,7 +1083,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs, ""); err != nil {
 		return nil, err
 	}
 
@@ -1131,6 +1149,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	jb.TOML = args.Input.TOML
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -1141,7 +1160,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	}
 
 	jbj, _ := json.Marshal(jb)
-	r.App.GetAuditLogger().Audit(audit.JobCreated, map[string]any{"job": string(jbj)})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.JobCreated, map[string]any{"job": string(jbj)})
 
 	return NewCreateJobPayload(r.App, &jb, nil), nil
 }
@@ -1149,7 +1168,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs, string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -1176,14 +1195,74 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.JobDeleted, map[string]any{"id": args.ID})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.JobDeleted, map[string]any{"id": args.ID})
 	return NewDeleteJobPayload(r.App, &j, nil), nil
 }
 
+func (r *Resolver) PauseJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*PauseJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs, string(args.ID)); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.PauseJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewPauseJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.JobPaused, map[string]any{"id": args.ID})
+	return NewPauseJobPayload(r.App, &j, nil), nil
+}
+
+func (r *Resolver) ResumeJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ResumeJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs, string(args.ID)); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.ResumeJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewResumeJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.JobResumed, map[string]any{"id": args.ID})
+	return NewResumeJobPayload(r.App, &j, nil), nil
+}
+
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs, ""); err != nil {
 		return nil, err
 	}
 
@@ -1210,14 +1289,14 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.JobErrorDismissed, map[string]any{"id": args.ID})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.JobErrorDismissed, map[string]any{"id": args.ID})
 	return NewDismissJobErrorPayload(&specErr, nil), nil
 }
 
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUserCanRun(ctx); err != nil {
+	if err := authenticateUserCanRun(ctx, sessions.ResourceJobs, string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -1240,14 +1319,14 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.JobRunSet, map[string]any{"jobID": args.ID, "jobRunID": jobRunID, "planRunID": plnRun})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.JobRunSet, map[string]any{"jobID": args.ID, "jobRunID": jobRunID, "planRunID": plnRun})
 	return NewRunJobPayload(&plnRun, r.App, nil), nil
 }
 
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceConfig, ""); err != nil {
 		return nil, err
 	}
 
@@ -1265,7 +1344,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.GlobalLogLevelSet, map[string]any{"logLevel": args.Level})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.GlobalLogLevelSet, map[string]any{"logLevel": args.Level})
 	return NewSetGlobalLogLevelPayload(args.Level, nil), nil
 }
 
@@ -1273,7 +1352,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys, ""); err != nil {
 		return nil, err
 	}
 
@@ -1285,7 +1364,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleCreated, map[string]any{
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.OCR2KeyBundleCreated, map[string]any{
 		"ocrKeyID":                        key.ID(),
 		"ocrKeyChainType":                 key.ChainType(),
 		"ocrKeyConfigEncryptionPublicKey": key.ConfigEncryptionPublicKey(),
@@ -1301,7 +1380,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys, string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -1316,6 +1395,6 @@ func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleDeleted, map[string]any{"id": id})
+	audit.FromContext(ctx, r.App.GetAuditLogger()).Audit(audit.OCR2KeyBundleDeleted, map[string]any{"id": id})
 	return NewDeleteOCR2KeyBundlePayloadResolver(&key, nil), nil
 }
@@ -38,6 +38,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/resolver"
@@ -53,6 +54,10 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 	if err != nil {
 		return nil, err
 	}
+	roles, err := clsessions.NewRoles(config.WebServer().Roles())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid WebServer.Roles")
+	}
 	sessionStore := cookie.NewStore(secret)
 	sessionStore.Options(config.WebServer().SessionOptions())
 	cors := uiCorsHandler(config.WebServer().AllowOrigins())
@@ -83,6 +88,7 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 			rl.Authenticated(),
 		),
 		sessions.Sessions(auth.SessionName, sessionStore),
+		auth.WithRoles(roles),
 	)
 
 	debugRoutes(app, api)
@@ -252,9 +258,15 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
 		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
 		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
+		authv2.DELETE("/users/:email/sessions", auth.RequiresAdminRole(uc.RevokeUserSessions))
+		authv2.GET("/sessions", auth.RequiresAdminRole(uc.IndexSessions))
+		authv2.DELETE("/sessions/:sessionID", auth.RequiresAdminRole(uc.RevokeSession))
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
+		authv2.GET("/user/tokens", uc.IndexAPITokens)
+		authv2.POST("/user/tokens", uc.CreateNamedAPIToken)
+		authv2.DELETE("/user/tokens/:name", uc.RevokeAPIToken)
 
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
@@ -304,10 +316,16 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			authv2.POST("/execute_capability", auth.RequiresRunRole(capContr.ExecuteCapability))
 		}
 
+		ksc := KeystoreController{app}
+		authv2.POST("/keystore/password", auth.RequiresAdminRole(ksc.RotatePassword))
+		authv2.POST("/keystore/backup", auth.RequiresAdminRole(ksc.Backup))
+		authv2.POST("/keystore/restore", auth.RequiresAdminRole(ksc.Restore))
+
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
 		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
 		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
+		authv2.POST("/keys/csa/external", auth.RequiresAdminRole(csakc.AddExternal))
 		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(csakc.Export))
 
 		ekc := NewETHKeysController(app)
@@ -329,6 +347,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		ethKeysGroup.POST("/keys/evm", auth.RequiresEditRole(ekc.Create))
 		ethKeysGroup.DELETE("/keys/evm/:address", auth.RequiresAdminRole(ekc.Delete))
 		ethKeysGroup.POST("/keys/evm/import", auth.RequiresAdminRole(ekc.Import))
+		ethKeysGroup.POST("/keys/evm/external", auth.RequiresAdminRole(ekc.AddExternal))
 		authv2.POST("/keys/evm/export/:address", auth.RequiresAdminRole(ekc.Export))
 		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresAdminRole(ekc.Chain))
 
@@ -344,6 +363,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/ocr2/:chainType", auth.RequiresEditRole(ocr2kc.Create))
 		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresAdminRole(ocr2kc.Delete))
 		authv2.POST("/keys/ocr2/import", auth.RequiresAdminRole(ocr2kc.Import))
+		authv2.POST("/keys/ocr2/:chainType/external", auth.RequiresAdminRole(ocr2kc.AddExternal))
 		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresAdminRole(ocr2kc.Export))
 
 		p2pkc := P2PKeysController{app}
@@ -351,6 +371,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/p2p", auth.RequiresEditRole(p2pkc.Create))
 		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresAdminRole(p2pkc.Delete))
 		authv2.POST("/keys/p2p/import", auth.RequiresAdminRole(p2pkc.Import))
+		authv2.POST("/keys/p2p/external", auth.RequiresAdminRole(p2pkc.AddExternal))
 		authv2.POST("/keys/p2p/export/:ID", auth.RequiresAdminRole(p2pkc.Export))
 
 		for _, keys := range []struct {
@@ -387,13 +408,29 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
+		authv2.GET("/jobs/export", auth.RequiresAdminRole(jc.Export))
 		authv2.GET("/jobs/:ID", jc.Show)
+		authv2.GET("/jobs/:ID/graph", jc.Graph)
+		authv2.GET("/jobs/:ID/revisions", jc.Revisions)
+		authv2.GET("/jobs/:ID/revisions/diff", jc.RevisionsDiff)
+		authv2.POST("/jobs/:ID/rollback/:rev", auth.RequiresEditRole(jc.Rollback))
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresEditRole(jc.Simulate))
+		authv2.POST("/jobs/import", auth.RequiresAdminRole(jc.Import))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
+		authv2.PATCH("/jobs/:ID", auth.RequiresEditRole(jc.Patch))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
+		gwc := GatewaysController{app}
+		authv2.GET("/gateways", auth.RequiresAdminRole(gwc.Index))
+		authv2.GET("/gateways/:ID", auth.RequiresAdminRole(gwc.Show))
+		authv2.DELETE("/gateways/:ID/dons/:donID/nodes/:address", auth.RequiresAdminRole(gwc.DisconnectNode))
+
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
+		authv2.GET("/pipeline/runs/:runID/fixture", prc.Fixture)
+		authv2.POST("/pipeline/runs/:runID/fixture/live", auth.RequiresRunRole(prc.RerecordFixtureLive))
+		authv2.POST("/pipeline/replay", auth.RequiresRunRole(prc.Replay))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
@@ -404,6 +441,10 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		// PipelineJobSpecErrorsController
 		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
 
+		ac := AuditController{app}
+		authv2.GET("/audit", auth.RequiresAdminRole(paginatedRequest(ac.Index)))
+		authv2.GET("/audit/verify", auth.RequiresAdminRole(ac.Verify))
+
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
 		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
@@ -37,6 +37,8 @@ func (sc *SessionsController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("error binding json %w", err))
 		return
 	}
+	sr.IPAddress = c.ClientIP()
+	sr.UserAgent = c.Request.UserAgent()
 
 	// Does this user have 2FA enabled?
 	userWebAuthnTokens, err := sc.App.AuthenticationProvider().GetUserWebAuthn(ctx, sr.Email)
@@ -84,7 +86,7 @@ func (sc *SessionsController) Destroy(c *gin.Context) {
 		return
 	}
 
-	sc.App.GetAuditLogger().Audit(audit.AuthSessionDeleted, map[string]any{"sessionID": sessionID})
+	audit.FromContext(ctx, sc.App.GetAuditLogger()).Audit(audit.AuthSessionDeleted, map[string]any{"sessionID": sessionID})
 	jsonAPIResponse(c, Session{Authenticated: false}, "session")
 }
 
@@ -77,7 +77,7 @@ func (tc *SolanaTransfersController) Create(c *gin.Context) {
 	resource.From = tr.From.String()
 	resource.To = tr.To.String()
 
-	tc.App.GetAuditLogger().Audit(audit.SolanaTransactionCreated, map[string]any{
+	audit.FromContext(c.Request.Context(), tc.App.GetAuditLogger()).Audit(audit.SolanaTransactionCreated, map[string]any{
 		"solanaTransactionResource": resource,
 	})
 	jsonAPIResponse(c, resource, "solana_tx")
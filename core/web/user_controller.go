@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"net/http"
 	"strings"
 
@@ -63,11 +64,14 @@ func (u *UserController) Create(c *gin.Context) {
 		return
 	}
 
-	userRole, err := clsession.GetUserRole(request.Role)
+	userRole, err := webauth.GetRoles(ctx).GetUserRole(request.Role)
 	if err != nil {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
+	if !u.canGrant(c, userRole) {
+		return
+	}
 
 	if verr := clsession.ValidateEmail(request.Email); verr != nil {
 		jsonAPIError(c, http.StatusBadRequest, verr)
@@ -139,9 +143,15 @@ func (u *UserController) UpdateRole(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, errors.New("new-role flag is empty, must specify a new role, possible options are 'admin', 'edit', 'run', 'view'"))
 		return
 	}
-	_, err := clsession.GetUserRole(request.NewRole)
+	newRole, err := webauth.GetRoles(ctx).GetUserRole(request.NewRole)
 	if err != nil {
-		jsonAPIError(c, http.StatusBadRequest, errors.New("new role does not exist, possible options are 'admin', 'edit', 'run', 'view'"))
+		jsonAPIError(c, http.StatusBadRequest, errors.Wrap(err, "new role does not exist"))
+		return
+	}
+	if !u.canGrant(c, newRole) {
+		return
+	}
+	if user, err := u.App.AuthenticationProvider().FindUser(ctx, request.Email); err == nil && !u.canGrant(c, user.Role) {
 		return
 	}
 
@@ -184,6 +194,9 @@ func (u *UserController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, errors.New("can not delete currently logged in admin user"))
 		return
 	}
+	if !u.canGrant(c, user.Role) {
+		return
+	}
 
 	if err = u.App.AuthenticationProvider().DeleteUser(ctx, email); err != nil {
 		if errors.Is(err, clsession.ErrNotSupported) {
@@ -223,7 +236,7 @@ func (u *UserController) UpdatePassword(c *gin.Context) {
 		return
 	}
 	if !utils.CheckPasswordHash(request.OldPassword, user.HashedPassword) {
-		u.App.GetAuditLogger().Audit(audit.PasswordResetAttemptFailedMismatch, map[string]any{"user": user.Email})
+		audit.FromContext(ctx, u.App.GetAuditLogger()).Audit(audit.PasswordResetAttemptFailedMismatch, map[string]any{"user": user.Email})
 		jsonAPIError(c, http.StatusConflict, errors.New("old password does not match"))
 		return
 	}
@@ -236,7 +249,7 @@ func (u *UserController) UpdatePassword(c *gin.Context) {
 		return
 	}
 
-	u.App.GetAuditLogger().Audit(audit.PasswordResetSuccess, map[string]any{"user": user.Email})
+	audit.FromContext(ctx, u.App.GetAuditLogger()).Audit(audit.PasswordResetSuccess, map[string]any{"user": user.Email})
 	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
 }
 
@@ -267,7 +280,7 @@ func (u *UserController) NewAPIToken(c *gin.Context) {
 	// In order to create an API token, login validation with provided password must succeed
 	err = u.App.AuthenticationProvider().TestPassword(ctx, sessionUser.Email, request.Password)
 	if err != nil {
-		u.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]any{"user": user.Email})
+		audit.FromContext(ctx, u.App.GetAuditLogger()).Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]any{"user": user.Email})
 		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
 		return
 	}
@@ -281,7 +294,7 @@ func (u *UserController) NewAPIToken(c *gin.Context) {
 		return
 	}
 
-	u.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]any{"user": user.Email})
+	audit.FromContext(ctx, u.App.GetAuditLogger()).Audit(audit.APITokenCreated, map[string]any{"user": user.Email})
 	jsonAPIResponseWithStatus(c, newToken, "auth_token", http.StatusCreated)
 }
 
@@ -311,7 +324,7 @@ func (u *UserController) DeleteAPIToken(c *gin.Context) {
 	}
 	err = u.App.AuthenticationProvider().TestPassword(ctx, sessionUser.Email, request.Password)
 	if err != nil {
-		u.App.GetAuditLogger().Audit(audit.APITokenDeleteAttemptPasswordMismatch, map[string]any{"user": user.Email})
+		audit.FromContext(ctx, u.App.GetAuditLogger()).Audit(audit.APITokenDeleteAttemptPasswordMismatch, map[string]any{"user": user.Email})
 		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
 		return
 	}
@@ -324,11 +337,163 @@ func (u *UserController) DeleteAPIToken(c *gin.Context) {
 		return
 	}
 	{
-		u.App.GetAuditLogger().Audit(audit.APITokenDeleted, map[string]any{"user": user.Email})
+		audit.FromContext(ctx, u.App.GetAuditLogger()).Audit(audit.APITokenDeleted, map[string]any{"user": user.Email})
 		jsonAPIResponseWithStatus(c, nil, "auth_token", http.StatusNoContent)
 	}
 }
 
+// IndexAPITokens lists the named API tokens of the current user.
+func (u *UserController) IndexAPITokens(c *gin.Context) {
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	tokens, err := u.App.AuthenticationProvider().ListAPITokens(c.Request.Context(), sessionUser.Email)
+	if err != nil {
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "api_tokens")
+}
+
+// CreateNamedAPIToken creates a named API token for the current user, which
+// expires and may be restricted to a scope.
+func (u *UserController) CreateNamedAPIToken(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request clsession.CreateAPITokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	if err := u.App.AuthenticationProvider().TestPassword(ctx, sessionUser.Email, request.Password); err != nil {
+		audit.FromContext(ctx, u.App.GetAuditLogger()).Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]any{"user": sessionUser.Email, "name": request.Name})
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+	apiToken, token, err := clsession.NewAPIToken(sessionUser.Email, request)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err := u.App.AuthenticationProvider().CreateAPIToken(ctx, &apiToken); err != nil {
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			jsonAPIError(c, http.StatusConflict, errors.Errorf("API token %s already exists", request.Name))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	audit.FromContext(ctx, u.App.GetAuditLogger()).Audit(audit.APITokenCreated, map[string]any{
+		"user":      sessionUser.Email,
+		"name":      apiToken.Name,
+		"scope":     request.Scope,
+		"expiresAt": apiToken.ExpiresAt,
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewCreatedAPITokenResource(apiToken, token), "api_tokens", http.StatusCreated)
+}
+
+// RevokeAPIToken deletes one of the current user's named API tokens.
+func (u *UserController) RevokeAPIToken(c *gin.Context) {
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	name := c.Param("name")
+	if err := u.App.AuthenticationProvider().DeleteAPIToken(c.Request.Context(), sessionUser.Email, name); err != nil {
+		switch {
+		case errors.Is(err, clsession.ErrNotSupported):
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+		case errors.Is(err, sql.ErrNoRows):
+			jsonAPIError(c, http.StatusNotFound, errors.Errorf("API token %s not found", name))
+		default:
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	audit.FromContext(c.Request.Context(), u.App.GetAuditLogger()).Audit(audit.APITokenDeleted, map[string]any{"user": sessionUser.Email, "name": name})
+	jsonAPIResponseWithStatus(c, nil, "api_tokens", http.StatusNoContent)
+}
+
+// IndexSessions lists the active sessions of all users, or of the user given
+// by the email query parameter.
+func (u *UserController) IndexSessions(c *gin.Context) {
+	ss, err := u.App.AuthenticationProvider().ListSessions(c.Request.Context(), c.Query("email"))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	// API token requests have no session
+	currentSessionID, _ := getCurrentSessionID(c)
+	jsonAPIResponse(c, presenters.NewSessionResources(ss, currentSessionID), "sessions")
+}
+
+// RevokeSession deletes an active session by its public ID, logging out its
+// user.
+func (u *UserController) RevokeSession(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("sessionID")
+	if err := u.App.AuthenticationProvider().RevokeSession(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.Errorf("session %s not found", id))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	audit.FromContext(ctx, u.App.GetAuditLogger()).Audit(audit.AuthSessionRevoked, map[string]any{"session": id})
+	jsonAPIResponseWithStatus(c, nil, "sessions", http.StatusNoContent)
+}
+
+// RevokeUserSessions deletes all active sessions of a user, logging them out
+// everywhere.
+func (u *UserController) RevokeUserSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+	email := c.Param("email")
+	if err := u.App.AuthenticationProvider().RevokeUserSessions(ctx, email); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	audit.FromContext(ctx, u.App.GetAuditLogger()).Audit(audit.AuthUserSessionsRevoked, map[string]any{"user": email})
+	jsonAPIResponseWithStatus(c, nil, "sessions", http.StatusNoContent)
+}
+
+// canGrant checks that the current user may grant role, so that a custom role
+// with admin permission on users can neither assign nor take away roles
+// permitting more than its own. It writes the error response otherwise.
+func (u *UserController) canGrant(c *gin.Context, role clsession.UserRole) bool {
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return false
+	}
+	if !webauth.GetRoles(c.Request.Context()).CanGrant(sessionUser.Role, role) {
+		jsonAPIError(c, http.StatusForbidden, errors.Errorf("role %s permits more than the role %s of the current user", role, sessionUser.Role))
+		return false
+	}
+	return true
+}
+
 func getCurrentSessionID(c *gin.Context) (string, error) {
 	session := sessions.Default(c)
 	sessionID, ok := session.Get(webauth.SessionIDKey).(string)
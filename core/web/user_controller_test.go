@@ -8,15 +8,20 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func TestUserController_UpdatePassword(t *testing.T) {
@@ -225,6 +230,63 @@ func TestUserController_UpdateRole(t *testing.T) {
 	}
 }
 
+func TestUserController_CustomRoleCanNotEscalate(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.WebServer.Roles = []toml.WebServerRole{
+			{Name: ptr("user-admin"), Permissions: []string{"users:admin"}},
+		}
+		for _, c := range c.EVM {
+			c.Enabled = ptr(false)
+		}
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(&cltest.User{Role: "user-admin"})
+
+	admin := cltest.MustRandomUser(t)
+	require.NoError(t, app.AuthenticationProvider().CreateUser(ctx, &admin))
+	viewer := cltest.MustRandomUser(t)
+	viewer.Role = sessions.UserRoleView
+	require.NoError(t, app.AuthenticationProvider().CreateUser(ctx, &viewer))
+
+	for _, tc := range []struct {
+		name           string
+		method, path   string
+		reqBody        string
+		wantStatusCode int
+	}{
+		{"create an admin", "POST", "/v2/users", fmt.Sprintf(`{"email": "new-admin@chainlink.test", "role": "admin", "password": %q}`, cltest.Password), http.StatusForbidden},
+		{"create an editor", "POST", "/v2/users", fmt.Sprintf(`{"email": "new-editor@chainlink.test", "role": "edit", "password": %q}`, cltest.Password), http.StatusForbidden},
+		{"promote to admin", "PATCH", "/v2/users", fmt.Sprintf(`{"email": %q, "newRole": "admin"}`, viewer.Email), http.StatusForbidden},
+		{"demote an admin", "PATCH", "/v2/users", fmt.Sprintf(`{"email": %q, "newRole": "view"}`, admin.Email), http.StatusForbidden},
+		{"delete an admin", "DELETE", "/v2/users/" + admin.Email, "", http.StatusForbidden},
+		{"create a viewer", "POST", "/v2/users", fmt.Sprintf(`{"email": "new-viewer@chainlink.test", "role": "view", "password": %q}`, cltest.Password), http.StatusOK},
+		{"grant its own role", "PATCH", "/v2/users", fmt.Sprintf(`{"email": %q, "newRole": "user-admin"}`, viewer.Email), http.StatusOK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var resp *http.Response
+			var cleanup func()
+			switch tc.method {
+			case "POST":
+				resp, cleanup = client.Post(tc.path, bytes.NewBufferString(tc.reqBody))
+			case "PATCH":
+				resp, cleanup = client.Patch(tc.path, bytes.NewBufferString(tc.reqBody))
+			case "DELETE":
+				resp, cleanup = client.Delete(tc.path)
+			}
+			t.Cleanup(cleanup)
+			assert.Equal(t, tc.wantStatusCode, resp.StatusCode)
+		})
+	}
+
+	user, err := app.AuthenticationProvider().FindUser(ctx, admin.Email)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleAdmin, user.Role)
+}
+
 func TestUserController_DeleteUser(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -289,6 +351,96 @@ func TestUserController_NewAPIToken_unauthorized(t *testing.T) {
 	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 }
 
+func TestUserController_NamedAPITokens(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+	req, err := json.Marshal(sessions.CreateAPITokenRequest{
+		Password:  cltest.Password,
+		Name:      "ci",
+		Scope:     []string{"jobs:view"},
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/user/tokens", bytes.NewBuffer(req))
+	defer cleanup()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var created presenters.APITokenResource
+	cltest.ParseJSONAPIResponse(t, resp, &created)
+	assert.Equal(t, "ci", created.Name)
+	assert.NotEmpty(t, created.Secret)
+
+	resp, cleanup = client.Post("/v2/user/tokens", bytes.NewBuffer(req))
+	defer cleanup()
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp, cleanup = client.Get("/v2/user/tokens")
+	defer cleanup()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var tokens []presenters.APITokenResource
+	cltest.ParseJSONAPIResponse(t, resp, &tokens)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, []string{"jobs:view"}, tokens[0].Scope)
+	assert.Empty(t, tokens[0].Secret)
+
+	resp, cleanup = client.Delete("/v2/user/tokens/ci")
+	defer cleanup()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, cleanup = client.Delete("/v2/user/tokens/ci")
+	defer cleanup()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestUserController_Sessions(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+	other := &cltest.User{}
+	otherClient := app.NewHTTPClient(other)
+
+	resp, cleanup := client.Get("/v2/sessions?email=" + other.Email)
+	defer cleanup()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var ss []presenters.SessionResource
+	cltest.ParseJSONAPIResponse(t, resp, &ss)
+	require.Len(t, ss, 1)
+	assert.Equal(t, other.Email, ss[0].Email)
+	assert.False(t, ss[0].Current)
+
+	resp, cleanup = client.Delete("/v2/sessions/" + ss[0].ID)
+	defer cleanup()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	resp, cleanup = otherClient.Get("/v2/user/tokens")
+	defer cleanup()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "revoked session")
+
+	resp, cleanup = client.Delete("/v2/sessions/" + ss[0].ID)
+	defer cleanup()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	app.MustSeedNewSession(other.Email)
+	app.MustSeedNewSession(other.Email)
+	resp, cleanup = client.Delete("/v2/users/" + other.Email + "/sessions")
+	defer cleanup()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, cleanup = client.Get("/v2/sessions")
+	defer cleanup()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	ss = nil
+	cltest.ParseJSONAPIResponse(t, resp, &ss)
+	for _, s := range ss {
+		assert.NotEqual(t, other.Email, s.Email)
+	}
+}
+
 func TestUserController_DeleteAPIKey(t *testing.T) {
 	t.Parallel()
 
@@ -39,7 +39,7 @@ func (vrfkc *VRFKeysController) Create(c *gin.Context) {
 		return
 	}
 
-	vrfkc.App.GetAuditLogger().Audit(audit.KeyCreated, map[string]any{
+	audit.FromContext(ctx, vrfkc.App.GetAuditLogger()).Audit(audit.KeyCreated, map[string]any{
 		"type":                "vrf",
 		"id":                  pk.ID(),
 		"vrfPublicKey":        pk.PublicKey,
@@ -67,7 +67,7 @@ func (vrfkc *VRFKeysController) Delete(c *gin.Context) {
 		return
 	}
 
-	vrfkc.App.GetAuditLogger().Audit(audit.KeyDeleted, map[string]any{
+	audit.FromContext(ctx, vrfkc.App.GetAuditLogger()).Audit(audit.KeyDeleted, map[string]any{
 		"type": "vrf",
 		"id":   keyID,
 	})
@@ -94,7 +94,7 @@ func (vrfkc *VRFKeysController) Import(c *gin.Context) {
 		return
 	}
 
-	vrfkc.App.GetAuditLogger().Audit(audit.KeyImported, map[string]any{
+	audit.FromContext(ctx, vrfkc.App.GetAuditLogger()).Audit(audit.KeyImported, map[string]any{
 		"type":                "vrf",
 		"id":                  key.ID(),
 		"vrfPublicKey":        key.PublicKey,
@@ -119,7 +119,7 @@ func (vrfkc *VRFKeysController) Export(c *gin.Context) {
 		return
 	}
 
-	vrfkc.App.GetAuditLogger().Audit(audit.KeyExported, map[string]any{
+	audit.FromContext(c.Request.Context(), vrfkc.App.GetAuditLogger()).Audit(audit.KeyExported, map[string]any{
 		"type": "vrf",
 		"id":   keyID,
 	})